@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_AccountQuery_FullMethodName = "/cosmos.accounts.v1.Query/AccountQuery"
-	Query_Schema_FullMethodName       = "/cosmos.accounts.v1.Query/Schema"
-	Query_AccountType_FullMethodName  = "/cosmos.accounts.v1.Query/AccountType"
+	Query_AccountQuery_FullMethodName          = "/cosmos.accounts.v1.Query/AccountQuery"
+	Query_Schema_FullMethodName                = "/cosmos.accounts.v1.Query/Schema"
+	Query_AccountType_FullMethodName           = "/cosmos.accounts.v1.Query/AccountType"
+	Query_AccountSchema_FullMethodName         = "/cosmos.accounts.v1.Query/AccountSchema"
+	Query_SimulateUserOperation_FullMethodName = "/cosmos.accounts.v1.Query/SimulateUserOperation"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,13 @@ type QueryClient interface {
 	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResponse, error)
 	// AccountType returns the account type for an address.
 	AccountType(ctx context.Context, in *AccountTypeRequest, opts ...grpc.CallOption) (*AccountTypeResponse, error)
+	// AccountSchema returns the schema of an account type together with the
+	// descriptors of the messages accepted by its handlers, so that clients can
+	// build the messages of account types they do not know about.
+	AccountSchema(ctx context.Context, in *AccountSchemaRequest, opts ...grpc.CallOption) (*AccountSchemaResponse, error)
+	// SimulateUserOperation simulates a user operation, returning the gas used by
+	// each of its parts and the responses of its messages.
+	SimulateUserOperation(ctx context.Context, in *SimulateUserOperationRequest, opts ...grpc.CallOption) (*SimulateUserOperationResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +80,24 @@ func (c *queryClient) AccountType(ctx context.Context, in *AccountTypeRequest, o
 	return out, nil
 }
 
+func (c *queryClient) AccountSchema(ctx context.Context, in *AccountSchemaRequest, opts ...grpc.CallOption) (*AccountSchemaResponse, error) {
+	out := new(AccountSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_AccountSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateUserOperation(ctx context.Context, in *SimulateUserOperationRequest, opts ...grpc.CallOption) (*SimulateUserOperationResponse, error) {
+	out := new(SimulateUserOperationResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateUserOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +108,13 @@ type QueryServer interface {
 	Schema(context.Context, *SchemaRequest) (*SchemaResponse, error)
 	// AccountType returns the account type for an address.
 	AccountType(context.Context, *AccountTypeRequest) (*AccountTypeResponse, error)
+	// AccountSchema returns the schema of an account type together with the
+	// descriptors of the messages accepted by its handlers, so that clients can
+	// build the messages of account types they do not know about.
+	AccountSchema(context.Context, *AccountSchemaRequest) (*AccountSchemaResponse, error)
+	// SimulateUserOperation simulates a user operation, returning the gas used by
+	// each of its parts and the responses of its messages.
+	SimulateUserOperation(context.Context, *SimulateUserOperationRequest) (*SimulateUserOperationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +131,12 @@ func (UnimplementedQueryServer) Schema(context.Context, *SchemaRequest) (*Schema
 func (UnimplementedQueryServer) AccountType(context.Context, *AccountTypeRequest) (*AccountTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountType not implemented")
 }
+func (UnimplementedQueryServer) AccountSchema(context.Context, *AccountSchemaRequest) (*AccountSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountSchema not implemented")
+}
+func (UnimplementedQueryServer) SimulateUserOperation(context.Context, *SimulateUserOperationRequest) (*SimulateUserOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateUserOperation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +204,42 @@ func _Query_AccountType_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountSchema(ctx, req.(*AccountSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateUserOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateUserOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateUserOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateUserOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateUserOperation(ctx, req.(*SimulateUserOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +259,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountType",
 			Handler:    _Query_AccountType_Handler,
 		},
+		{
+			MethodName: "AccountSchema",
+			Handler:    _Query_AccountSchema_Handler,
+		},
+		{
+			MethodName: "SimulateUserOperation",
+			Handler:    _Query_SimulateUserOperation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/query.proto",
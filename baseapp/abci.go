@@ -496,7 +496,11 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	// again in a subsequent round. However, we only want to do this after we've
 	// processed the first block, as we want to avoid overwriting the finalizeState
 	// after state changes during InitChain.
-	if req.Height > app.initialHeight {
+	//
+	// If the proposal was already executed optimistically in a previous round,
+	// its execution is reused, together with the FinalizeBlock state it was
+	// executed on.
+	if req.Height > app.initialHeight && !app.optimisticExec.HasExecution(req.Hash) {
 		// abort any running OE
 		app.optimisticExec.Abort()
 		app.setState(execModeFinalize, header)
@@ -554,7 +558,7 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	if resp.Status == abci.ResponseProcessProposal_ACCEPT &&
 		app.optimisticExec.Enabled() &&
 		req.Height > app.initialHeight {
-		app.optimisticExec.Execute(req)
+		app.optimisticExec.Execute(req, app.finalizeBlockState)
 	}
 
 	return resp, nil
@@ -786,13 +790,54 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.ctx)
 	app.finalizeBlockState.ctx = app.finalizeBlockState.ctx.WithBlockGasMeter(gasMeter)
 
+	var txResults []*abci.ExecTxResult
+	if app.parallelTxWorkers > 1 {
+		txResults, err = app.executeTxsParallel(ctx, req.Txs)
+	} else {
+		txResults, err = app.executeTxs(ctx, req.Txs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if app.finalizeBlockState.ms.TracingEnabled() {
+		app.finalizeBlockState.ms = app.finalizeBlockState.ms.SetTracingContext(nil).(storetypes.CacheMultiStore)
+	}
+
+	endBlock, err := app.endBlock(app.finalizeBlockState.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// check after endBlock if we should abort, to avoid propagating the result
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// continue
+	}
+
+	events = append(events, endBlock.Events...)
+	cp := app.GetConsensusParams(app.finalizeBlockState.ctx)
+
+	return &abci.ResponseFinalizeBlock{
+		Events:                events,
+		TxResults:             txResults,
+		ValidatorUpdates:      endBlock.ValidatorUpdates,
+		ConsensusParamUpdates: &cp,
+	}, nil
+}
+
+// executeTxs executes the given raw transactions sequentially, gathering the
+// execution results.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
 		var response *abci.ExecTxResult
 
 		if _, err := app.txDecoder(rawTx); err == nil {
@@ -821,32 +866,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 		txResults = append(txResults, response)
 	}
 
-	if app.finalizeBlockState.ms.TracingEnabled() {
-		app.finalizeBlockState.ms = app.finalizeBlockState.ms.SetTracingContext(nil).(storetypes.CacheMultiStore)
-	}
-
-	endBlock, err := app.endBlock(app.finalizeBlockState.ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// check after endBlock if we should abort, to avoid propagating the result
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
-		// continue
-	}
-
-	events = append(events, endBlock.Events...)
-	cp := app.GetConsensusParams(app.finalizeBlockState.ctx)
-
-	return &abci.ResponseFinalizeBlock{
-		Events:                events,
-		TxResults:             txResults,
-		ValidatorUpdates:      endBlock.ValidatorUpdates,
-		ConsensusParamUpdates: &cp,
-	}, nil
+	return txResults, nil
 }
 
 // FinalizeBlock will execute the block proposal provided by RequestFinalizeBlock.
@@ -868,9 +888,14 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 
 		// only return if we are not aborting
 		if !aborted {
+			// restore the FinalizeBlock state the decided proposal was executed on,
+			// which may be from a previous round
+			app.finalizeBlockState = app.optimisticExec.State().(*state)
+			app.optimisticExec.Reset()
 			if res != nil {
 				res.AppHash = app.workingHash()
 			}
+			app.listenFinalizeBlock(req, res)
 			return res, err
 		}
 
@@ -885,14 +910,22 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 		res.AppHash = app.workingHash()
 	}
 
-	// call the streaming service hooks with the FinalizeBlock messages
+	app.listenFinalizeBlock(req, res)
+
+	return res, err
+}
+
+// listenFinalizeBlock calls the streaming service hooks with the FinalizeBlock messages.
+func (app *BaseApp) listenFinalizeBlock(req *abci.RequestFinalizeBlock, res *abci.ResponseFinalizeBlock) {
+	if res == nil {
+		return
+	}
+
 	for _, streamingListener := range app.streamingManager.ABCIListeners {
 		if err := streamingListener.ListenFinalizeBlock(app.finalizeBlockState.ctx, *req, *res); err != nil {
 			app.logger.Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", err)
 		}
 	}
-
-	return res, err
 }
 
 // checkHalt checks if height or time exceeds halt-height or halt-time respectively.
@@ -957,6 +990,7 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	// NOTE: This is safe because CometBFT holds a lock on the mempool for
 	// Commit. Use the header from this latest block.
 	app.setState(execModeCheck, header)
+	app.checkState.ctx = app.checkState.ctx.WithHeaderInfo(app.finalizeBlockState.ctx.HeaderInfo())
 
 	app.finalizeBlockState = nil
 
@@ -0,0 +1,184 @@
+package baseapp
+
+import (
+	"github.com/cockroachdb/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// LaneProposalHandler defines the ABCI PrepareProposal and ProcessProposal
+// handlers for a mempool.LaneMempool, which partition the block space between
+// the lanes of the mempool according to their max block space.
+type LaneProposalHandler struct {
+	mempool    *mempool.LaneMempool
+	txVerifier ProposalTxVerifier
+}
+
+func NewLaneProposalHandler(mp *mempool.LaneMempool, txVerifier ProposalTxVerifier) *LaneProposalHandler {
+	return &LaneProposalHandler{
+		mempool:    mp,
+		txVerifier: txVerifier,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler which builds the
+// proposal lane by lane, in lane order. The transactions of each lane are
+// selected from the lane mempool until the lane reaches its share of
+// RequestPrepareProposal.MaxTxBytes and of the max block gas, or the block is
+// full. The block space left unused by a lane is available to the next lanes,
+// within their own share. Transactions are verified like in the
+// DefaultProposalHandler, and invalid transactions are removed from the mempool.
+// Transactions which do not belong to their lane anymore are moved to the lane
+// they belong to, or removed from the mempool if they match no lane.
+func (h *LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		var maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil && b.MaxGas > 0 {
+			maxBlockGas = uint64(b.MaxGas)
+		}
+
+		var (
+			maxTxBytes   = uint64(req.MaxTxBytes)
+			totalTxBytes uint64
+			totalTxGas   uint64
+			txs          [][]byte
+		)
+		for i, lane := range h.mempool.Lanes() {
+			maxLaneBytes := min(laneLimit(lane.MaxBlockSpace, maxTxBytes), maxTxBytes-totalTxBytes)
+			var maxLaneGas uint64
+			if maxBlockGas > 0 {
+				maxLaneGas = min(laneLimit(lane.MaxBlockSpace, maxBlockGas), maxBlockGas-totalTxGas)
+			}
+
+			// the lane, or the block, is already full
+			if maxLaneBytes == 0 || (maxBlockGas > 0 && maxLaneGas == 0) {
+				continue
+			}
+
+			var (
+				ts           = &defaultTxSelector{}
+				misplacedTxs []sdk.Tx
+			)
+			iterator := lane.Mempool.Select(ctx, req.Txs)
+			for iterator != nil {
+				memTx := iterator.Tx()
+
+				// Skip the txs which do not belong to the lane anymore, as the
+				// proposal would be rejected in ProcessProposal.
+				laneIdx, err := h.mempool.LaneIndex(ctx, memTx)
+				if err != nil || laneIdx != i {
+					misplacedTxs = append(misplacedTxs, memTx)
+				} else {
+					txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+					if err != nil {
+						err := lane.Mempool.Remove(memTx)
+						if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+							return nil, err
+						}
+					} else if stop := ts.SelectTxForProposal(ctx, maxLaneBytes, maxLaneGas, memTx, txBz); stop {
+						break
+					}
+				}
+
+				iterator = iterator.Next()
+			}
+
+			// Move the skipped txs to the lane they belong to, once the lane is
+			// iterated. A tx moved to a following lane can still be selected in
+			// this proposal.
+			for _, memTx := range misplacedTxs {
+				err := lane.Mempool.Remove(memTx)
+				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					return nil, err
+				}
+
+				if laneIdx, err := h.mempool.LaneIndex(ctx, memTx); err == nil {
+					// the tx is dropped if the mempool of its lane rejects it
+					_ = h.mempool.Lanes()[laneIdx].Mempool.Insert(ctx, memTx)
+				}
+			}
+
+			totalTxBytes += ts.totalTxBytes
+			totalTxGas += ts.totalTxGas
+			txs = append(txs, ts.selectedTxs...)
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: txs}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler which verifies that
+// the proposal was built lane by lane. Every transaction in the proposal must be
+// valid, as in the DefaultProposalHandler, the transactions must be ordered by
+// lane, and each lane must not exceed its share of the max block gas and of the
+// max block bytes. Note that the lane bytes are checked against the max block
+// bytes, which are slightly larger than the RequestPrepareProposal.MaxTxBytes
+// used to build the proposal.
+func (h *LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		var maxBlockGas, maxBlockBytes uint64
+		if b := ctx.ConsensusParams().Block; b != nil {
+			if b.MaxGas > 0 {
+				maxBlockGas = uint64(b.MaxGas)
+			}
+			if b.MaxBytes > 0 {
+				maxBlockBytes = uint64(b.MaxBytes)
+			}
+		}
+
+		var (
+			lanes        = h.mempool.Lanes()
+			currentLane  int
+			laneTxBytes  uint64
+			laneTxGas    uint64
+			totalTxGas   uint64
+			rejectResult = &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		)
+		for _, txBz := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBz)
+			if err != nil {
+				return rejectResult, nil
+			}
+
+			laneIdx, err := h.mempool.LaneIndex(ctx, tx)
+			if err != nil || laneIdx < currentLane {
+				return rejectResult, nil
+			}
+			if laneIdx > currentLane {
+				currentLane = laneIdx
+				laneTxBytes = 0
+				laneTxGas = 0
+			}
+
+			if maxBlockBytes > 0 {
+				laneTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+				if laneTxBytes > laneLimit(lanes[currentLane].MaxBlockSpace, maxBlockBytes) {
+					return rejectResult, nil
+				}
+			}
+
+			if maxBlockGas > 0 {
+				if gasTx, ok := tx.(GasTx); ok {
+					laneTxGas += gasTx.GetGas()
+					totalTxGas += gasTx.GetGas()
+				}
+
+				if totalTxGas > maxBlockGas || laneTxGas > laneLimit(lanes[currentLane].MaxBlockSpace, maxBlockGas) {
+					return rejectResult, nil
+				}
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// laneLimit returns the share of the total block space allotted to a lane.
+func laneLimit(maxBlockSpace math.LegacyDec, total uint64) uint64 {
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(total)).Mul(maxBlockSpace).TruncateInt().Uint64()
+}
@@ -0,0 +1,214 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestLaneProposalHandler(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	// txs with counter 1 belong to the priority lane, the others to the default lane
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:    "priority",
+			Mempool: mempool.DefaultPriorityMempool(),
+			Match: func(_ context.Context, tx sdk.Tx) bool {
+				return tx.GetMsgs()[0].(*baseapptestutil.MsgCounter).Counter == 1
+			},
+			MaxBlockSpace: math.LegacyNewDecWithPrec(3, 1),
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(counter int64, nonce uint64) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: counter, Signer: addr.String()}))
+		builder.SetGasLimit(100)
+		setTxSignature(t, builder, nonce)
+		return builder.GetTx()
+	}
+
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	var priorityTxs, defaultTxs [][]byte
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		for _, counter := range []int64{1, 2} {
+			tx := newTx(counter, nonce)
+			require.NoError(t, mp.Insert(ctx, tx))
+
+			txBz, err := txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			if counter == 1 {
+				priorityTxs = append(priorityTxs, txBz)
+			} else {
+				defaultTxs = append(defaultTxs, txBz)
+			}
+		}
+	}
+
+	txSize := int64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{priorityTxs[0]}))
+	for _, txBz := range append(priorityTxs, defaultTxs...) {
+		require.Equal(t, txSize, cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	}
+
+	ctrl := gomock.NewController(t)
+	txVerifier := mock.NewMockProposalTxVerifier(ctrl)
+	txVerifier.EXPECT().PrepareProposalVerifyTx(gomock.Any()).DoAndReturn(txConfig.TxEncoder()).AnyTimes()
+	txVerifier.EXPECT().ProcessProposalVerifyTx(gomock.Any()).DoAndReturn(func(txBz []byte) (sdk.Tx, error) {
+		return txConfig.TxDecoder()(txBz)
+	}).AnyTimes()
+
+	ph := baseapp.NewLaneProposalHandler(mp, txVerifier)
+	prepareProposal := ph.PrepareProposalHandler()
+	processProposal := ph.ProcessProposalHandler()
+
+	testCases := map[string]struct {
+		maxTxBytes  int64
+		maxGas      int64
+		expectedTxs [][]byte
+	}{
+		"lanes limited by bytes": {
+			maxTxBytes:  10 * txSize,
+			expectedTxs: append(append([][]byte{}, priorityTxs[:3]...), defaultTxs...),
+		},
+		"default lane limited by the remaining bytes": {
+			maxTxBytes:  5 * txSize,
+			expectedTxs: append(append([][]byte{}, priorityTxs[:1]...), defaultTxs[:4]...),
+		},
+		"no block gas limit": {
+			maxTxBytes:  10 * txSize,
+			maxGas:      -1,
+			expectedTxs: append(append([][]byte{}, priorityTxs[:3]...), defaultTxs...),
+		},
+		"lanes limited by gas": {
+			maxTxBytes:  100 * txSize,
+			maxGas:      500,
+			expectedTxs: append(append([][]byte{}, priorityTxs[:1]...), defaultTxs[:4]...),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Block: &cmtproto.BlockParams{MaxBytes: tc.maxTxBytes, MaxGas: tc.maxGas},
+			})
+
+			resp, err := prepareProposal(ctx, &abci.RequestPrepareProposal{MaxTxBytes: tc.maxTxBytes})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTxs, resp.Txs)
+
+			res, err := processProposal(ctx, &abci.RequestProcessProposal{Txs: resp.Txs})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+		})
+	}
+
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 10 * txSize, MaxGas: 1000},
+	})
+	rejected := map[string][][]byte{
+		"lanes out of order":      {defaultTxs[0], priorityTxs[0]},
+		"lane exceeds its bytes":  priorityTxs[:4],
+		"undecodable transaction": {priorityTxs[0], []byte("invalid")},
+	}
+	for name, txs := range rejected {
+		t.Run(name, func(t *testing.T) {
+			res, err := processProposal(ctx, &abci.RequestProcessProposal{Txs: txs})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+		})
+	}
+}
+
+func TestLaneProposalHandlerMovesTxs(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	// the txs whose counter is below the bound of a lane belong to it
+	priorityBound, defaultBound := int64(10), int64(10)
+	mp, err := mempool.NewLaneMempool(
+		mempool.Lane{
+			Name:    "priority",
+			Mempool: mempool.DefaultPriorityMempool(),
+			Match: func(_ context.Context, tx sdk.Tx) bool {
+				return tx.GetMsgs()[0].(*baseapptestutil.MsgCounter).Counter < priorityBound
+			},
+			MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+		},
+		mempool.Lane{
+			Name:    "default",
+			Mempool: mempool.DefaultPriorityMempool(),
+			Match: func(_ context.Context, tx sdk.Tx) bool {
+				return tx.GetMsgs()[0].(*baseapptestutil.MsgCounter).Counter < defaultBound
+			},
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+
+	// the txs are sent by different signers
+	newTx := func(counter int64) sdk.Tx {
+		_, pubKey, addr := testdata.KeyTestPubAddr()
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: counter, Signer: addr.String()}))
+		builder.SetGasLimit(100)
+		require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+			PubKey:   pubKey,
+			Sequence: 1,
+			Data:     &signingtypes.SingleSignatureData{},
+		}))
+		return builder.GetTx()
+	}
+
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	movedTx, droppedTx := newTx(1), newTx(5)
+	require.NoError(t, mp.Insert(ctx, movedTx))
+	require.NoError(t, mp.Insert(ctx, droppedTx))
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+
+	// the first tx now belongs to the default lane, and the second to no lane
+	priorityBound, defaultBound = 0, 3
+
+	ctrl := gomock.NewController(t)
+	txVerifier := mock.NewMockProposalTxVerifier(ctrl)
+	txVerifier.EXPECT().PrepareProposalVerifyTx(gomock.Any()).DoAndReturn(txConfig.TxEncoder()).AnyTimes()
+
+	ph := baseapp.NewLaneProposalHandler(mp, txVerifier)
+	resp, err := ph.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1000})
+	require.NoError(t, err)
+
+	// the moved tx is selected in the default lane
+	movedTxBz, err := txConfig.TxEncoder()(movedTx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{movedTxBz}, resp.Txs)
+	require.Equal(t, 0, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 1, mp.Lanes()[1].Mempool.CountTx())
+}
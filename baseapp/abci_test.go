@@ -28,6 +28,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
@@ -35,6 +36,7 @@ import (
 	"cosmossdk.io/x/auth/signing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -42,6 +44,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 const (
@@ -820,6 +823,78 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateTxWithStateDiff(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			// the ante writes the same value on every tx, which does not change the state
+			ctx.KVStore(capKey1).Set([]byte("ante"), []byte("ante"))
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent("coin_spent", sdk.NewAttribute("spender", "payer"), sdk.NewAttribute("amount", "5atom,10stake")),
+				sdk.NewEvent("coin_received", sdk.NewAttribute("receiver", "collector"), sdk.NewAttribute("amount", "5atom,10stake")),
+				sdk.NewEvent("coin_spent", sdk.NewAttribute("spender", "collector"), sdk.NewAttribute("amount", "5atom")),
+				sdk.NewEvent("coin_received", sdk.NewAttribute("receiver", "payer"), sdk.NewAttribute("amount", "5atom")),
+			})
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(key, value string) []byte {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte(value), Signer: addr.String()}))
+		setTxSignature(t, builder, 0)
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{newTx("existing", "old")}})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	for _, txBytes := range [][]byte{newTx("existing", "new"), newTx("created", "value")} {
+		gInfo, result, err := suite.baseApp.Simulate(txBytes)
+		require.NoError(t, err)
+
+		gInfoDiff, resultDiff, stateDiff, err := suite.baseApp.SimulateWithStateDiff(txBytes)
+		require.NoError(t, err)
+		require.Equal(t, gInfo, gInfoDiff)
+		require.Equal(t, result.Events, resultDiff.Events)
+
+		require.Equal(t, []*txtypes.BalanceDelta{
+			{Address: "collector", Denom: "stake", Amount: math.NewInt(10)},
+			{Address: "payer", Denom: "stake", Amount: math.NewInt(-10)},
+		}, stateDiff.BalanceDeltas)
+
+		tx, err := suite.txConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		msg := tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue)
+
+		var oldValue []byte
+		if string(msg.Key) == "existing" {
+			oldValue = []byte("old")
+		}
+		require.Equal(t, []*txtypes.StoreWrite{
+			{StoreKey: capKey2.Name(), Key: msg.Key, OldValue: oldValue, NewValue: msg.Value},
+		}, stateDiff.Writes)
+	}
+
+	// the simulations do not change the state
+	store := getCheckStateCtx(suite.baseApp).KVStore(capKey2)
+	require.Equal(t, []byte("old"), store.Get([]byte("existing")))
+	require.False(t, store.Has([]byte("created")))
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -2380,3 +2455,79 @@ func TestOptimisticExecution(t *testing.T) {
 
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
+
+func TestOptimisticExecution_RoundChanges(t *testing.T) {
+	var optimisticExec *oe.OptimisticExecution
+	suite := NewBaseAppSuite(t, baseapp.SetOptimisticExecution(func(o *oe.OptimisticExecution) {
+		optimisticExec = o
+	}))
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	// the reference app executes the decided proposals only
+	refSuite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterKeyValueServer(refSuite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	for _, s := range []*BaseAppSuite{suite, refSuite} {
+		_, err := s.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newProposal := func(height int64, key string) *abci.RequestProcessProposal {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte(key), Signer: addr.String()}))
+		setTxSignature(t, builder, 0)
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		return &abci.RequestProcessProposal{
+			Txs:    [][]byte{txBytes},
+			Height: height,
+			Hash:   []byte(key),
+		}
+	}
+
+	// the first block is not executed optimistically
+	for height := int64(1); height <= 6; height++ {
+		proposalA := newProposal(height, fmt.Sprintf("a%d", height))
+		proposalB := newProposal(height, fmt.Sprintf("b%d", height))
+
+		// proposal A is re-proposed after a round change
+		for _, req := range []*abci.RequestProcessProposal{proposalA, proposalB, proposalA} {
+			res, err := suite.baseApp.ProcessProposal(req)
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+			// let the execution finish before the round change, otherwise it
+			// would be aborted by the execution of the next proposal
+			_, _ = optimisticExec.WaitResult()
+		}
+
+		// the last block is decided on a proposal which was not executed
+		decidedHash := proposalA.Hash
+		if height == 6 {
+			decidedHash = []byte("c")
+		}
+
+		var appHashes [][]byte
+		for _, s := range []*BaseAppSuite{suite, refSuite} {
+			res, err := s.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Height: height,
+				Txs:    proposalA.Txs,
+				Hash:   decidedHash,
+			})
+			require.NoError(t, err)
+			require.Len(t, res.TxResults, 1)
+			require.Equal(t, uint32(0), res.TxResults[0].Code, res.TxResults[0].Log)
+			appHashes = append(appHashes, res.AppHash)
+
+			_, err = s.baseApp.Commit()
+			require.NoError(t, err)
+		}
+		require.Equal(t, appHashes[1], appHashes[0])
+	}
+
+	require.Equal(t, oe.Stats{Executions: 10, Reused: 5, Hits: 4, Misses: 1, Aborted: 6}, optimisticExec.Stats())
+}
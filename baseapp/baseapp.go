@@ -74,6 +74,7 @@ type BaseApp struct {
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
 
 	mempool     mempool.Mempool // application side mempool
+	mempoolMu   sync.Mutex      // serializes the removal of txs executed in parallel from the mempool
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.PostHandler // post handler, optional
 
@@ -183,6 +184,15 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// parallelTxWorkers defines the number of workers executing the txs of a
+	// block in parallel during FinalizeBlock. Txs are executed sequentially if
+	// it is lower than 2.
+	parallelTxWorkers int
+
+	// txBundlesEnabled defines whether tx bundles are executed, they are
+	// rejected otherwise.
+	txBundlesEnabled bool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -741,7 +751,13 @@ func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) (sdk.BeginBlock,
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
+	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	return app.execTxResult(gInfo, result, anteEvents, err)
+}
+
+// execTxResult returns the response of a tx executed in FinalizeBlock given the
+// outcome of runTx.
+func (app *BaseApp) execTxResult(gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) *abci.ExecTxResult {
 	resultStr := "successful"
 
 	var resp *abci.ExecTxResult
@@ -753,7 +769,6 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
 	if err != nil {
 		resultStr = "failed"
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
@@ -811,12 +826,17 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, using the provided
+// Context retrieved through getContextForTx.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -863,6 +883,36 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		return sdk.GasInfo{}, nil, nil, err
 	}
 
+	if bundle, ok := tx.(sdk.BundleTx); ok {
+		if !app.txBundlesEnabled {
+			return sdk.GasInfo{}, nil, nil, errorsmod.Wrap(sdkerrors.ErrNotSupported, "tx bundles are not enabled")
+		}
+
+		// The txs of the bundle have their own gas meters, the gas meter of the
+		// bundle sums up their gas.
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		var msCache storetypes.CacheMultiStore
+		result, anteEvents, msCache, err = app.runBundle(ctx, mode, bundle, &gasWanted)
+		if err == nil {
+			if mode == execModeFinalize {
+				// When block gas exceeds, it'll panic and won't commit the cached store.
+				consumeBlockGas()
+
+				msCache.Write()
+			} else if mode == execModeSimulate {
+				msCache.Write()
+			}
+		}
+
+		return gInfo, result, anteEvents, err
+	}
+
+	// a tx committing to a bundle can only be executed as part of it
+	if bundledTx, ok := tx.(sdk.TxWithBundleCommitment); ok && len(bundledTx.GetBundleCommitment()) > 0 {
+		return sdk.GasInfo{}, nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "bundled tx cannot be executed outside of its bundle")
+	}
+
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(app.msgServiceRouter, msgs); err != nil {
 		return sdk.GasInfo{}, nil, nil, err
@@ -914,7 +964,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
+		app.mempoolMu.Lock()
 		err = app.mempool.Remove(tx)
+		app.mempoolMu.Unlock()
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -956,6 +1008,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
+			msCache.Write()
+		} else if mode == execModeSimulate {
+			// The state of a simulation is a branch which is discarded, committing
+			// the state of the messages to it lets SimulateWithStateDiff capture it.
 			msCache.Write()
 		}
 
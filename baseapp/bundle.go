@@ -0,0 +1,180 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// attributeKeyTxIndex is the attribute appended to the events of the txs of a
+// bundle, with the index of the tx in the bundle.
+const attributeKeyTxIndex = "tx_index"
+
+// runBundle executes the txs of a bundle in order and atomically, as part of
+// runTxWithContext. The txs must all commit to the bundle, the TxDecoder
+// verifying that their commitment is the one of the bundle.
+//
+// The AnteHandlers of all the txs run first, on a branch of the state which is
+// committed only if all of them succeed. As for a single tx, the fees and
+// sequences of the txs are thus committed even if their messages fail. The
+// messages and PostHandlers of all the txs then run on a shared branch, which
+// is returned to be committed by the caller only if all of them succeed: if any
+// tx fails, the messages of all the txs are reverted.
+//
+// Each tx has its own gas meter, set by its AnteHandler. The gas limits of the
+// txs are added to gasWanted, and the gas they consume to the gas meter of ctx.
+func (app *BaseApp) runBundle(ctx sdk.Context, mode execMode, bundle sdk.BundleTx, gasWanted *uint64) (result *sdk.Result, anteEvents []abci.Event, msCache storetypes.CacheMultiStore, err error) {
+	txs, txsBytes := bundle.GetTxs(), bundle.GetTxsBytes()
+	for i, tx := range txs {
+		bundledTx, ok := tx.(sdk.TxWithBundleCommitment)
+		if !ok || len(bundledTx.GetBundleCommitment()) == 0 {
+			return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bundled tx %d: missing bundle commitment", i)
+		}
+		if !bytes.Equal(bundledTx.GetBundleCommitment(), txs[0].(sdk.TxWithBundleCommitment).GetBundleCommitment()) {
+			return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bundled tx %d: invalid bundle commitment", i)
+		}
+
+		if err := validateBasicTxMsgs(app.msgServiceRouter, tx.GetMsgs()); err != nil {
+			return nil, nil, nil, errorsmod.Wrapf(err, "bundled tx %d", i)
+		}
+	}
+
+	txCtxs := make([]sdk.Context, len(txs))
+	for i := range txs {
+		txCtxs[i] = ctx.WithTxBytes(txsBytes[i]).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	}
+
+	// consume the gas of the txs also when one of them panics
+	defer func() {
+		for _, txCtx := range txCtxs {
+			ctx.GasMeter().ConsumeGas(txCtx.GasMeter().GasConsumedToLimit(), "bundled tx")
+		}
+	}()
+
+	if app.anteHandler != nil {
+		// Branch context before the AnteHandlers of the txs, in case any of them
+		// aborts.
+		anteCtx, anteCache := app.cacheTxContext(ctx, ctx.TxBytes())
+		for i, tx := range txs {
+			txCtx := txCtxs[i].WithMultiStore(anteCtx.MultiStore()).WithEventManager(sdk.NewEventManager())
+			newCtx, err := app.anteHandler(txCtx, tx, mode == execModeSimulate)
+			if !newCtx.IsZero() {
+				// the original multistore is restored with the state of the txs
+				txCtxs[i] = newCtx.WithMultiStore(ctx.MultiStore())
+			}
+
+			// GasMeter expected to be set in AnteHandler
+			*gasWanted = addGasSaturating(*gasWanted, txCtxs[i].GasMeter().Limit())
+
+			if err != nil {
+				return nil, nil, nil, errorsmod.Wrapf(err, "bundled tx %d", i)
+			}
+
+			anteEvents = append(anteEvents, withTxIndex(i, txCtxs[i].EventManager().ABCIEvents())...)
+		}
+
+		anteCache.Write()
+	}
+
+	if mode == execModeCheck {
+		// the bundle is inserted as a whole, with the lowest priority of its txs
+		priority := txCtxs[0].Priority()
+		for _, txCtx := range txCtxs[1:] {
+			priority = min(priority, txCtx.Priority())
+		}
+
+		err = app.mempool.Insert(ctx.WithPriority(priority), bundle)
+		if err != nil {
+			return nil, anteEvents, nil, err
+		}
+	} else if mode == execModeFinalize {
+		app.mempoolMu.Lock()
+		err = app.mempool.Remove(bundle)
+		app.mempoolMu.Unlock()
+		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return nil, anteEvents, nil, fmt.Errorf("failed to remove tx bundle from mempool: %w", err)
+		}
+	}
+
+	// Branch the state before the messages of the txs, the branch is committed
+	// only if all of them succeed.
+	runMsgCtx, msCache := app.cacheTxContext(ctx, ctx.TxBytes())
+
+	result = &sdk.Result{}
+	for i, tx := range txs {
+		txResult, err := app.runBundledTxMsgs(txCtxs[i].WithMultiStore(runMsgCtx.MultiStore()), mode, tx)
+		if err != nil {
+			return nil, anteEvents, nil, errorsmod.Wrapf(err, "bundled tx %d", i)
+		}
+
+		result.Events = append(result.Events, withTxIndex(i, txResult.Events)...)
+		result.MsgResponses = append(result.MsgResponses, txResult.MsgResponses...)
+	}
+
+	result.Data, err = makeABCIData(result.MsgResponses)
+	if err != nil {
+		return nil, anteEvents, nil, errorsmod.Wrap(err, "failed to marshal tx data")
+	}
+
+	if len(anteEvents) > 0 && (mode == execModeFinalize || mode == execModeSimulate) {
+		// append the events in the order of occurrence
+		result.Events = append(anteEvents, result.Events...)
+	}
+
+	return result, anteEvents, msCache, nil
+}
+
+// runBundledTxMsgs runs the messages and the PostHandler of a tx of a bundle,
+// on the shared branch of the bundle.
+func (app *BaseApp) runBundledTxMsgs(ctx sdk.Context, mode execMode, tx sdk.Tx) (*sdk.Result, error) {
+	msgsV2, err := tx.GetMsgsV2()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := app.runMsgs(ctx, tx.GetMsgs(), msgsV2, mode)
+
+	// Run optional postHandlers (should run regardless of the execution result).
+	if app.postHandler != nil {
+		postCtx := ctx.WithEventManager(sdk.NewEventManager())
+
+		newCtx, postErr := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
+		if postErr != nil {
+			return nil, postErr
+		}
+
+		if err == nil {
+			result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
+		}
+	}
+
+	return result, err
+}
+
+// withTxIndex appends the index of a tx in its bundle to its events.
+func withTxIndex(index int, events []abci.Event) []abci.Event {
+	for i, event := range events {
+		events[i] = abci.Event(sdk.Event(event).AppendAttributes(sdk.NewAttribute(attributeKeyTxIndex, strconv.Itoa(index))))
+	}
+	return events
+}
+
+// addGasSaturating returns the sum of two gas amounts, capped to the maximum
+// uint64, as the gas limit of a simulated tx is infinite.
+func addGasSaturating(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
@@ -0,0 +1,224 @@
+package baseapp_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var bundleFeesKey = []byte("fees")
+
+// bundleKeyValueImpl sets a key-value pair, failing if the value is "fail".
+type bundleKeyValueImpl struct{}
+
+func (m bundleKeyValueImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	if string(msg.Value) == "fail" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+	}
+
+	sdk.UnwrapSDKContext(ctx).KVStore(capKey2).Set(msg.Key, msg.Value)
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+// bundleAnteHandler charges a fee of 1 per tx, failing if the memo of the tx is
+// "failOnAnte".
+func bundleAnteHandler(t *testing.T) sdk.AnteHandler {
+	t.Helper()
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		_, isBundle := tx.(sdk.BundleTx)
+		require.False(t, isBundle)
+
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100000))
+		ctx.GasMeter().ConsumeGas(10, "ante")
+
+		if tx.(sdk.TxWithMemo).GetMemo() == "failOnAnte" {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+		}
+
+		store := ctx.KVStore(capKey1)
+		setIntOnStore(store, bundleFeesKey, getIntFromStore(t, store, bundleFeesKey)+1)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("ante_handler"))
+
+		return ctx, nil
+	}
+}
+
+// newBundleSuite returns a BaseAppSuite executing tx bundles.
+func newBundleSuite(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+	t.Helper()
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(bundleAnteHandler(t)) }
+	suite := NewBaseAppSuite(t, append([]func(*baseapp.BaseApp){anteOpt, baseapp.SetTxBundles(true)}, opts...)...)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), bundleKeyValueImpl{})
+
+	txConfig, err := authtx.NewTxConfigWithOptions(suite.cdc, authtx.ConfigOptions{EnableTxBundles: true})
+	require.NoError(t, err)
+	suite.txConfig = txConfig
+	suite.baseApp.SetTxDecoder(txConfig.TxDecoder())
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	return suite
+}
+
+// bundledMsg is the message of a tx of a bundle, with the memo of the tx.
+type bundledMsg struct {
+	key, value, memo string
+}
+
+// newBundleTxs returns the encoded txs of a bundle, each with one of the given
+// messages and committing to the bundle.
+func newBundleTxs(t *testing.T, suite *BaseAppSuite, bundledMsgs ...bundledMsg) [][]byte {
+	t.Helper()
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+
+	msgs := make([][]sdk.Msg, len(bundledMsgs))
+	for i, m := range bundledMsgs {
+		msgs[i] = []sdk.Msg{&baseapptestutil.MsgKeyValue{Key: []byte(m.key), Value: []byte(m.value), Signer: addr.String()}}
+	}
+	commitment, err := authtx.BundleCommitment(msgs...)
+	require.NoError(t, err)
+
+	txsBytes := make([][]byte, len(bundledMsgs))
+	for i, m := range bundledMsgs {
+		txsBytes[i] = newBundledTx(t, suite, msgs[i], m.memo, commitment, pubKey)
+	}
+	return txsBytes
+}
+
+func newBundledTx(t *testing.T, suite *BaseAppSuite, msgs []sdk.Msg, memo string, commitment []byte, pubKey cryptotypes.PubKey) []byte {
+	t.Helper()
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetMemo(memo)
+	builder.SetBundleCommitment(commitment)
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey: pubKey,
+		Data:   &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+	}))
+
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return txBytes
+}
+
+func newTxBundle(t *testing.T, txs ...[]byte) []byte {
+	t.Helper()
+	bz, err := (&txtypes.TxBundle{Txs: txs}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestABCI_FinalizeBlock_TxBundle(t *testing.T) {
+	suite := newBundleSuite(t)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs: [][]byte{
+			// all the txs succeed
+			newTxBundle(t, newBundleTxs(t, suite, bundledMsg{"a", "1", ""}, bundledMsg{"b", "2", ""})...),
+			// the message of the second tx fails, the fees are charged
+			newTxBundle(t, newBundleTxs(t, suite, bundledMsg{"c", "3", ""}, bundledMsg{"d", "fail", ""})...),
+			// the ante handler of the second tx fails, nothing is committed
+			newTxBundle(t, newBundleTxs(t, suite, bundledMsg{"e", "5", ""}, bundledMsg{"f", "6", "failOnAnte"})...),
+			// bundles cannot be nested
+			newTxBundle(t, append(newBundleTxs(t, suite, bundledMsg{"g", "7", ""}), newTxBundle(t, newBundleTxs(t, suite, bundledMsg{"h", "8", ""})...))...),
+			// a tx committing to a bundle cannot be executed alone
+			newBundleTxs(t, suite, bundledMsg{"i", "9", ""}, bundledMsg{"j", "10", ""})[0],
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 5)
+
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+	require.Greater(t, res.TxResults[0].GasUsed, int64(20))
+	require.Equal(t, int64(200000), res.TxResults[0].GasWanted)
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, suite.cdc.Unmarshal(res.TxResults[0].Data, &txMsgData))
+	require.Len(t, txMsgData.MsgResponses, 2)
+
+	// the events of each tx are tagged with its index in the bundle
+	txIndexes := map[string][]string{}
+	for _, event := range res.TxResults[0].Events {
+		for _, attr := range event.Attributes {
+			if attr.Key == "tx_index" {
+				txIndexes[event.Type] = append(txIndexes[event.Type], attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{"0", "1"}, txIndexes["ante_handler"])
+	require.Equal(t, []string{"0", "1"}, txIndexes[sdk.EventTypeMessage])
+
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.TxResults[1].Code)
+	require.True(t, strings.Contains(res.TxResults[1].Log, "bundled tx 1"), res.TxResults[1].Log)
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.TxResults[2].Code)
+	require.Equal(t, sdkerrors.ErrTxDecode.ABCICode(), res.TxResults[3].Code)
+	require.Equal(t, sdkerrors.ErrInvalidRequest.ABCICode(), res.TxResults[4].Code)
+
+	ctx := getFinalizeBlockStateCtx(suite.baseApp)
+	store := ctx.KVStore(capKey2)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	for _, key := range []string{"c", "d", "e", "f", "g", "h", "i", "j"} {
+		require.Nil(t, store.Get([]byte(key)), key)
+	}
+	require.Equal(t, int64(4), getIntFromStore(t, ctx.KVStore(capKey1), bundleFeesKey))
+}
+
+func TestABCI_CheckTx_TxBundle(t *testing.T) {
+	pool := mempool.NewSenderNonceMempool()
+	suite := newBundleSuite(t, baseapp.SetMempool(pool))
+
+	res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{
+		Tx:   newTxBundle(t, newBundleTxs(t, suite, bundledMsg{"a", "1", ""}, bundledMsg{"b", "2", ""})...),
+		Type: abci.CheckTxType_New,
+	})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(200000), res.GasWanted)
+	require.Greater(t, res.GasUsed, int64(20))
+
+	// the bundle is inserted in the mempool as a whole
+	require.Equal(t, 1, pool.CountTx())
+
+	res, err = suite.baseApp.CheckTx(&abci.RequestCheckTx{
+		Tx:   newTxBundle(t, newBundleTxs(t, suite, bundledMsg{"c", "3", ""}, bundledMsg{"d", "4", "failOnAnte"})...),
+		Type: abci.CheckTxType_New,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+	require.Equal(t, 1, pool.CountTx())
+
+	// only the ante handlers of the valid bundle are committed to the check state
+	ctx := getCheckStateCtx(suite.baseApp)
+	require.Equal(t, int64(2), getIntFromStore(t, ctx.KVStore(capKey1), bundleFeesKey))
+}
+
+func TestABCI_CheckTx_TxBundlesDisabled(t *testing.T) {
+	suite := newBundleSuite(t, baseapp.SetTxBundles(false))
+
+	res, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{
+		Tx:   newTxBundle(t, newBundleTxs(t, suite, bundledMsg{"a", "1", ""}, bundledMsg{"b", "2", ""})...),
+		Type: abci.CheckTxType_New,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrNotSupported.ABCICode(), res.Code)
+}
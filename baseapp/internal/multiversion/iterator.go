@@ -0,0 +1,175 @@
+package multiversion
+
+import (
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.Iterator = (*iterator)(nil)
+
+// iterator walks over both a snapshot of writes and a parent iterator at the
+// same time, where the writes take precedence over the parent. If versions are
+// provided, the version of the current key is tracked, where keys yielded by
+// the parent iterator are written by the parent version. If an iteration is
+// provided, every key yielded is recorded into it.
+type iterator struct {
+	parentItr storetypes.Iterator
+	start     []byte
+	end       []byte
+	key       []byte
+	value     []byte
+	version   version
+	keys      []string
+	values    [][]byte
+	versions  []version
+	reverse   bool
+	exhausted bool // exhausted reflects if the parent iterator is exhausted or not
+
+	it *iteration
+}
+
+func newIterator(
+	parentItr storetypes.Iterator,
+	start, end []byte,
+	keys []string,
+	values [][]byte,
+	versions []version,
+	reverse bool,
+	it *iteration,
+) *iterator {
+	itr := &iterator{
+		parentItr: parentItr,
+		start:     start,
+		end:       end,
+		keys:      keys,
+		values:    values,
+		versions:  versions,
+		reverse:   reverse,
+		exhausted: !parentItr.Valid(),
+		it:        it,
+	}
+
+	// call Next() to move the iterator to the first key/value entry
+	itr.Next()
+
+	return itr
+}
+
+// Domain returns the domain of the iterator. The caller must not modify the
+// return values.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *iterator) Key() []byte {
+	return slices.Clone(itr.key)
+}
+
+func (itr *iterator) Value() []byte {
+	return slices.Clone(itr.value)
+}
+
+func (itr *iterator) Close() error {
+	itr.key = nil
+	itr.value = nil
+	itr.keys = nil
+	itr.values = nil
+	itr.versions = nil
+	return itr.parentItr.Close()
+}
+
+func (itr *iterator) Valid() bool {
+	return itr.key != nil && itr.value != nil
+}
+
+func (itr *iterator) Error() error {
+	return itr.parentItr.Error()
+}
+
+func (itr *iterator) Next() {
+	ok := itr.next()
+
+	if itr.it != nil {
+		if ok {
+			itr.it.entries = append(itr.it.entries, iterationEntry{key: string(itr.key), version: itr.version})
+		} else {
+			itr.it.exhausted = true
+		}
+	}
+}
+
+func (itr *iterator) next() bool {
+	for {
+		switch {
+		case itr.exhausted && len(itr.keys) == 0: // exhausted both
+			itr.key = nil
+			itr.value = nil
+			return false
+
+		case itr.exhausted: // exhausted parent iterator but not the writes
+			if itr.popWrite() {
+				return true
+			}
+
+		case len(itr.keys) == 0: // exhausted the writes but not parent iterator
+			itr.popParent()
+			return true
+
+		default: // parent iterator is not exhausted and we have writes remaining
+			writeKey := itr.keys[0]
+			parentKey := string(itr.parentItr.Key())
+
+			switch {
+			case (!itr.reverse && writeKey < parentKey) || (itr.reverse && writeKey > parentKey): // write key should come before parent's key
+				if itr.popWrite() {
+					return true
+				}
+
+			case (!itr.reverse && parentKey < writeKey) || (itr.reverse && parentKey > writeKey): // parent's key should come before write key
+				itr.popParent()
+				return true
+
+			default: // the write shadows the parent's key
+				itr.parentItr.Next()
+				itr.exhausted = !itr.parentItr.Valid()
+
+				if itr.popWrite() {
+					return true
+				}
+			}
+		}
+	}
+}
+
+// popWrite moves the iterator to the next write and returns true, unless the
+// write is a deletion.
+func (itr *iterator) popWrite() bool {
+	key, value := itr.keys[0], itr.values[0]
+	itr.keys = itr.keys[1:]
+	itr.values = itr.values[1:]
+
+	if itr.versions != nil {
+		itr.version = itr.versions[0]
+		itr.versions = itr.versions[1:]
+	}
+
+	if value == nil {
+		return false
+	}
+
+	itr.key = []byte(key)
+	itr.value = value
+
+	return true
+}
+
+// popParent moves the iterator to the current key of the parent iterator and
+// advances the parent iterator.
+func (itr *iterator) popParent() {
+	itr.key = slices.Clone(itr.parentItr.Key())
+	itr.value = slices.Clone(itr.parentItr.Value())
+	itr.version = parentVersion
+	itr.parentItr.Next()
+	itr.exhausted = !itr.parentItr.Valid()
+}
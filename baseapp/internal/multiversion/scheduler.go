@@ -0,0 +1,172 @@
+package multiversion
+
+import (
+	"slices"
+	"sync"
+
+	"golang.org/x/exp/maps"
+)
+
+// ExecuteFn defines the function executing the tx with the given index against
+// the given TxStores, keyed by store key. It must be deterministic and must only
+// access state through the given TxStores.
+type ExecuteFn func(txIndex int, txStores map[string]*TxStore)
+
+// Scheduler executes the txs of a block optimistically in parallel against a
+// set of multi-version stores, in the spirit of Block-STM.
+//
+// All txs are first executed concurrently. The txs are then validated and the
+// txs that read a value which has since been overwritten by a tx with a lower
+// index are re-executed concurrently. This is repeated until all txs are valid.
+// Since every tx with an index lower than the lowest invalid tx is final, the
+// lowest invalid tx is guaranteed to be valid once re-executed, i.e. it takes at
+// most as many rounds as there are txs. The resulting state is deterministic
+// and matches the state of a sequential execution, regardless of the number of
+// times each tx is executed.
+type Scheduler struct {
+	workers   int
+	storeKeys []string
+	stores    map[string]*Store
+
+	incarnations []int
+}
+
+// NewScheduler returns a new Scheduler executing txs against the given stores
+// with at most the given number of concurrent workers.
+func NewScheduler(workers int, stores ...*Store) *Scheduler {
+	if workers < 1 {
+		workers = 1
+	}
+
+	storesByKey := make(map[string]*Store, len(stores))
+	for _, s := range stores {
+		storesByKey[s.GetStoreKey()] = s
+	}
+
+	storeKeys := maps.Keys(storesByKey)
+	slices.Sort(storeKeys)
+
+	return &Scheduler{
+		workers:   workers,
+		storeKeys: storeKeys,
+		stores:    storesByKey,
+	}
+}
+
+// Execute executes the given number of txs until all of them are valid. If the
+// execution of a tx panics, the panic is propagated once all concurrent
+// executions have completed.
+func (s *Scheduler) Execute(numTxs int, execute ExecuteFn) {
+	s.incarnations = make([]int, numTxs)
+
+	pending := make([]int, numTxs)
+	for i := range pending {
+		pending[i] = i
+	}
+
+	for len(pending) > 0 {
+		s.forEach(pending, func(txIndex int) bool {
+			s.execute(txIndex, execute)
+			return true
+		})
+
+		// every tx lower than the lowest re-executed tx is final
+		suffix := make([]int, 0, numTxs-pending[0])
+		for txIndex := pending[0]; txIndex < numTxs; txIndex++ {
+			suffix = append(suffix, txIndex)
+		}
+
+		pending = s.forEach(suffix, func(txIndex int) bool {
+			return s.validate(txIndex)
+		})
+	}
+}
+
+// Flush writes the state resulting from the first numTxs txs to the parent
+// stores.
+func (s *Scheduler) Flush(numTxs int) {
+	for _, storeKey := range s.storeKeys {
+		s.stores[storeKey].Flush(numTxs)
+	}
+}
+
+func (s *Scheduler) execute(txIndex int, execute ExecuteFn) {
+	incarnation := s.incarnations[txIndex]
+	s.incarnations[txIndex]++
+
+	txStores := make(map[string]*TxStore, len(s.stores))
+	for storeKey, mv := range s.stores {
+		txStores[storeKey] = mv.NewTxStore(txIndex, incarnation)
+	}
+
+	execute(txIndex, txStores)
+
+	for _, storeKey := range s.storeKeys {
+		s.stores[storeKey].publish(txStores[storeKey])
+	}
+}
+
+func (s *Scheduler) validate(txIndex int) bool {
+	for _, storeKey := range s.storeKeys {
+		if !s.stores[storeKey].validate(txIndex) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// forEach calls fn for each of the given tx indices with at most s.workers
+// concurrent calls, and returns the tx indices for which fn returned false, in
+// ascending order.
+func (s *Scheduler) forEach(txIndices []int, fn func(txIndex int) bool) []int {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		failed   []int
+		panicked any
+	)
+
+	ch := make(chan int)
+	for w := 0; w < min(s.workers, len(txIndices)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for txIndex := range ch {
+				ok := func() (ok bool) {
+					defer func() {
+						if r := recover(); r != nil {
+							mu.Lock()
+							if panicked == nil {
+								panicked = r
+							}
+							mu.Unlock()
+						}
+					}()
+
+					return fn(txIndex)
+				}()
+
+				if !ok {
+					mu.Lock()
+					failed = append(failed, txIndex)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, txIndex := range txIndices {
+		ch <- txIndex
+	}
+	close(ch)
+	wg.Wait()
+
+	if panicked != nil {
+		panic(panicked)
+	}
+
+	slices.Sort(failed)
+	return failed
+}
@@ -0,0 +1,306 @@
+package multiversion
+
+import (
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/tidwall/btree"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// degree defines the approximate number of items and children per B-tree node.
+const degree = 32
+
+// version identifies the incarnation of the tx that wrote a value. Values read
+// from the parent store have a tx index of -1.
+type version struct {
+	txIndex     int
+	incarnation int
+}
+
+// parentVersion denotes a value read from the parent store.
+var parentVersion = version{txIndex: -1}
+
+// write defines a value written by an incarnation of a tx. A nil value denotes
+// a deletion.
+type write struct {
+	version version
+	value   []byte
+}
+
+// keyWrites holds the writes of all txs to a single key, ordered by tx index.
+type keyWrites struct {
+	key    string
+	writes []write
+}
+
+// latestBefore returns the write of the tx with the highest index lower than
+// the given tx index, if any.
+func (kw *keyWrites) latestBefore(txIndex int) (write, bool) {
+	i := sort.Search(len(kw.writes), func(i int) bool { return kw.writes[i].version.txIndex >= txIndex })
+	if i == 0 {
+		return write{}, false
+	}
+
+	return kw.writes[i-1], true
+}
+
+// Store defines a multi-version in-memory store shared by the txs of a block
+// which are executed concurrently. It records, for each key, the value written
+// by every tx, s.t. a tx reads the value written by the tx with the highest
+// index lower than its own, falling back to the parent store otherwise.
+//
+// Each tx accesses the store through a TxStore, which records its read and
+// write sets. Writes only become visible to other txs once published, i.e. once
+// the tx has completed, and a tx is valid as long as every value it read is
+// still the value visible to it. Once all txs are valid, the state is exactly
+// the state of a sequential execution of the txs.
+//
+// Note, the parent store must be safe for concurrent reads and must not be
+// written to while txs are executed.
+type Store struct {
+	storeKey string
+	parent   storetypes.KVStore
+
+	mu   sync.RWMutex
+	data *btree.BTreeG[*keyWrites]
+
+	// writeSets reflects the keys written by the latest published incarnation
+	// of each tx
+	writeSets map[int][]string
+
+	// readSets reflects the reads of the latest published incarnation of each tx
+	readSets map[int]*readSet
+}
+
+// New returns a multi-version Store on top of the given parent store, which is
+// identified by the given store key.
+func New(storeKey string, parent storetypes.KVStore) *Store {
+	return &Store{
+		storeKey: storeKey,
+		parent:   parent,
+		data: btree.NewBTreeGOptions(
+			func(a, b *keyWrites) bool { return a.key < b.key },
+			btree.Options{
+				Degree:  degree,
+				NoLocks: true,
+			}),
+		writeSets: make(map[int][]string),
+		readSets:  make(map[int]*readSet),
+	}
+}
+
+func (s *Store) GetStoreKey() string {
+	return s.storeKey
+}
+
+// NewTxStore returns a TxStore for the given incarnation of the tx with the
+// given index.
+func (s *Store) NewTxStore(txIndex, incarnation int) *TxStore {
+	return &TxStore{
+		mv:        s,
+		storeKey:  s.storeKey,
+		storeType: s.parent.GetStoreType(),
+		version:   version{txIndex: txIndex, incarnation: incarnation},
+		reads:     newReadSet(),
+		writes:    make(map[string][]byte),
+	}
+}
+
+// get returns the value of the given key visible to the given tx along with
+// the version that wrote it.
+func (s *Store) get(txIndex int, key []byte) ([]byte, version) {
+	s.mu.RLock()
+	kw, ok := s.data.Get(&keyWrites{key: string(key)})
+	if ok {
+		if w, ok := kw.latestBefore(txIndex); ok {
+			s.mu.RUnlock()
+			return w.value, w.version
+		}
+	}
+	s.mu.RUnlock()
+
+	return s.parent.Get(key), parentVersion
+}
+
+// snapshot returns the keys in the domain [start, end) that are written by txs
+// with an index lower than the given tx index, in iteration order, along with
+// the visible value and version of each key.
+func (s *Store) snapshot(txIndex int, start, end []byte, reverse bool) ([]string, [][]byte, []version) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var (
+		keys     []string
+		values   [][]byte
+		versions []version
+	)
+
+	collect := func(kw *keyWrites) bool {
+		// skip keys out of the domain until the iteration reaches it, and stop once
+		// the iteration leaves it
+		if end != nil && kw.key >= string(end) {
+			return reverse
+		}
+		if start != nil && kw.key < string(start) {
+			return !reverse
+		}
+
+		if w, ok := kw.latestBefore(txIndex); ok {
+			keys = append(keys, kw.key)
+			values = append(values, w.value)
+			versions = append(versions, w.version)
+		}
+
+		return true
+	}
+
+	switch {
+	case !reverse && start != nil:
+		s.data.Ascend(&keyWrites{key: string(start)}, collect)
+
+	case !reverse:
+		s.data.Scan(collect)
+
+	case end != nil:
+		s.data.Descend(&keyWrites{key: string(end)}, collect)
+
+	default:
+		s.data.Reverse(collect)
+	}
+
+	return keys, values, versions
+}
+
+// publish makes the writes of the given incarnation of a tx visible to other
+// txs and records its reads for validation, replacing those of any previous
+// incarnation of the tx.
+func (s *Store) publish(txStore *TxStore) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txIndex := txStore.version.txIndex
+
+	// remove the writes of the previous incarnation, if any
+	for _, key := range s.writeSets[txIndex] {
+		if _, ok := txStore.writes[key]; ok {
+			continue
+		}
+
+		kw, ok := s.data.Get(&keyWrites{key: key})
+		if !ok {
+			continue
+		}
+
+		kw.writes = slices.DeleteFunc(kw.writes, func(w write) bool { return w.version.txIndex == txIndex })
+		if len(kw.writes) == 0 {
+			s.data.Delete(kw)
+		}
+	}
+
+	keys := make([]string, 0, len(txStore.writes))
+	for key, value := range txStore.writes {
+		keys = append(keys, key)
+
+		kw, ok := s.data.Get(&keyWrites{key: key})
+		if !ok {
+			kw = &keyWrites{key: key}
+			s.data.Set(kw)
+		}
+
+		w := write{version: txStore.version, value: value}
+		i := sort.Search(len(kw.writes), func(i int) bool { return kw.writes[i].version.txIndex >= txIndex })
+		if i < len(kw.writes) && kw.writes[i].version.txIndex == txIndex {
+			kw.writes[i] = w
+		} else {
+			kw.writes = slices.Insert(kw.writes, i, w)
+		}
+	}
+
+	s.writeSets[txIndex] = keys
+	s.readSets[txIndex] = txStore.reads
+}
+
+// validate returns true if every value read by the latest published incarnation
+// of the given tx is still the value visible to it. Note, it must not be called
+// concurrently with publish.
+func (s *Store) validate(txIndex int) bool {
+	reads, ok := s.readSets[txIndex]
+	if !ok {
+		return true
+	}
+
+	for key, r := range reads.gets {
+		if _, v := s.get(txIndex, []byte(key)); v != r.version {
+			return false
+		}
+	}
+
+	for _, it := range reads.iterations {
+		if !s.validateIteration(txIndex, it) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateIteration returns true if iterating over the domain of the given
+// iteration yields the same keys, written by the same versions, as observed by
+// the tx.
+func (s *Store) validateIteration(txIndex int, it *iteration) bool {
+	itr := s.newParentIterator(txIndex, it.start, it.end, it.reverse, nil)
+	defer itr.Close()
+
+	for _, entry := range it.entries {
+		if !itr.Valid() || string(itr.key) != entry.key || itr.version != entry.version {
+			return false
+		}
+
+		itr.Next()
+	}
+
+	// if the tx exhausted the iterator, no key may have been added after the
+	// last key it observed
+	return !it.exhausted || !itr.Valid()
+}
+
+// newParentIterator returns an iterator over the values visible to the given tx,
+// i.e. the values written by txs with a lower index merged with the parent
+// store. If an iteration is provided, the observed keys are recorded into it.
+func (s *Store) newParentIterator(txIndex int, start, end []byte, reverse bool, it *iteration) *iterator {
+	keys, values, versions := s.snapshot(txIndex, start, end, reverse)
+
+	var parentItr storetypes.Iterator
+	if reverse {
+		parentItr = s.parent.ReverseIterator(start, end)
+	} else {
+		parentItr = s.parent.Iterator(start, end)
+	}
+
+	return newIterator(parentItr, start, end, keys, values, versions, reverse, it)
+}
+
+// Flush writes the latest values written by the first numTxs txs to the parent
+// store, in key order. It must only be called once all txs are validated.
+func (s *Store) Flush(numTxs int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.data.Scan(func(kw *keyWrites) bool {
+		w, ok := kw.latestBefore(numTxs)
+		if !ok {
+			return true
+		}
+
+		if w.value == nil {
+			s.parent.Delete([]byte(kw.key))
+		} else {
+			s.parent.Set([]byte(kw.key), w.value)
+		}
+
+		return true
+	})
+}
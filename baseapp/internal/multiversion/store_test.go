@@ -0,0 +1,244 @@
+package multiversion_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/internal/multiversion"
+)
+
+const storeKey = "storeKey"
+
+func newParent(t *testing.T, numKeys int) storetypes.KVStore {
+	t.Helper()
+
+	parent := &dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < numKeys; i++ {
+		parent.Set([]byte(fmt.Sprintf("key%03d", i)), encode(100))
+	}
+
+	return parent
+}
+
+func encode(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
+
+func decode(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func dump(kvStore storetypes.KVStore) map[string]string {
+	itr := kvStore.Iterator(nil, nil)
+	defer itr.Close()
+
+	state := make(map[string]string)
+	for ; itr.Valid(); itr.Next() {
+		state[string(itr.Key())] = string(itr.Value())
+	}
+
+	return state
+}
+
+// transfer moves an amount between two keys and records the total of a range
+// of keys, s.t. txs conflict through both point reads and iterators.
+func transfer(txIndex, numKeys int, kvStore storetypes.KVStore) {
+	from := []byte(fmt.Sprintf("key%03d", txIndex%numKeys))
+	to := []byte(fmt.Sprintf("key%03d", (txIndex*7+3)%numKeys))
+
+	amount := uint64(txIndex%5 + 1)
+	if balance := decode(kvStore.Get(from)); balance >= amount {
+		kvStore.Set(from, encode(balance-amount))
+		kvStore.Set(to, encode(decode(kvStore.Get(to))+amount))
+	}
+
+	if txIndex%10 == 0 {
+		kvStore.Delete([]byte(fmt.Sprintf("key%03d", (txIndex/10)%numKeys)))
+	}
+
+	if txIndex%4 == 0 {
+		var total uint64
+		itr := kvStore.Iterator([]byte("key000"), []byte("key005"))
+		for ; itr.Valid(); itr.Next() {
+			total += decode(itr.Value())
+		}
+		itr.Close()
+
+		kvStore.Set([]byte(fmt.Sprintf("total%03d", txIndex)), encode(total))
+	}
+}
+
+func TestSchedulerMatchesSequentialExecution(t *testing.T) {
+	const (
+		numKeys = 8
+		numTxs  = 200
+	)
+
+	expected := newParent(t, numKeys)
+	for txIndex := 0; txIndex < numTxs; txIndex++ {
+		transfer(txIndex, numKeys, expected)
+	}
+
+	for _, workers := range []int{1, 4, 16} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			parent := newParent(t, numKeys)
+			mv := multiversion.New(storeKey, parent)
+
+			var executions atomic.Int64
+			scheduler := multiversion.NewScheduler(workers, mv)
+			scheduler.Execute(numTxs, func(txIndex int, txStores map[string]*multiversion.TxStore) {
+				executions.Add(1)
+
+				cache := txStores[storeKey].CacheWrap().(storetypes.CacheKVStore)
+				transfer(txIndex, numKeys, cache)
+				cache.Write()
+			})
+			scheduler.Flush(numTxs)
+
+			require.GreaterOrEqual(t, executions.Load(), int64(numTxs))
+			require.Equal(t, dump(expected), dump(parent))
+		})
+	}
+}
+
+// executeConcurrently executes two txs, where the first tx only completes once
+// the first incarnation of the second tx has completed, s.t. the second tx
+// always reads stale state.
+func executeConcurrently(t *testing.T, mv *multiversion.Store, tx0, tx1 func(kvStore storetypes.KVStore)) []int {
+	t.Helper()
+
+	var (
+		mu           sync.Mutex
+		executions   = make([]int, 2)
+		tx1Completed = make(chan struct{})
+	)
+
+	scheduler := multiversion.NewScheduler(2, mv)
+	scheduler.Execute(2, func(txIndex int, txStores map[string]*multiversion.TxStore) {
+		txStore := txStores[storeKey]
+
+		switch txIndex {
+		case 0:
+			if txStore.Incarnation() == 0 {
+				<-tx1Completed
+			}
+			tx0(txStore)
+
+		case 1:
+			tx1(txStore)
+			if txStore.Incarnation() == 0 {
+				close(tx1Completed)
+			}
+		}
+
+		mu.Lock()
+		executions[txIndex]++
+		mu.Unlock()
+	})
+	scheduler.Flush(2)
+
+	return executions
+}
+
+func TestSchedulerReexecutesStaleRead(t *testing.T) {
+	parent := newParent(t, 1)
+
+	executions := executeConcurrently(t, multiversion.New(storeKey, parent),
+		func(kvStore storetypes.KVStore) {
+			kvStore.Set([]byte("key000"), encode(decode(kvStore.Get([]byte("key000")))+1))
+		},
+		func(kvStore storetypes.KVStore) {
+			kvStore.Set([]byte("key000"), encode(decode(kvStore.Get([]byte("key000")))*2))
+		},
+	)
+
+	require.Equal(t, []int{1, 2}, executions)
+	require.Equal(t, uint64(202), decode(parent.Get([]byte("key000"))))
+}
+
+func TestSchedulerReexecutesStaleIteration(t *testing.T) {
+	parent := newParent(t, 3)
+
+	executions := executeConcurrently(t, multiversion.New(storeKey, parent),
+		func(kvStore storetypes.KVStore) {
+			kvStore.Delete([]byte("key000"))
+			kvStore.Set([]byte("key001a"), encode(1))
+		},
+		func(kvStore storetypes.KVStore) {
+			var keys []byte
+			itr := kvStore.ReverseIterator(nil, []byte("key999"))
+			for ; itr.Valid(); itr.Next() {
+				keys = append(keys, itr.Key()...)
+				keys = append(keys, ',')
+			}
+			itr.Close()
+
+			kvStore.Set([]byte("keys"), keys)
+		},
+	)
+
+	require.Equal(t, []int{1, 2}, executions)
+	require.Equal(t, "key002,key001a,key001,", string(parent.Get([]byte("keys"))))
+	require.Nil(t, parent.Get([]byte("key000")))
+}
+
+func TestSchedulerSkipsUnrelatedTxs(t *testing.T) {
+	parent := newParent(t, 2)
+
+	executions := executeConcurrently(t, multiversion.New(storeKey, parent),
+		func(kvStore storetypes.KVStore) {
+			kvStore.Set([]byte("key000"), encode(1))
+		},
+		func(kvStore storetypes.KVStore) {
+			kvStore.Set([]byte("key001"), encode(decode(kvStore.Get([]byte("key001")))+1))
+		},
+	)
+
+	require.Equal(t, []int{1, 1}, executions)
+	require.Equal(t, uint64(1), decode(parent.Get([]byte("key000"))))
+	require.Equal(t, uint64(101), decode(parent.Get([]byte("key001"))))
+}
+
+func TestTxStore(t *testing.T) {
+	parent := newParent(t, 4)
+	mv := multiversion.New(storeKey, parent)
+
+	txStore := mv.NewTxStore(0, 0)
+	require.Equal(t, storeKey, txStore.GetStoreKey())
+	require.Equal(t, storetypes.StoreTypeDB, txStore.GetStoreType())
+
+	// writes are only visible to the tx until published
+	txStore.Set([]byte("key001"), encode(1))
+	txStore.Delete([]byte("key002"))
+	require.Equal(t, uint64(1), decode(txStore.Get([]byte("key001"))))
+	require.False(t, txStore.Has([]byte("key002")))
+	require.Equal(t, uint64(100), decode(parent.Get([]byte("key001"))))
+
+	itr := txStore.Iterator(nil, nil)
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	itr.Close()
+	require.Equal(t, []string{"key000", "key001", "key003"}, keys)
+
+	// writes of a cache wrap are only flushed to the tx once written
+	cache := txStore.CacheWrap().(storetypes.CacheKVStore)
+	cache.Set([]byte("key003"), encode(3))
+	require.Equal(t, uint64(100), decode(txStore.Get([]byte("key003"))))
+	cache.Write()
+	require.Equal(t, uint64(3), decode(txStore.Get([]byte("key003"))))
+}
@@ -0,0 +1,170 @@
+package multiversion
+
+import (
+	"io"
+	"slices"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.KVStore = (*TxStore)(nil)
+
+// read defines a value read by a tx along with the version that wrote it.
+type read struct {
+	value   []byte
+	version version
+}
+
+// iterationEntry defines a key observed by an iterator along with the version
+// that wrote its value.
+type iterationEntry struct {
+	key     string
+	version version
+}
+
+// iteration records the keys observed by an iterator over a domain of keys.
+type iteration struct {
+	start     []byte
+	end       []byte
+	reverse   bool
+	entries   []iterationEntry
+	exhausted bool
+}
+
+// readSet defines the values read by a tx, through both point reads and
+// iterators, which are validated once the tx completes.
+type readSet struct {
+	gets       map[string]read
+	iterations []*iteration
+}
+
+func newReadSet() *readSet {
+	return &readSet{gets: make(map[string]read)}
+}
+
+// TxStore defines the KVStore through which a single incarnation of a tx
+// accesses a multi-version Store. Writes are buffered in the write set of the
+// tx until the tx completes, whereas reads that are not served from the write
+// set are recorded in the read set of the tx. A key read more than once always
+// yields the value of the first read.
+//
+// A TxStore is not safe for concurrent use. The tx typically writes to cache
+// wraps of the TxStore, s.t. the writes of a failed tx are discarded.
+type TxStore struct {
+	mv        *Store
+	storeKey  string
+	storeType storetypes.StoreType
+	version   version
+
+	reads  *readSet
+	writes map[string][]byte
+}
+
+func (s *TxStore) GetStoreKey() string {
+	return s.storeKey
+}
+
+// GetStoreType returns the type of the parent store.
+func (s *TxStore) GetStoreType() storetypes.StoreType {
+	return s.storeType
+}
+
+// TxIndex returns the index of the tx in the block.
+func (s *TxStore) TxIndex() int {
+	return s.version.txIndex
+}
+
+// Incarnation returns the number of times the tx was executed before.
+func (s *TxStore) Incarnation() int {
+	return s.version.incarnation
+}
+
+// CacheWrap returns a cache wrap of the TxStore, which flushes its writes to the
+// write set of the tx.
+func (s *TxStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *TxStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *TxStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *TxStore) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	if value, ok := s.writes[string(key)]; ok {
+		return slices.Clone(value)
+	}
+
+	if r, ok := s.reads.gets[string(key)]; ok {
+		return slices.Clone(r.value)
+	}
+
+	value, v := s.mv.get(s.version.txIndex, key)
+	s.reads.gets[string(key)] = read{value: value, version: v}
+
+	return slices.Clone(value)
+}
+
+func (s *TxStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+
+	s.writes[string(key)] = slices.Clone(value)
+}
+
+func (s *TxStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+
+	s.writes[string(key)] = nil
+}
+
+// Iterator creates an iterator over the domain [start, end), which walks over
+// both the write set of the tx and the values visible to the tx. The keys that
+// are not served from the write set are recorded in the read set of the tx.
+func (s *TxStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.newIterator(start, end, false)
+}
+
+// ReverseIterator creates a reverse iterator over the domain [start, end). It
+// has the same properties as Iterator.
+func (s *TxStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.newIterator(start, end, true)
+}
+
+func (s *TxStore) newIterator(start, end []byte, reverse bool) storetypes.Iterator {
+	it := &iteration{
+		start:   slices.Clone(start),
+		end:     slices.Clone(end),
+		reverse: reverse,
+	}
+	s.reads.iterations = append(s.reads.iterations, it)
+
+	parentItr := s.mv.newParentIterator(s.version.txIndex, start, end, reverse, it)
+
+	keys := make([]string, 0, len(s.writes))
+	for key := range s.writes {
+		if (start == nil || key >= string(start)) && (end == nil || key < string(end)) {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+	if reverse {
+		slices.Reverse(keys)
+	}
+
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		values[i] = s.writes[key]
+	}
+
+	return newIterator(parentItr, start, end, keys, values, nil, reverse, nil)
+}
@@ -1,7 +1,6 @@
 package oe
 
 import (
-	"bytes"
 	"context"
 	"encoding/hex"
 	"math/rand"
@@ -11,6 +10,8 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // FinalizeBlockFunc is the function that is called by the OE to finalize the
@@ -19,26 +20,58 @@ type FinalizeBlockFunc func(context.Context, *abci.RequestFinalizeBlock) (*abci.
 
 // OptimisticExecution is a struct that contains the OE context. It is used to
 // run the FinalizeBlock function in a goroutine, and to abort it if needed.
+//
+// The OE keeps the executions of all the proposals accepted at the current
+// height, keyed by proposal hash, so that a proposal re-proposed in a later
+// round is not executed again, and FinalizeBlock can use the execution of the
+// decided proposal, whichever round it was proposed in. Only one execution runs
+// at a time: a running execution is aborted when another proposal is executed.
 type OptimisticExecution struct {
 	finalizeBlockFunc FinalizeBlockFunc // ABCI FinalizeBlock function with a context
 	logger            log.Logger
 
-	mtx         sync.Mutex
-	stopCh      chan struct{}
-	request     *abci.RequestFinalizeBlock
-	response    *abci.ResponseFinalizeBlock
-	err         error
-	cancelFunc  func() // cancel function for the context
-	initialized bool   // A boolean value indicating whether the struct has been initialized
+	mtx        sync.Mutex
+	executions map[string]*execution // executions of the current height, keyed by proposal hash
+	running    *execution            // the execution which is running, if any
+	current    *execution            // the execution of the last proposal, or the one matching the FinalizeBlock request
+	stats      Stats
 
 	// debugging/testing options
 	abortRate int // number from 0 to 100 that determines the percentage of OE that should be aborted
 }
 
+// execution is the optimistic execution of a single proposal.
+type execution struct {
+	request    *abci.RequestFinalizeBlock
+	response   *abci.ResponseFinalizeBlock
+	err        error
+	state      any // state the proposal is executed on, opaque to the OE
+	stopCh     chan struct{}
+	cancelFunc func() // cancel function for the context
+}
+
+// Stats contains the counters of the OE, which are also reported as telemetry
+// counters under the "oe" key.
+type Stats struct {
+	// Executions is the number of proposals executed optimistically.
+	Executions uint64
+	// Reused is the number of proposals which were not executed again because
+	// they were already executed in a previous round.
+	Reused uint64
+	// Hits is the number of FinalizeBlock requests served by an execution.
+	Hits uint64
+	// Misses is the number of FinalizeBlock requests for which no execution
+	// matched the decided proposal.
+	Misses uint64
+	// Aborted is the number of executions which were cancelled or whose result
+	// was never used.
+	Aborted uint64
+}
+
 // NewOptimisticExecution initializes the Optimistic Execution context but does not start it.
 func NewOptimisticExecution(logger log.Logger, fn FinalizeBlockFunc, opts ...func(*OptimisticExecution)) *OptimisticExecution {
 	logger = logger.With(log.ModuleKey, "oe")
-	oe := &OptimisticExecution{logger: logger, finalizeBlockFunc: fn}
+	oe := &OptimisticExecution{logger: logger, finalizeBlockFunc: fn, executions: make(map[string]*execution)}
 	for _, opt := range opts {
 		opt(oe)
 	}
@@ -54,15 +87,24 @@ func WithAbortRate(rate int) func(*OptimisticExecution) {
 	}
 }
 
-// Reset resets the OE context. Must be called whenever we want to invalidate
-// the current OE.
+// Reset resets the OE context, discarding the executions of the current height.
+// Must be called whenever we want to invalidate the current OE.
 func (oe *OptimisticExecution) Reset() {
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
-	oe.request = nil
-	oe.response = nil
-	oe.err = nil
-	oe.initialized = false
+
+	for _, exec := range oe.executions {
+		if exec != oe.current {
+			oe.incrAborted()
+		}
+	}
+	if oe.running != nil {
+		oe.running.cancelFunc()
+	}
+
+	oe.executions = make(map[string]*execution)
+	oe.running = nil
+	oe.current = nil
 }
 
 func (oe *OptimisticExecution) Enabled() bool {
@@ -70,7 +112,7 @@ func (oe *OptimisticExecution) Enabled() bool {
 }
 
 // Initialized returns true if the OE was initialized, meaning that it contains
-// a request and it was run or it is running.
+// at least one execution which was run or is running.
 func (oe *OptimisticExecution) Initialized() bool {
 	if oe == nil {
 		return false
@@ -78,45 +120,97 @@ func (oe *OptimisticExecution) Initialized() bool {
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
 
-	return oe.initialized
+	return len(oe.executions) > 0
 }
 
-// Execute initializes the OE and starts it in a goroutine.
-func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal) {
+// HasExecution returns true if the proposal with the given hash was executed,
+// or is being executed.
+func (oe *OptimisticExecution) HasExecution(hash []byte) bool {
+	if oe == nil {
+		return false
+	}
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
 
-	oe.stopCh = make(chan struct{})
-	oe.request = &abci.RequestFinalizeBlock{
-		Txs:                req.Txs,
-		DecidedLastCommit:  req.ProposedLastCommit,
-		Misbehavior:        req.Misbehavior,
-		Hash:               req.Hash,
-		Height:             req.Height,
-		Time:               req.Time,
-		NextValidatorsHash: req.NextValidatorsHash,
-		ProposerAddress:    req.ProposerAddress,
+	_, ok := oe.executions[string(hash)]
+	return ok
+}
+
+// Execute starts the execution of the proposal in a goroutine, on the provided
+// state. If the proposal was already executed, or is being executed, in a
+// previous round, the previous execution is reused and Execute is a no-op.
+// Otherwise, the running execution, if any, is aborted and waited for before
+// starting the new one.
+func (oe *OptimisticExecution) Execute(req *abci.RequestProcessProposal, state any) {
+	if oe.HasExecution(req.Hash) {
+		oe.mtx.Lock()
+		defer oe.mtx.Unlock()
+		oe.logger.Debug("OE reused", "height", req.Height, "hash", hex.EncodeToString(req.Hash))
+		oe.current = oe.executions[string(req.Hash)]
+		oe.stats.Reused++
+		telemetry.IncrCounter(1, "oe", "reused")
+		return
 	}
 
-	oe.logger.Debug("OE started", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "time", req.Time.String())
+	oe.Abort()
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+
 	ctx, cancel := context.WithCancel(context.Background())
-	oe.cancelFunc = cancel
-	oe.initialized = true
+	exec := &execution{
+		request: &abci.RequestFinalizeBlock{
+			Txs:                req.Txs,
+			DecidedLastCommit:  req.ProposedLastCommit,
+			Misbehavior:        req.Misbehavior,
+			Hash:               req.Hash,
+			Height:             req.Height,
+			Time:               req.Time,
+			NextValidatorsHash: req.NextValidatorsHash,
+			ProposerAddress:    req.ProposerAddress,
+		},
+		state:      state,
+		stopCh:     make(chan struct{}),
+		cancelFunc: cancel,
+	}
+	oe.executions[string(req.Hash)] = exec
+	oe.running = exec
+	oe.current = exec
+	oe.stats.Executions++
+	telemetry.IncrCounter(1, "oe", "executions")
+
+	oe.logger.Debug("OE started", "height", req.Height, "hash", hex.EncodeToString(req.Hash), "time", req.Time.String())
 
 	go func() {
 		start := time.Now()
-		resp, err := oe.finalizeBlockFunc(ctx, oe.request)
+		resp, err := oe.finalizeBlockFunc(ctx, exec.request)
 		oe.mtx.Lock()
 		executionTime := time.Since(start)
 		oe.logger.Debug("OE finished", "duration", executionTime.String(), "height", req.Height, "hash", hex.EncodeToString(req.Hash))
-		oe.response, oe.err = resp, err
-		close(oe.stopCh)
+		exec.response, exec.err = resp, err
+
+		// an aborted execution is incomplete and cannot be reused
+		if ctx.Err() != nil {
+			if oe.executions[string(req.Hash)] == exec {
+				delete(oe.executions, string(req.Hash))
+				oe.incrAborted()
+			}
+			if oe.current == exec {
+				oe.current = nil
+			}
+		}
+		if oe.running == exec {
+			oe.running = nil
+		}
+		close(exec.stopCh)
 		oe.mtx.Unlock()
 	}()
 }
 
-// AbortIfNeeded aborts the OE if the request hash is not the same as the one in
-// the running OE. Returns true if the OE was aborted.
+// AbortIfNeeded selects the execution of the proposal with the given hash, to
+// be returned by WaitResult, and aborts the running execution if it is another
+// one. Returns true if no execution of the proposal exists, in which case the
+// block must be executed again.
 func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 	if oe == nil {
 		return false
@@ -125,33 +219,95 @@ func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 	oe.mtx.Lock()
 	defer oe.mtx.Unlock()
 
-	if !bytes.Equal(oe.request.Hash, reqHash) {
-		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(oe.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", oe.request.Height, "req_height", oe.request.Height)
-		oe.cancelFunc()
-		return true
-	} else if oe.abortRate > 0 && rand.Intn(100) < oe.abortRate {
+	exec, ok := oe.executions[string(reqHash)]
+	switch {
+	case !ok:
+		oe.logger.Error("OE aborted due to hash mismatch", "req_hash", hex.EncodeToString(reqHash), "executions", len(oe.executions))
+		oe.stats.Misses++
+		telemetry.IncrCounter(1, "oe", "misses")
+		exec = nil
+
+	case oe.abortRate > 0 && rand.Intn(100) < oe.abortRate:
 		// this is for test purposes only, we can emulate a certain percentage of
 		// OE needed to be aborted.
-		oe.cancelFunc()
 		oe.logger.Error("OE aborted due to test abort rate")
-		return true
+		oe.stats.Misses++
+		telemetry.IncrCounter(1, "oe", "misses")
+		exec = nil
+
+	default:
+		oe.stats.Hits++
+		telemetry.IncrCounter(1, "oe", "hits")
 	}
 
-	return false
+	if oe.running != nil && oe.running != exec {
+		oe.running.cancelFunc()
+	}
+	oe.current = exec
+
+	return exec == nil
 }
 
-// Abort aborts the OE unconditionally and waits for it to finish.
+// Abort aborts the running execution, if any, and waits for it to finish. The
+// executions which are finished are kept.
 func (oe *OptimisticExecution) Abort() {
-	if oe == nil || oe.cancelFunc == nil {
+	if oe == nil {
 		return
 	}
 
-	oe.cancelFunc()
-	<-oe.stopCh
+	oe.mtx.Lock()
+	running := oe.running
+	if running != nil {
+		running.cancelFunc()
+	}
+	oe.mtx.Unlock()
+
+	if running != nil {
+		<-running.stopCh
+	}
 }
 
-// WaitResult waits for the OE to finish and returns the result.
+// WaitResult waits for the running execution to finish and returns the result
+// of the execution selected by AbortIfNeeded, or of the last executed proposal
+// if AbortIfNeeded was not called.
 func (oe *OptimisticExecution) WaitResult() (*abci.ResponseFinalizeBlock, error) {
-	<-oe.stopCh
-	return oe.response, oe.err
+	oe.mtx.Lock()
+	running := oe.running
+	oe.mtx.Unlock()
+
+	if running != nil {
+		<-running.stopCh
+	}
+
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+	if oe.current == nil {
+		return nil, nil
+	}
+
+	return oe.current.response, oe.current.err
+}
+
+// State returns the state the execution selected by AbortIfNeeded was run on,
+// or nil if there is none.
+func (oe *OptimisticExecution) State() any {
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+	if oe.current == nil {
+		return nil
+	}
+
+	return oe.current.state
+}
+
+// Stats returns the counters of the OE.
+func (oe *OptimisticExecution) Stats() Stats {
+	oe.mtx.Lock()
+	defer oe.mtx.Unlock()
+	return oe.stats
+}
+
+func (oe *OptimisticExecution) incrAborted() {
+	oe.stats.Aborted++
+	telemetry.IncrCounter(1, "oe", "aborted")
 }
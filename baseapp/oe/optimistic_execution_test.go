@@ -3,6 +3,7 @@ package oe
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -20,7 +21,7 @@ func TestOptimisticExecution(t *testing.T) {
 	assert.True(t, oe.Enabled())
 	oe.Execute(&abci.RequestProcessProposal{
 		Hash: []byte("test"),
-	})
+	}, nil)
 	assert.True(t, oe.Initialized())
 
 	resp, err := oe.WaitResult()
@@ -32,3 +33,55 @@ func TestOptimisticExecution(t *testing.T) {
 
 	oe.Reset()
 }
+
+func TestOptimisticExecution_Reuse(t *testing.T) {
+	var calls atomic.Int32
+	block := make(chan struct{})
+	finalizeBlock := func(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+		calls.Add(1)
+		if string(req.Hash) == "slow" {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-block:
+			}
+		}
+		return &abci.ResponseFinalizeBlock{AppHash: req.Hash}, nil
+	}
+
+	oe := NewOptimisticExecution(log.NewNopLogger(), finalizeBlock)
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("a")}, "state_a")
+	_, _ = oe.WaitResult()
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("b")}, "state_b")
+	_, _ = oe.WaitResult()
+
+	// the execution of a re-proposal is reused
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("a")}, "state_a2")
+	assert.True(t, oe.HasExecution([]byte("a")))
+	assert.Equal(t, int32(2), calls.Load())
+
+	// a running execution is aborted by the execution of another proposal
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("slow")}, "state_slow")
+	assert.True(t, oe.HasExecution([]byte("slow")))
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("c")}, "state_c")
+	assert.False(t, oe.HasExecution([]byte("slow")))
+
+	assert.False(t, oe.AbortIfNeeded([]byte("b")))
+	resp, err := oe.WaitResult()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("b"), resp.AppHash)
+	assert.Equal(t, "state_b", oe.State())
+
+	oe.Reset()
+	assert.False(t, oe.Initialized())
+	assert.Equal(t, Stats{Executions: 4, Reused: 1, Hits: 1, Aborted: 3}, oe.Stats())
+
+	// no execution matches the decided proposal
+	oe.Execute(&abci.RequestProcessProposal{Hash: []byte("d")}, "state_d")
+	assert.True(t, oe.AbortIfNeeded([]byte("e")))
+	resp, err = oe.WaitResult()
+	assert.Nil(t, resp)
+	assert.NoError(t, err)
+	assert.Nil(t, oe.State())
+	assert.Equal(t, uint64(1), oe.Stats().Misses)
+}
@@ -114,6 +114,23 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetParallelTxExecution enables the optimistic parallel execution of the txs of
+// a block during FinalizeBlock with the given number of workers. Txs that read
+// state written by a tx earlier in the block are re-executed, s.t. the resulting
+// state and tx results match those of a sequential execution. All msg handlers,
+// ante and post handlers must hence be safe for concurrent use and must only
+// access state through the Context. This is experimental and must be enabled by
+// developers.
+func SetParallelTxExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.parallelTxWorkers = workers }
+}
+
+// SetTxBundles enables the atomic execution of tx bundles. The TxDecoder must
+// also decode them, e.g. the one of a x/auth/tx TxConfig with EnableTxBundles.
+func SetTxBundles(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.txBundlesEnabled = enabled }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
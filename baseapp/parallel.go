@@ -0,0 +1,150 @@
+package baseapp
+
+import (
+	"context"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"golang.org/x/exp/maps"
+
+	"cosmossdk.io/store/cachemulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/internal/multiversion"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelTxOutcome reflects the outcome of the latest execution of a tx
+// executed in parallel.
+type parallelTxOutcome struct {
+	decodeErr    bool
+	gInfo        sdk.GasInfo
+	result       *sdk.Result
+	anteEvents   []abci.Event
+	err          error
+	blockGasUsed uint64
+}
+
+// executeTxsParallel executes the given raw transactions optimistically in
+// parallel against a multi-version store of every store of the block state,
+// gathering the execution results.
+//
+// Each tx is executed with its own block gas meter. Once all txs are validated,
+// the gas they consumed is accounted to the block gas meter in order. The state
+// of the txs preceding the first tx that would have run out of block gas, if
+// any, is committed, and the remaining txs are executed sequentially, s.t. the
+// results match those of a sequential execution.
+func (app *BaseApp) executeTxsParallel(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok || app.finalizeBlockState.ms.TracingEnabled() || len(txs) < 2 {
+		return app.executeTxs(ctx, txs)
+	}
+
+	storeKeys := cms.StoreKeysByName()
+	names := maps.Keys(storeKeys)
+	slices.Sort(names)
+
+	stores := make([]*multiversion.Store, len(names))
+	for i, name := range names {
+		stores[i] = multiversion.New(name, app.finalizeBlockState.ms.GetKVStore(storeKeys[name]))
+	}
+
+	outcomes := make([]parallelTxOutcome, len(txs))
+
+	scheduler := multiversion.NewScheduler(app.parallelTxWorkers, stores...)
+	scheduler.Execute(len(txs), func(txIndex int, txStores map[string]*multiversion.TxStore) {
+		txBytes := txs[txIndex]
+
+		// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
+		// vote extensions, so skip those.
+		if _, err := app.txDecoder(txBytes); err != nil {
+			outcomes[txIndex] = parallelTxOutcome{decodeErr: true}
+			return
+		}
+
+		kvStores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(txStores))
+		for name, txStore := range txStores {
+			kvStores[storeKeys[name]] = txStore
+		}
+
+		ms := cachemulti.NewStore(dbm.NewMemDB(), kvStores, storeKeys, nil, nil)
+		blockGasMeter := storetypes.NewInfiniteGasMeter()
+		txCtx := app.getContextForTx(execModeFinalize, txBytes).
+			WithMultiStore(ms).
+			WithEventManager(sdk.NewEventManager()).
+			WithGasMeter(storetypes.NewInfiniteGasMeter()).
+			WithBlockGasMeter(blockGasMeter)
+
+		gInfo, result, anteEvents, err := app.runTxWithContext(txCtx, execModeFinalize, txBytes)
+
+		// flush the writes committed by runTx to the write sets of the tx
+		ms.Write()
+		outcomes[txIndex] = parallelTxOutcome{
+			gInfo:        gInfo,
+			result:       result,
+			anteEvents:   anteEvents,
+			err:          err,
+			blockGasUsed: blockGasMeter.GasConsumed(),
+		}
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// continue
+	}
+
+	// account the block gas consumed by each tx in order, stopping at the first
+	// tx whose outcome depends on the block gas consumed by the preceding txs
+	blockGasMeter := app.finalizeBlockState.ctx.BlockGasMeter()
+	numTxs := len(txs)
+	for i, outcome := range outcomes {
+		if outcome.decodeErr {
+			continue
+		}
+
+		if blockGasMeter.IsOutOfGas() || outcome.blockGasUsed > blockGasMeter.Limit()-blockGasMeter.GasConsumed() {
+			numTxs = i
+			break
+		}
+
+		blockGasMeter.ConsumeGas(outcome.blockGasUsed, "block gas meter")
+	}
+
+	scheduler.Flush(numTxs)
+
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, outcome := range outcomes[:numTxs] {
+		if outcome.decodeErr {
+			// In the case where a transaction included in a block proposal is malformed,
+			// we still want to return a default response to comet. This is because comet
+			// expects a response for each transaction included in a block proposal.
+			txResults = append(txResults, sdkerrors.ResponseExecTxResultWithEvents(
+				sdkerrors.ErrTxDecode,
+				0,
+				0,
+				nil,
+				false,
+			))
+			continue
+		}
+
+		txResults = append(txResults, app.execTxResult(outcome.gInfo, outcome.result, outcome.anteEvents, outcome.err))
+	}
+
+	if numTxs < len(txs) {
+		remaining, err := app.executeTxs(ctx, txs[numTxs:])
+		if err != nil {
+			return nil, err
+		}
+
+		txResults = append(txResults, remaining...)
+	}
+
+	return txResults, nil
+}
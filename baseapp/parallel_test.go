@@ -0,0 +1,165 @@
+package baseapp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelCounterServerImpl increments a counter shared by all txs, failing if
+// the counter of the msg does not match the stored counter. Unlike
+// CounterServerImpl, it does not assert the stored counter, as txs executed in
+// parallel may read stale state before being re-executed.
+type parallelCounterServerImpl struct {
+	counterKey []byte
+}
+
+func (m parallelCounterServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey1)
+
+	var stored int64
+	if bz := store.Get(m.counterKey); bz != nil {
+		stored = int64(sdk.BigEndianToUint64(bz))
+	}
+
+	if stored != msg.Counter {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidSequence, "expected counter %d, got %d", stored, msg.Counter)
+	}
+
+	store.Set(m.counterKey, sdk.Uint64ToBigEndian(uint64(stored+1)))
+	sdkCtx.EventManager().EmitEvents(counterEvent(sdk.EventTypeMessage, msg.Counter))
+
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+// newParallelTxs returns a block of txs conflicting through a shared counter,
+// interleaved with independent txs, a tx failing on its handler and a tx that
+// cannot be decoded.
+func newParallelTxs(t *testing.T) [][]byte {
+	t.Helper()
+
+	suite := NewBaseAppSuite(t)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	var (
+		txs     [][]byte
+		counter int64
+	)
+
+	for i := 0; i < 30; i++ {
+		var msg sdk.Msg
+		switch {
+		case i%3 == 0:
+			msg = &baseapptestutil.MsgKeyValue{
+				Key:    []byte(fmt.Sprintf("key%d", i)),
+				Value:  []byte(fmt.Sprintf("value%d", i)),
+				Signer: addr.String(),
+			}
+
+		case i == 10:
+			// the counter is stale
+			msg = &baseapptestutil.MsgCounter{Counter: counter - 1, Signer: addr.String()}
+
+		default:
+			msg = &baseapptestutil.MsgCounter{Counter: counter, Signer: addr.String()}
+			counter++
+		}
+
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		setTxSignature(t, builder, uint64(i))
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		txs = append(txs, txBytes)
+	}
+
+	return append(txs, []byte("invalid tx"))
+}
+
+func finalizeParallelTxs(t *testing.T, txs [][]byte, maxGas int64, opts ...func(*baseapp.BaseApp)) *abci.ResponseFinalizeBlock {
+	t.Helper()
+
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(storetypes.NewGasMeter(100000)), nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, append(opts, anteOpt)...)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), parallelCounterServerImpl{[]byte("counter")})
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: maxGas},
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs:    txs,
+	})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	return res
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution(t *testing.T) {
+	txs := newParallelTxs(t)
+
+	sequential := finalizeParallelTxs(t, txs, -1)
+
+	require.Len(t, sequential.TxResults, 31)
+	for i, res := range sequential.TxResults {
+		switch i {
+		case 10:
+			require.Equal(t, sdkerrors.ErrInvalidSequence.ABCICode(), res.Code)
+		case 30:
+			require.Equal(t, sdkerrors.ErrTxDecode.ABCICode(), res.Code)
+		default:
+			require.True(t, res.IsOK(), res.Log)
+		}
+	}
+
+	// the block gas limit is reached by the 20th tx
+	var blockGas int64
+	for _, res := range sequential.TxResults[:20] {
+		blockGas += res.GasUsed
+	}
+
+	limited := finalizeParallelTxs(t, txs, blockGas-1)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), limited.TxResults[19].Code)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), limited.TxResults[20].Code)
+
+	for _, workers := range []int{2, 8} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			parallel := finalizeParallelTxs(t, txs, -1, baseapp.SetParallelTxExecution(workers))
+			require.Equal(t, sequential.TxResults, parallel.TxResults)
+			require.Equal(t, sequential.AppHash, parallel.AppHash)
+
+			parallel = finalizeParallelTxs(t, txs, blockGas-1, baseapp.SetParallelTxExecution(workers))
+			require.Equal(t, limited.TxResults, parallel.TxResults)
+			require.Equal(t, limited.AppHash, parallel.AppHash)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// The events emitted by x/bank for every change of a balance, from which the
+// balance deltas of a state diff are derived.
+const (
+	eventTypeCoinSpent    = "coin_spent"
+	eventTypeCoinReceived = "coin_received"
+	attributeKeySpender   = "spender"
+	attributeKeyReceiver  = "receiver"
+	attributeKeyAmount    = "amount"
+)
+
+// SimulateWithStateDiff executes a tx in simulate mode like Simulate, and also
+// returns the state diff of the tx. The writes of the tx to the persistent
+// stores are captured from the branch of the check state the tx is executed
+// on, and the balance deltas are derived from the events of the tx.
+//
+// The state diff is only returned if the tx is executed successfully.
+func (app *BaseApp) SimulateWithStateDiff(txBytes []byte) (sdk.GasInfo, *sdk.Result, *txtypes.StateDiff, error) {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return sdk.GasInfo{}, nil, nil, errorsmod.Wrap(sdkerrors.ErrNotSupported, "state diff is not supported by the multi-store")
+	}
+
+	ctx := app.getContextForTx(execModeSimulate, txBytes)
+
+	storeKeys := cms.StoreKeysByName()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(storeKeys))
+	diffStores := make([]*stateDiffKVStore, 0, len(storeKeys))
+	for name, key := range storeKeys {
+		store := &stateDiffKVStore{
+			parent:   ctx.MultiStore().GetKVStore(key),
+			storeKey: name,
+			writes:   make(map[string]*txtypes.StoreWrite),
+		}
+		stores[key] = store
+
+		// only the writes to the persistent stores are part of the state diff
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			diffStores = append(diffStores, store)
+		}
+	}
+
+	ms := cachemulti.NewStore(dbm.NewMemDB(), stores, storeKeys, nil, nil)
+	gInfo, result, _, err := app.runTxWithContext(ctx.WithMultiStore(ms), execModeSimulate, txBytes)
+	if err != nil {
+		return gInfo, result, nil, err
+	}
+
+	// flush the writes committed by runTx to the state diff stores
+	ms.Write()
+
+	sort.Slice(diffStores, func(i, j int) bool {
+		return diffStores[i].storeKey < diffStores[j].storeKey
+	})
+
+	stateDiff := &txtypes.StateDiff{}
+	for _, store := range diffStores {
+		stateDiff.Writes = append(stateDiff.Writes, store.stateChanges()...)
+	}
+
+	stateDiff.BalanceDeltas, err = balanceDeltas(result.Events)
+	if err != nil {
+		return gInfo, result, nil, err
+	}
+
+	return gInfo, result, stateDiff, nil
+}
+
+// balanceDeltas returns the balance deltas, sorted by address and denom,
+// resulting from the coin_spent and coin_received events.
+func balanceDeltas(events []abci.Event) ([]*txtypes.BalanceDelta, error) {
+	deltas := make(map[string]map[string]math.Int)
+	for _, event := range events {
+		var addressKey string
+		switch event.Type {
+		case eventTypeCoinSpent:
+			addressKey = attributeKeySpender
+		case eventTypeCoinReceived:
+			addressKey = attributeKeyReceiver
+		default:
+			continue
+		}
+
+		var address, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addressKey:
+				address = attr.Value
+			case attributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid amount of %s event", event.Type)
+		}
+
+		if deltas[address] == nil {
+			deltas[address] = make(map[string]math.Int)
+		}
+		for _, coin := range coins {
+			delta, ok := deltas[address][coin.Denom]
+			if !ok {
+				delta = math.ZeroInt()
+			}
+			if event.Type == eventTypeCoinSpent {
+				deltas[address][coin.Denom] = delta.Sub(coin.Amount)
+			} else {
+				deltas[address][coin.Denom] = delta.Add(coin.Amount)
+			}
+		}
+	}
+
+	var balanceDeltas []*txtypes.BalanceDelta
+	for address, denoms := range deltas {
+		for denom, amount := range denoms {
+			if amount.IsZero() {
+				continue
+			}
+
+			balanceDeltas = append(balanceDeltas, &txtypes.BalanceDelta{Address: address, Denom: denom, Amount: amount})
+		}
+	}
+
+	sort.Slice(balanceDeltas, func(i, j int) bool {
+		if balanceDeltas[i].Address != balanceDeltas[j].Address {
+			return balanceDeltas[i].Address < balanceDeltas[j].Address
+		}
+		return balanceDeltas[i].Denom < balanceDeltas[j].Denom
+	})
+
+	return balanceDeltas, nil
+}
+
+var _ storetypes.KVStore = (*stateDiffKVStore)(nil)
+
+// stateDiffKVStore wraps a KVStore of the state a simulated tx is executed on,
+// recording the writes to it along with the values they overwrite.
+type stateDiffKVStore struct {
+	parent   storetypes.KVStore
+	storeKey string
+	writes   map[string]*txtypes.StoreWrite
+}
+
+// stateChanges returns the writes to the store which change its state, sorted
+// by key.
+func (s *stateDiffKVStore) stateChanges() []*txtypes.StoreWrite {
+	changes := make([]*txtypes.StoreWrite, 0, len(s.writes))
+	for _, write := range s.writes {
+		if write.Delete && write.OldValue == nil || !write.Delete && bytes.Equal(write.OldValue, write.NewValue) {
+			continue
+		}
+
+		changes = append(changes, write)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+
+	return changes
+}
+
+func (s *stateDiffKVStore) record(key, value []byte, isDelete bool) {
+	write, ok := s.writes[string(key)]
+	if !ok {
+		write = &txtypes.StoreWrite{
+			StoreKey: s.storeKey,
+			Key:      slices.Clone(key),
+			OldValue: slices.Clone(s.parent.Get(key)),
+		}
+		s.writes[string(key)] = write
+	}
+
+	write.NewValue = slices.Clone(value)
+	write.Delete = isDelete
+}
+
+func (s *stateDiffKVStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+func (s *stateDiffKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *stateDiffKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *stateDiffKVStore) Get(key []byte) []byte {
+	return s.parent.Get(key)
+}
+
+func (s *stateDiffKVStore) Has(key []byte) bool {
+	return s.parent.Has(key)
+}
+
+func (s *stateDiffKVStore) Set(key, value []byte) {
+	s.record(key, value, false)
+	s.parent.Set(key, value)
+}
+
+func (s *stateDiffKVStore) Delete(key []byte) {
+	s.record(key, nil, true)
+	s.parent.Delete(key)
+}
+
+func (s *stateDiffKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.parent.Iterator(start, end)
+}
+
+func (s *stateDiffKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.parent.ReverseIterator(start, end)
+}
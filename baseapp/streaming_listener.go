@@ -0,0 +1,411 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// maxStreamingMsgSize is the maximum size of a message read from a streamed
+// block file.
+const maxStreamingMsgSize = 1 << 30
+
+// StreamingBlock contains the data of a committed block, as streamed to a
+// StreamingListener.
+type StreamingBlock struct {
+	RequestFinalizeBlock  *abci.RequestFinalizeBlock
+	ResponseFinalizeBlock *abci.ResponseFinalizeBlock
+	ResponseCommit        *abci.ResponseCommit
+	// StateChanges are the state changes of the block, in the stores exposed to
+	// the listener.
+	StateChanges []*storetypes.StoreKVPair
+}
+
+// Height returns the height of the block.
+func (b *StreamingBlock) Height() int64 {
+	if b.RequestFinalizeBlock == nil {
+		return 0
+	}
+	return b.RequestFinalizeBlock.Height
+}
+
+// StreamingListener is an in-process listener of the committed blocks. Unlike
+// the ABCIListener plugins, it is called asynchronously, after Commit, by a
+// StreamingListenerService, so that a slow listener does not slow down the
+// consensus. The callbacks are called for each block, in block order.
+type StreamingListener interface {
+	// OnStateChanges is called with the state changes of a committed block.
+	OnStateChanges(height int64, changes []*storetypes.StoreKVPair) error
+	// OnEvents is called with the FinalizeBlock events of a committed block and
+	// with the results of its transactions, which contain the transaction events.
+	OnEvents(height int64, events []abci.Event, txResults []*abci.ExecTxResult) error
+	// OnCommit is called last, with the whole committed block.
+	OnCommit(block *StreamingBlock) error
+}
+
+// StreamingCallbacks implements StreamingListener with optional callbacks.
+type StreamingCallbacks struct {
+	StateChanges func(height int64, changes []*storetypes.StoreKVPair) error
+	Events       func(height int64, events []abci.Event, txResults []*abci.ExecTxResult) error
+	Commit       func(block *StreamingBlock) error
+}
+
+var _ StreamingListener = StreamingCallbacks{}
+
+func (c StreamingCallbacks) OnStateChanges(height int64, changes []*storetypes.StoreKVPair) error {
+	if c.StateChanges == nil {
+		return nil
+	}
+	return c.StateChanges(height, changes)
+}
+
+func (c StreamingCallbacks) OnEvents(height int64, events []abci.Event, txResults []*abci.ExecTxResult) error {
+	if c.Events == nil {
+		return nil
+	}
+	return c.Events(height, events, txResults)
+}
+
+func (c StreamingCallbacks) OnCommit(block *StreamingBlock) error {
+	if c.Commit == nil {
+		return nil
+	}
+	return c.Commit(block)
+}
+
+// Backpressure defines what a StreamingListenerService does with a committed
+// block when its buffer is full, because the listener is slower than the chain.
+type Backpressure string
+
+const (
+	// BackpressureBlock blocks Commit until the listener processes a block.
+	BackpressureBlock Backpressure = "block"
+	// BackpressureDrop drops the block, which is never streamed to the listener.
+	BackpressureDrop Backpressure = "drop"
+	// BackpressureSpill writes the block to the spill directory, from which it is
+	// streamed to the listener once the listener catches up.
+	BackpressureSpill Backpressure = "spill"
+)
+
+// StreamingListenerConfig defines the configuration of a StreamingListenerService.
+type StreamingListenerConfig struct {
+	// BufferSize is the number of blocks buffered in memory for the listener.
+	// It defaults to 16.
+	BufferSize int
+	// Backpressure defines what to do with a block when the buffer is full. It
+	// defaults to BackpressureBlock.
+	Backpressure Backpressure
+	// SpillDir is the directory the blocks are spilled to with BackpressureSpill.
+	SpillDir string
+}
+
+// StreamingListenerService streams the committed blocks to a StreamingListener
+// in a separate goroutine. It implements storetypes.ABCIListener, so that it
+// can be registered with the StreamingManager of the BaseApp, see
+// BaseApp.RegisterStreamingListener.
+type StreamingListenerService struct {
+	listener StreamingListener
+	cfg      StreamingListenerConfig
+	logger   log.Logger
+
+	// pending holds the FinalizeBlock messages of the block being committed
+	pending *StreamingBlock
+
+	mtx     sync.Mutex
+	cond    *sync.Cond
+	buffer  []*StreamingBlock
+	spilled []int64 // heights of the spilled blocks, in order
+	dropped uint64
+	closed  bool
+	done    chan struct{}
+}
+
+var _ storetypes.ABCIListener = (*StreamingListenerService)(nil)
+
+// NewStreamingListenerService returns a new StreamingListenerService, which
+// starts streaming to the listener right away.
+func NewStreamingListenerService(listener StreamingListener, cfg StreamingListenerConfig, logger log.Logger) (*StreamingListenerService, error) {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = 16
+	}
+
+	switch cfg.Backpressure {
+	case "":
+		cfg.Backpressure = BackpressureBlock
+	case BackpressureBlock, BackpressureDrop:
+	case BackpressureSpill:
+		if cfg.SpillDir == "" {
+			return nil, errors.New("spill directory is required to spill blocks")
+		}
+		if err := os.MkdirAll(cfg.SpillDir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create spill directory: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown backpressure %q", cfg.Backpressure)
+	}
+
+	s := &StreamingListenerService{
+		listener: listener,
+		cfg:      cfg,
+		logger:   logger.With(log.ModuleKey, "streaming"),
+		done:     make(chan struct{}),
+	}
+	s.cond = sync.NewCond(&s.mtx)
+	go s.run()
+
+	return s, nil
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (s *StreamingListenerService) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	s.pending = &StreamingBlock{RequestFinalizeBlock: &req, ResponseFinalizeBlock: &res}
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. It enqueues the committed
+// block, applying the configured backpressure if the buffer is full.
+func (s *StreamingListenerService) ListenCommit(_ context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	block := s.pending
+	if block == nil {
+		block = &StreamingBlock{}
+	}
+	s.pending = nil
+	block.ResponseCommit = &res
+	block.StateChanges = changeSet
+
+	return s.enqueue(block)
+}
+
+func (s *StreamingListenerService) enqueue(block *StreamingBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.closed {
+		return errors.New("streaming listener service is closed")
+	}
+
+	// blocks are buffered only if no block is spilled, to preserve their order
+	if len(s.spilled) == 0 && len(s.buffer) < s.cfg.BufferSize {
+		s.buffer = append(s.buffer, block)
+		s.cond.Broadcast()
+		return nil
+	}
+
+	switch s.cfg.Backpressure {
+	case BackpressureDrop:
+		s.dropped++
+		s.logger.Error("streaming listener buffer is full, dropping block", "height", block.Height(), "dropped", s.dropped)
+		return nil
+
+	case BackpressureSpill:
+		if err := writeStreamingBlockFile(s.spillPath(block.Height()), block); err != nil {
+			return fmt.Errorf("failed to spill block %d: %w", block.Height(), err)
+		}
+		s.spilled = append(s.spilled, block.Height())
+		s.cond.Broadcast()
+		return nil
+
+	default:
+		for len(s.buffer) >= s.cfg.BufferSize && !s.closed {
+			s.cond.Wait()
+		}
+		s.buffer = append(s.buffer, block)
+		s.cond.Broadcast()
+		return nil
+	}
+}
+
+// run streams the enqueued blocks to the listener until the service is closed
+// and all the enqueued blocks are streamed.
+func (s *StreamingListenerService) run() {
+	defer close(s.done)
+
+	for {
+		s.mtx.Lock()
+		for len(s.buffer) == 0 && len(s.spilled) == 0 && !s.closed {
+			s.cond.Wait()
+		}
+
+		var (
+			block *StreamingBlock
+			err   error
+		)
+		switch {
+		case len(s.buffer) > 0:
+			block = s.buffer[0]
+			s.buffer = s.buffer[1:]
+
+		case len(s.spilled) > 0:
+			path := s.spillPath(s.spilled[0])
+			s.spilled = s.spilled[1:]
+			block, err = readStreamingBlockFile(path)
+			if err == nil {
+				err = os.Remove(path)
+			}
+
+		default:
+			s.mtx.Unlock()
+			return
+		}
+		// wake up Commit if it is blocked on a full buffer
+		s.cond.Broadcast()
+		s.mtx.Unlock()
+
+		if err != nil {
+			s.logger.Error("failed to read spilled block", "err", err)
+			continue
+		}
+
+		if err := s.stream(block); err != nil {
+			s.logger.Error("streaming listener failed", "height", block.Height(), "err", err)
+		}
+	}
+}
+
+func (s *StreamingListenerService) stream(block *StreamingBlock) error {
+	height := block.Height()
+	if err := s.listener.OnStateChanges(height, block.StateChanges); err != nil {
+		return err
+	}
+
+	if res := block.ResponseFinalizeBlock; res != nil {
+		if err := s.listener.OnEvents(height, res.Events, res.TxResults); err != nil {
+			return err
+		}
+	}
+
+	return s.listener.OnCommit(block)
+}
+
+// Dropped returns the number of blocks dropped because the buffer was full.
+func (s *StreamingListenerService) Dropped() uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.dropped
+}
+
+// Close stops accepting blocks and waits until all the enqueued blocks, including
+// the spilled ones, are streamed to the listener.
+func (s *StreamingListenerService) Close() error {
+	s.mtx.Lock()
+	s.closed = true
+	s.cond.Broadcast()
+	s.mtx.Unlock()
+
+	<-s.done
+	return nil
+}
+
+func (s *StreamingListenerService) spillPath(height int64) string {
+	return filepath.Join(s.cfg.SpillDir, fmt.Sprintf("block-%d.pb", height))
+}
+
+// RegisterStreamingListener registers an in-process StreamingListener with the
+// BaseApp, which streams to the listener the committed blocks and the state
+// changes of the given stores. The returned service must be closed on shutdown,
+// to stream the blocks which are still enqueued.
+func (app *BaseApp) RegisterStreamingListener(
+	listener StreamingListener,
+	keys []storetypes.StoreKey,
+	cfg StreamingListenerConfig,
+) (*StreamingListenerService, error) {
+	service, err := NewStreamingListenerService(listener, cfg, app.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	app.cms.AddListeners(keys)
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, service)
+
+	return service, nil
+}
+
+// WriteStreamingBlock writes the block to w as length-prefixed protobuf
+// messages: a storetypes.BlockMetadata followed by the state changes of the
+// block, one storetypes.StoreKVPair each.
+func WriteStreamingBlock(w io.Writer, block *StreamingBlock) error {
+	writer := protoio.NewDelimitedWriter(w)
+	err := writer.WriteMsg(&storetypes.BlockMetadata{
+		RequestFinalizeBlock:  block.RequestFinalizeBlock,
+		ResponseFinalizeBlock: block.ResponseFinalizeBlock,
+		ResponseCommit:        block.ResponseCommit,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range block.StateChanges {
+		if err := writer.WriteMsg(pair); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadStreamingBlock reads a block written by WriteStreamingBlock from r, until
+// the end of r.
+func ReadStreamingBlock(r io.Reader) (*StreamingBlock, error) {
+	reader := protoio.NewDelimitedReader(r, maxStreamingMsgSize)
+
+	var metadata storetypes.BlockMetadata
+	if err := reader.ReadMsg(&metadata); err != nil {
+		return nil, err
+	}
+
+	block := &StreamingBlock{
+		RequestFinalizeBlock:  metadata.RequestFinalizeBlock,
+		ResponseFinalizeBlock: metadata.ResponseFinalizeBlock,
+		ResponseCommit:        metadata.ResponseCommit,
+	}
+	for {
+		pair := new(storetypes.StoreKVPair)
+		err := reader.ReadMsg(pair)
+		if errors.Is(err, io.EOF) {
+			return block, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		block.StateChanges = append(block.StateChanges, pair)
+	}
+}
+
+// writeStreamingBlockFile writes the block to the file at path, atomically.
+func writeStreamingBlockFile(path string, block *StreamingBlock) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if err := WriteStreamingBlock(f, block); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+func readStreamingBlockFile(path string) (*StreamingBlock, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadStreamingBlock(f)
+}
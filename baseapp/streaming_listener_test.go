@@ -0,0 +1,237 @@
+package baseapp_test
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+// recordingListener records the blocks streamed to it, optionally blocking on
+// each block until released.
+type recordingListener struct {
+	mtx     sync.Mutex
+	heights []int64
+	started chan struct{}
+	release chan struct{}
+}
+
+func newRecordingListener(blocking bool) *recordingListener {
+	l := &recordingListener{started: make(chan struct{}, 100)}
+	if blocking {
+		l.release = make(chan struct{})
+	}
+	return l
+}
+
+func (l *recordingListener) callbacks() baseapp.StreamingCallbacks {
+	return baseapp.StreamingCallbacks{
+		Commit: func(block *baseapp.StreamingBlock) error {
+			l.started <- struct{}{}
+			if l.release != nil {
+				<-l.release
+			}
+			l.mtx.Lock()
+			defer l.mtx.Unlock()
+			l.heights = append(l.heights, block.Height())
+			return nil
+		},
+	}
+}
+
+func commitStreamingBlock(t *testing.T, s *baseapp.StreamingListenerService, height int64) {
+	t.Helper()
+	require.NoError(t, s.ListenFinalizeBlock(nil, abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, s.ListenCommit(nil, abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{StoreKey: "store", Key: []byte{byte(height)}, Value: []byte("value")},
+	}))
+}
+
+func TestStreamingListener(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	var (
+		changes   = make(map[int64][]*storetypes.StoreKVPair)
+		txResults = make(map[int64][]*abci.ExecTxResult)
+		heights   []int64
+	)
+	service, err := suite.baseApp.RegisterStreamingListener(baseapp.StreamingCallbacks{
+		StateChanges: func(height int64, c []*storetypes.StoreKVPair) error {
+			changes[height] = c
+			return nil
+		},
+		Events: func(height int64, _ []abci.Event, res []*abci.ExecTxResult) error {
+			txResults[height] = res
+			return nil
+		},
+		Commit: func(block *baseapp.StreamingBlock) error {
+			heights = append(heights, block.Height())
+			return nil
+		},
+	}, []storetypes.StoreKey{capKey2}, baseapp.StreamingListenerConfig{})
+	require.NoError(t, err)
+
+	_, err = suite.baseApp.InitChain(&abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	for height := int64(1); height <= 3; height++ {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte{byte(height)}, Value: []byte("value"), Signer: addr.String()}))
+		setTxSignature(t, builder, 0)
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{txBytes}})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, service.Close())
+	require.Equal(t, []int64{1, 2, 3}, heights)
+	for height := int64(1); height <= 3; height++ {
+		require.Equal(t, []*storetypes.StoreKVPair{
+			{StoreKey: capKey2.Name(), Key: []byte{byte(height)}, Value: []byte("value")},
+		}, changes[height])
+		require.Len(t, txResults[height], 1)
+		require.Equal(t, uint32(0), txResults[height][0].Code)
+	}
+
+	// no block is accepted after the service is closed
+	require.Error(t, service.ListenCommit(nil, abci.ResponseCommit{}, nil))
+}
+
+func TestStreamingListener_Backpressure(t *testing.T) {
+	t.Run("drop", func(t *testing.T) {
+		listener := newRecordingListener(true)
+		s, err := baseapp.NewStreamingListenerService(listener.callbacks(), baseapp.StreamingListenerConfig{
+			BufferSize:   1,
+			Backpressure: baseapp.BackpressureDrop,
+		}, log.NewNopLogger())
+		require.NoError(t, err)
+
+		commitStreamingBlock(t, s, 1)
+		<-listener.started
+		commitStreamingBlock(t, s, 2) // buffered
+		commitStreamingBlock(t, s, 3) // dropped
+		require.Equal(t, uint64(1), s.Dropped())
+
+		close(listener.release)
+		require.NoError(t, s.Close())
+		require.Equal(t, []int64{1, 2}, listener.heights)
+	})
+
+	t.Run("spill", func(t *testing.T) {
+		spillDir := t.TempDir()
+		listener := newRecordingListener(true)
+		s, err := baseapp.NewStreamingListenerService(listener.callbacks(), baseapp.StreamingListenerConfig{
+			BufferSize:   1,
+			Backpressure: baseapp.BackpressureSpill,
+			SpillDir:     spillDir,
+		}, log.NewNopLogger())
+		require.NoError(t, err)
+
+		commitStreamingBlock(t, s, 1)
+		<-listener.started
+		commitStreamingBlock(t, s, 2) // buffered
+		commitStreamingBlock(t, s, 3) // spilled
+		commitStreamingBlock(t, s, 4) // spilled, to preserve the order
+		entries, err := os.ReadDir(spillDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		close(listener.release)
+		require.NoError(t, s.Close())
+		require.Equal(t, []int64{1, 2, 3, 4}, listener.heights)
+		require.Equal(t, uint64(0), s.Dropped())
+
+		entries, err = os.ReadDir(spillDir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		listener := newRecordingListener(true)
+		s, err := baseapp.NewStreamingListenerService(listener.callbacks(), baseapp.StreamingListenerConfig{
+			BufferSize: 1,
+		}, log.NewNopLogger())
+		require.NoError(t, err)
+
+		commitStreamingBlock(t, s, 1)
+		<-listener.started
+		commitStreamingBlock(t, s, 2) // buffered
+
+		committed := make(chan struct{})
+		go func() {
+			commitStreamingBlock(t, s, 3)
+			close(committed)
+		}()
+
+		select {
+		case <-committed:
+			t.Fatal("commit must block while the buffer is full")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(listener.release)
+		<-committed
+		require.NoError(t, s.Close())
+		require.Equal(t, []int64{1, 2, 3}, listener.heights)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := baseapp.NewStreamingListenerService(baseapp.StreamingCallbacks{}, baseapp.StreamingListenerConfig{
+			Backpressure: baseapp.BackpressureSpill,
+		}, log.NewNopLogger())
+		require.Error(t, err)
+
+		_, err = baseapp.NewStreamingListenerService(baseapp.StreamingCallbacks{}, baseapp.StreamingListenerConfig{
+			Backpressure: "unknown",
+		}, log.NewNopLogger())
+		require.Error(t, err)
+	})
+}
+
+func TestFileStreamingSink(t *testing.T) {
+	sink, err := baseapp.NewFileStreamingSink(t.TempDir())
+	require.NoError(t, err)
+
+	block := &baseapp.StreamingBlock{
+		RequestFinalizeBlock: &abci.RequestFinalizeBlock{Height: 7, Txs: [][]byte{[]byte("tx")}},
+		ResponseFinalizeBlock: &abci.ResponseFinalizeBlock{
+			Events:    []abci.Event{{Type: "block"}},
+			TxResults: []*abci.ExecTxResult{{Code: 1, Log: "log"}},
+		},
+		ResponseCommit: &abci.ResponseCommit{RetainHeight: 3},
+		StateChanges: []*storetypes.StoreKVPair{
+			{StoreKey: "a", Key: []byte("k1"), Value: []byte("v1")},
+			{StoreKey: "b", Key: []byte("k2"), Delete: true},
+		},
+	}
+	require.NoError(t, sink.OnCommit(block))
+
+	f, err := os.Open(sink.BlockPath(7))
+	require.NoError(t, err)
+	defer f.Close()
+
+	read, err := baseapp.ReadStreamingBlock(f)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), read.Height())
+	require.Equal(t, block.RequestFinalizeBlock.Txs, read.RequestFinalizeBlock.Txs)
+	require.Equal(t, block.ResponseFinalizeBlock.TxResults[0].Log, read.ResponseFinalizeBlock.TxResults[0].Log)
+	require.Equal(t, block.ResponseCommit.RetainHeight, read.ResponseCommit.RetainHeight)
+	require.Equal(t, block.StateChanges, read.StateChanges)
+}
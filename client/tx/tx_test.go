@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -152,6 +153,28 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedUnorderedTx(t *testing.T) {
+	txConfig, _ := newTestTxConfig()
+	msg := &countertypes.MsgIncreaseCounter{Signer: sdk.AccAddress("from").String(), Count: 1}
+	timeout := time.Unix(1_000_000, 0).UTC()
+
+	// unordered txs require a timeout timestamp
+	txf := mockTxFactory(txConfig).WithUnordered(true)
+	_, err := txf.BuildUnsignedTx(msg)
+	require.ErrorContains(t, err, "timeout timestamp required for unordered tx")
+
+	// unordered txs cannot be signed with amino json
+	_, err = txf.WithTimeoutTimestamp(timeout).WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON).BuildUnsignedTx(msg)
+	require.ErrorContains(t, err, "unordered tx cannot be signed with SIGN_MODE_LEGACY_AMINO_JSON")
+
+	tx, err := txf.WithTimeoutTimestamp(timeout).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+
+	unorderedTx := tx.GetTx().(sdk.TxWithUnordered)
+	require.True(t, unorderedTx.GetUnordered())
+	require.Equal(t, timeout, unorderedTx.GetTimeoutTimestamp())
+}
+
 func TestBuildUnsignedTxWithWithExtensionOptions(t *testing.T) {
 	txCfg := moduletestutil.MakeBuilderTestTxConfig()
 	extOpts := []*codectypes.Any{
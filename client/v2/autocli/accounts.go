@@ -0,0 +1,331 @@
+package autocli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/client/v2/internal/util"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+const (
+	// accountsExecuteMethod is the x/accounts Msg/Execute method. Its command is
+	// built from the schemas of the account types instead of from its input message.
+	accountsExecuteMethod protoreflect.FullName = "cosmos.accounts.v1.Msg.Execute"
+
+	// accountsExecuteMsg is the input message of the x/accounts Msg/Execute method.
+	accountsExecuteMsg protoreflect.FullName = "cosmos.accounts.v1.MsgExecute"
+
+	// accountSchemaMethod is the x/accounts Query/AccountSchema method, returning
+	// the schema of an account type and the descriptors of its messages.
+	accountSchemaMethod protoreflect.FullName = "cosmos.accounts.v1.Query.AccountSchema"
+)
+
+// accountSchema is the schema of an account type returned by the x/accounts
+// AccountSchema query.
+type accountSchema struct {
+	// executeRequests are the names of the messages accepted by the execute
+	// handlers of the account type.
+	executeRequests []string
+	// fileDescriptors are the serialized descriptors of the files defining the
+	// messages of the account type, a file coming after the files it imports.
+	fileDescriptors [][]byte
+}
+
+// BuildAccountsExecuteCommand returns the command executing messages on x/accounts
+// accounts, `execute [account-type] [method] [target-address]`. The account types
+// depend on the chain, so the subcommands of an account type, one per message
+// accepted by its execute handlers, are built when the command runs from the
+// descriptors returned by the x/accounts AccountSchema query.
+func (b *Builder) BuildAccountsExecuteCommand(options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
+	if options == nil {
+		options = &autocliv1.RpcCommandOptions{}
+	}
+
+	use := options.Use
+	if use == "" {
+		use = "execute [account-type] [method] [target-address]"
+	}
+
+	short := options.Short
+	if short == "" {
+		short = "Execute a message on an account"
+	}
+
+	long := options.Long
+	if long == "" {
+		long = `Execute a message on an account. The methods of an account type, and the flags
+of their message fields, are discovered from the chain: run
+'execute [account-type] --help' to list them.
+The account type and the method must be given before any flag.`
+	}
+
+	cmd := &cobra.Command{
+		Use:          use,
+		Short:        short,
+		Long:         long,
+		Example:      options.Example,
+		Aliases:      options.Alias,
+		SuggestFor:   options.SuggestFor,
+		Deprecated:   options.Deprecated,
+		Version:      options.Version,
+		SilenceUsage: true,
+		// the flags are only known once the account type command is built.
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// parse the connection flags, and the account type, ahead of the
+			// account type command, which is built from the schema queried from
+			// the chain. The flags of the messages are not known yet.
+			connCmd := &cobra.Command{}
+			if b.AddTxConnFlags != nil {
+				b.AddTxConnFlags(connCmd)
+			}
+			// the commands of the methods show their own connection flags.
+			connCmd.Flags().VisitAll(func(flag *pflag.Flag) {
+				flag.Hidden = true
+			})
+			// accept the persistent flags of the root command, e.g. --home.
+			connCmd.Flags().AddFlagSet(cmd.Root().PersistentFlags())
+			connFlags := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+			connFlags.AddFlagSet(connCmd.Flags())
+			// the help flag is left to the account type command.
+			connCmd.InitDefaultHelpFlag()
+			connCmd.FParseErrWhitelist.UnknownFlags = true
+			if err := connCmd.ParseFlags(args); err != nil {
+				return err
+			}
+			if connCmd.Flags().NArg() == 0 {
+				return cmd.Help()
+			}
+			accountType := connCmd.Flags().Arg(0)
+			connCmd.SetContext(cmd.Context())
+
+			conn, err := b.GetClientConn(connCmd)
+			if err != nil {
+				return err
+			}
+			schema, err := b.queryAccountSchema(cmd.Context(), conn, accountType)
+			if err != nil {
+				return err
+			}
+
+			typeCmd, err := b.buildAccountTypeCommand(accountType, schema)
+			if err != nil {
+				return err
+			}
+
+			// the account type command is executed under a copy of this command,
+			// which parses the flags.
+			execCmd := &cobra.Command{
+				Use: cmd.Name(),
+				CompletionOptions: cobra.CompletionOptions{
+					DisableDefaultCmd: true,
+				},
+				Annotations: map[string]string{
+					cobra.CommandDisplayNameAnnotation: cmd.CommandPath(),
+				},
+				SilenceUsage:  true,
+				SilenceErrors: true,
+			}
+			execCmd.SetHelpCommand(&cobra.Command{Hidden: true})
+			execCmd.PersistentFlags().AddFlagSet(connFlags)
+			execCmd.AddCommand(typeCmd)
+			execCmd.SetArgs(args)
+			execCmd.SetIn(cmd.InOrStdin())
+			execCmd.SetOut(cmd.OutOrStdout())
+			execCmd.SetErr(cmd.ErrOrStderr())
+			return execCmd.ExecuteContext(cmd.Context())
+		},
+	}
+
+	return cmd, nil
+}
+
+// queryAccountSchema returns the schema of an account type with the x/accounts
+// AccountSchema query. The query is built from the descriptors of the file
+// resolver, as the other autocli commands, so that it does not depend on the
+// version of the cosmossdk.io/api module linked in the client.
+func (b *Builder) queryAccountSchema(ctx context.Context, conn grpc.ClientConnInterface, accountType string) (accountSchema, error) {
+	desc, err := b.FileResolver.FindDescriptorByName(accountSchemaMethod)
+	if err != nil {
+		return accountSchema{}, fmt.Errorf("can't find the x/accounts AccountSchema query: %w", err)
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return accountSchema{}, fmt.Errorf("%s is not a method", accountSchemaMethod)
+	}
+
+	req := dynamicpb.NewMessage(method.Input())
+	req.Set(method.Input().Fields().ByName("account_type"), protoreflect.ValueOfString(accountType))
+	res := dynamicpb.NewMessage(method.Output())
+	methodName := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+	if err := conn.Invoke(ctx, methodName, req, res); err != nil {
+		return accountSchema{}, err
+	}
+
+	var schema accountSchema
+	resSchema := res.Get(method.Output().Fields().ByName("schema")).Message()
+	handlers := resSchema.Get(resSchema.Descriptor().Fields().ByName("execute_handlers")).List()
+	for i := 0; i < handlers.Len(); i++ {
+		handler := handlers.Get(i).Message()
+		schema.executeRequests = append(schema.executeRequests, handler.Get(handler.Descriptor().Fields().ByName("request")).String())
+	}
+	fileDescriptors := res.Get(method.Output().Fields().ByName("file_descriptors")).List()
+	for i := 0; i < fileDescriptors.Len(); i++ {
+		schema.fileDescriptors = append(schema.fileDescriptors, fileDescriptors.Get(i).Bytes())
+	}
+
+	return schema, nil
+}
+
+// buildAccountTypeCommand returns the command of an account type, with a
+// subcommand for each message accepted by its execute handlers.
+func (b *Builder) buildAccountTypeCommand(accountType string, schema accountSchema) (*cobra.Command, error) {
+	fds := &descriptorpb.FileDescriptorSet{}
+	for _, bz := range schema.fileDescriptors {
+		fd := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(bz, fd); err != nil {
+			return nil, fmt.Errorf("invalid file descriptor of account type %s: %w", accountType, err)
+		}
+		fds.File = append(fds.File, fd)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file descriptors of account type %s: %w", accountType, err)
+	}
+
+	typeCmd := &cobra.Command{
+		Use:          accountType,
+		Short:        fmt.Sprintf("Execute a message on a %s account", accountType),
+		RunE:         client.ValidateCmd,
+		SilenceUsage: true,
+	}
+
+	for _, request := range schema.executeRequests {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(request))
+		if err != nil {
+			return nil, fmt.Errorf("can't find message %s of account type %s: %w", request, accountType, err)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s of account type %s is not a message", request, accountType)
+		}
+
+		// messages with the same name in different packages are disambiguated by their full name.
+		name := accountMethodName(msgDesc)
+		if findSubCommand(typeCmd, name) != nil {
+			name = string(msgDesc.FullName())
+		}
+
+		methodCmd, err := b.buildAccountMethodCommand(name, msgDesc)
+		if err != nil {
+			return nil, err
+		}
+		typeCmd.AddCommand(methodCmd)
+	}
+
+	return typeCmd, nil
+}
+
+// buildAccountMethodCommand returns the command executing a message on an account,
+// the fields of the message being set with flags.
+func (b *Builder) buildAccountMethodCommand(name string, msgDesc protoreflect.MessageDescriptor) (*cobra.Command, error) {
+	long := util.DescriptorDocs(msgDesc)
+	if long == "" {
+		long = fmt.Sprintf("Execute %s on an account, the fields of the message being set with flags.", msgDesc.FullName())
+	}
+
+	cmd := &cobra.Command{
+		Use:          fmt.Sprintf("%s [target-address]", name),
+		Short:        fmt.Sprintf("Execute %s on an account", msgDesc.FullName()),
+		Long:         long,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+	}
+
+	if b.AddTxConnFlags != nil {
+		b.AddTxConnFlags(cmd)
+	}
+
+	cmd.SetContext(context.Background())
+	msgFlags := pflag.NewFlagSet(name, pflag.ContinueOnError)
+	binder, err := b.AddMessageFlags(cmd.Context(), msgFlags, util.ResolveMessageType(b.TypeResolver, msgDesc), &autocliv1.RpcCommandOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the fields named like a connection flag, e.g. sequence, are set with a
+	// flag prefixed by msg-.
+	msgFlags.VisitAll(func(flag *pflag.Flag) {
+		if cmd.Flags().Lookup(flag.Name) != nil {
+			flag.Name = "msg-" + flag.Name
+		}
+		cmd.Flags().AddFlag(flag)
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		input, err := binder.BuildMessage(nil)
+		if err != nil {
+			return err
+		}
+
+		clientCtx, err := b.getTxClientContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		sender, err := b.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+		if err != nil {
+			return fmt.Errorf("failed to set sender on message, got %v: %w", clientCtx.GetFromAddress(), err)
+		}
+		message, err := anyutil.New(input.Interface())
+		if err != nil {
+			return err
+		}
+
+		// AutoCLI uses protov2 messages, while the SDK only supports proto v1 messages.
+		// Here we use dynamicpb, to create a proto v1 compatible message.
+		// The SDK codec will handle protov2 -> protov1 (marshal)
+		// As the AccountSchema query, MsgExecute is built from the descriptors
+		// of the file resolver.
+		desc, err := b.FileResolver.FindDescriptorByName(accountsExecuteMsg)
+		if err != nil {
+			return fmt.Errorf("can't find the x/accounts MsgExecute message: %w", err)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return fmt.Errorf("%s is not a message", accountsExecuteMsg)
+		}
+		msg := dynamicpb.NewMessage(msgDesc)
+		msg.Set(msgDesc.Fields().ByName("sender"), protoreflect.ValueOfString(sender))
+		msg.Set(msgDesc.Fields().ByName("target"), protoreflect.ValueOfString(args[0]))
+		msg.Set(msgDesc.Fields().ByName("message"), protoreflect.ValueOfMessage(message.ProtoReflect()))
+
+		return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	return cmd, nil
+}
+
+// accountMethodName returns the name of the command of an account message, its
+// name without the Msg prefix, e.g. create-proposal for MsgCreateProposal.
+func accountMethodName(msgDesc protoreflect.MessageDescriptor) string {
+	name := string(msgDesc.Name())
+	if trimmed := strings.TrimPrefix(name, "Msg"); trimmed != "" {
+		name = trimmed
+	}
+	return protoNameToCliName(protoreflect.Name(name))
+}
@@ -0,0 +1,131 @@
+package autocli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+)
+
+var buildModuleAccountsCommand = func(moduleName string, b *Builder) (*cobra.Command, error) {
+	cmd := topLevelCmd(moduleName, fmt.Sprintf("Transactions commands for the %s module", moduleName))
+	err := b.AddMsgServiceCommands(cmd, &autocliv1.ServiceCommandDescriptor{
+		Service: "cosmos.accounts.v1.Msg",
+	})
+	return cmd, err
+}
+
+// initAccountsFixture returns a fixture resolving the descriptors of the
+// x/accounts API with the ones of the x/accounts module, as an app does.
+func initAccountsFixture(t *testing.T) *fixture {
+	t.Helper()
+	fixture := initFixture(t)
+	fixture.b.FileResolver = accountsFileResolver{}
+	return fixture
+}
+
+func TestAccountsExecute(t *testing.T) {
+	fixture := initAccountsFixture(t)
+	out, err := runCmd(fixture.conn, fixture.b, buildModuleAccountsCommand, "execute",
+		"test", "string-value", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		"--value", "hello",
+		"--from", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		"--generate-only",
+		"--output", "json",
+	)
+	assert.NilError(t, err)
+	golden.Assert(t, out.String(), "msg-accounts-execute.golden")
+
+	// connection flags can be given before the account type
+	out, err = runCmd(fixture.conn, fixture.b, buildModuleAccountsCommand, "execute",
+		"--generate-only",
+		"test", "string-value", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		"--value", "hello",
+		"--from", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		"--output", "json",
+	)
+	assert.NilError(t, err)
+	golden.Assert(t, out.String(), "msg-accounts-execute.golden")
+
+	_, err = runCmd(fixture.conn, fixture.b, buildModuleAccountsCommand, "execute",
+		"unknown", "string-value", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+	)
+	assert.ErrorContains(t, err, "account type unknown not found")
+
+	_, err = runCmd(fixture.conn, fixture.b, buildModuleAccountsCommand, "execute",
+		"test", "unknown-method", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+	)
+	assert.ErrorContains(t, err, "unknown command \"unknown-method\"")
+}
+
+func TestHelpAccountsExecute(t *testing.T) {
+	fixture := initAccountsFixture(t)
+	out, err := runCmd(fixture.conn, fixture.b, buildModuleAccountsCommand, "execute", "test", "--help")
+	assert.NilError(t, err)
+	golden.Assert(t, out.String(), "help-accounts-execute.golden")
+}
+
+type testAccountsServer struct {
+	accountsv1.UnimplementedQueryServer
+}
+
+func (t testAccountsServer) AccountSchema(_ context.Context, request *accountsv1.AccountSchemaRequest) (*accountsv1.AccountSchemaResponse, error) {
+	if request.AccountType != "test" {
+		return nil, fmt.Errorf("account type %s not found", request.AccountType)
+	}
+
+	fd, err := proto.Marshal(protodesc.ToFileDescriptorProto(wrapperspb.File_google_protobuf_wrappers_proto))
+	if err != nil {
+		return nil, err
+	}
+	return &accountsv1.AccountSchemaResponse{
+		Schema: &accountsv1.SchemaResponse{
+			InitSchema: &accountsv1.SchemaResponse_Handler{
+				Request:  "google.protobuf.StringValue",
+				Response: "google.protobuf.StringValue",
+			},
+			ExecuteHandlers: []*accountsv1.SchemaResponse_Handler{{
+				Request:  "google.protobuf.StringValue",
+				Response: "google.protobuf.StringValue",
+			}},
+		},
+		FileDescriptors: [][]byte{fd},
+	}, nil
+}
+
+// accountsFileResolver resolves the descriptors of the x/accounts API from the
+// files registered by the x/accounts module, and the other descriptors from the
+// global registry. The x/accounts module can define a more recent API than the
+// cosmossdk.io/api module linked in the tests.
+type accountsFileResolver struct{}
+
+func (accountsFileResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if strings.HasPrefix(path, "cosmos/accounts/") {
+		return gogoproto.GogoResolver.FindFileByPath(path)
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (accountsFileResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if strings.HasPrefix(string(name), "cosmos.accounts.") {
+		return gogoproto.GogoResolver.FindDescriptorByName(name)
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+func (accountsFileResolver) RangeFiles(f func(protoreflect.FileDescriptor) bool) {
+	protoregistry.GlobalFiles.RangeFiles(f)
+}
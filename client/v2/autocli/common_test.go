@@ -6,6 +6,7 @@ import (
 	"net"
 	"testing"
 
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -16,6 +17,8 @@ import (
 	reflectionv2alpha1 "cosmossdk.io/api/cosmos/base/reflection/v2alpha1"
 	"cosmossdk.io/client/v2/autocli/flag"
 	"cosmossdk.io/client/v2/internal/testpb"
+	"cosmossdk.io/x/accounts"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
 	"cosmossdk.io/x/bank"
 	banktypes "cosmossdk.io/x/bank/types"
 
@@ -37,6 +40,7 @@ func initFixture(t *testing.T) *fixture {
 	home := t.TempDir()
 	server := grpc.NewServer()
 	testpb.RegisterQueryServer(server, &testEchoServer{})
+	accountsv1.RegisterQueryServer(server, &testAccountsServer{})
 	reflectionv2alpha1.RegisterReflectionServiceServer(server, &testReflectionServer{})
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
 	assert.NilError(t, err)
@@ -59,6 +63,8 @@ func initFixture(t *testing.T) *fixture {
 
 	interfaceRegistry := encodingConfig.Codec.InterfaceRegistry()
 	banktypes.RegisterInterfaces(interfaceRegistry)
+	accounts.AppModule{}.RegisterInterfaces(interfaceRegistry)
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &gogotypes.StringValue{})
 
 	var initClientCtx client.Context
 	initClientCtx = initClientCtx.
@@ -99,7 +99,14 @@ func (b *Builder) AddMsgServiceCommands(cmd *cobra.Command, cmdDescriptor *autoc
 			continue
 		}
 
-		methodCmd, err := b.BuildMsgMethodCommand(methodDescriptor, methodOpts)
+		var methodCmd *cobra.Command
+		var err error
+		if methodDescriptor.FullName() == accountsExecuteMethod {
+			// the messages executed on accounts depend on the account type.
+			methodCmd, err = b.BuildAccountsExecuteCommand(methodOpts)
+		} else {
+			methodCmd, err = b.BuildMsgMethodCommand(methodDescriptor, methodOpts)
+		}
 		if err != nil {
 			return err
 		}
@@ -121,34 +128,11 @@ func (b *Builder) AddMsgServiceCommands(cmd *cobra.Command, cmdDescriptor *autoc
 // BuildMsgMethodCommand returns a command that outputs the JSON representation of the message.
 func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
 	execFunc := func(cmd *cobra.Command, input protoreflect.Message) error {
-		cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &b.ClientCtx))
-
-		clientCtx, err := client.GetClientTxContext(cmd)
+		clientCtx, err := b.getTxClientContext(cmd)
 		if err != nil {
 			return err
 		}
 
-		clientCtx = clientCtx.WithCmdContext(cmd.Context())
-		clientCtx = clientCtx.WithOutput(cmd.OutOrStdout())
-
-		// enable sign mode textual
-		// the config is always overwritten as we need to have set the flags to the client context
-		// this ensures that the context has the correct client.
-		if !clientCtx.Offline {
-			b.TxConfigOpts.EnabledSignModes = append(b.TxConfigOpts.EnabledSignModes, signing.SignMode_SIGN_MODE_TEXTUAL)
-			b.TxConfigOpts.TextualCoinMetadataQueryFn = authtxconfig.NewGRPCCoinMetadataQueryFn(clientCtx)
-
-			txConfig, err := authtx.NewTxConfigWithOptions(
-				codec.NewProtoCodec(clientCtx.InterfaceRegistry),
-				b.TxConfigOpts,
-			)
-			if err != nil {
-				return err
-			}
-
-			clientCtx = clientCtx.WithTxConfig(txConfig)
-		}
-
 		fd := input.Descriptor().Fields().ByName(protoreflect.Name(flag.GetSignerFieldName(input.Descriptor())))
 		addressCodec := b.Builder.AddressCodec
 
@@ -212,6 +196,40 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 	return cmd, nil
 }
 
+// getTxClientContext returns the client context of a transaction command, set
+// from its flags.
+func (b *Builder) getTxClientContext(cmd *cobra.Command) (client.Context, error) {
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &b.ClientCtx))
+
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	clientCtx = clientCtx.WithCmdContext(cmd.Context())
+	clientCtx = clientCtx.WithOutput(cmd.OutOrStdout())
+
+	// enable sign mode textual
+	// the config is always overwritten as we need to have set the flags to the client context
+	// this ensures that the context has the correct client.
+	if !clientCtx.Offline {
+		b.TxConfigOpts.EnabledSignModes = append(b.TxConfigOpts.EnabledSignModes, signing.SignMode_SIGN_MODE_TEXTUAL)
+		b.TxConfigOpts.TextualCoinMetadataQueryFn = authtxconfig.NewGRPCCoinMetadataQueryFn(clientCtx)
+
+		txConfig, err := authtx.NewTxConfigWithOptions(
+			codec.NewProtoCodec(clientCtx.InterfaceRegistry),
+			b.TxConfigOpts,
+		)
+		if err != nil {
+			return client.Context{}, err
+		}
+
+		clientCtx = clientCtx.WithTxConfig(txConfig)
+	}
+
+	return clientCtx, nil
+}
+
 // handleGovProposal sets the authority field of the message to the gov module address and creates a gov proposal.
 func (b *Builder) handleGovProposal(
 	options *autocliv1.RpcCommandOptions,
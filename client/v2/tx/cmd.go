@@ -0,0 +1,212 @@
+package tx
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHex            = "hex"
+	flagIndex          = "index"
+	flagAccountNumbers = "account-numbers"
+)
+
+// InspectCmd returns the command to decode a tx and show what each of its
+// signers signed: its resolved signers, and their sign bytes rendered in each
+// sign mode supported by the app, including the screens of SIGN_MODE_TEXTUAL.
+func InspectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect [tx]",
+		Short: "Decode a tx and show what each of its signers signed",
+		Long: `Decode a binary encoded tx, given as base64 (default) or hex or read from
+stdin with "-", or fetched from the block at --height at --index, and show its
+JSON encoding, its resolved signers, and the sign bytes of each signer in each
+supported sign mode.
+
+The sign bytes depend on the chain id and on the account numbers of the
+signers, which are not part of the tx: they are set with --chain-id and
+--account-numbers, in the order of the signers of the tx. The sign modes whose
+sign bytes cannot be computed report an error instead, e.g. SIGN_MODE_TEXTUAL
+when the coin metadata it renders cannot be queried from --node.`,
+		Example: fmt.Sprintf(`$ %[1]s tx inspect CpMBCpABChwvY29zbW9z... --chain-id my-chain --account-numbers 4,12
+$ %[1]s tx inspect --height 1000 --index 0 --node tcp://localhost:26657`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var txBytes []byte
+			if clientCtx.Height > 0 {
+				if len(args) > 0 {
+					return errors.New("a tx cannot be given with --height")
+				}
+				txBytes, err = readBlockTx(cmd, clientCtx)
+			} else {
+				if len(args) == 0 {
+					return errors.New("a tx or --height is required")
+				}
+				txBytes, err = readTx(cmd, args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			inspectedTx, err := inspect(cmd, clientCtx, txBytes)
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, inspectedTx)
+		},
+	}
+
+	cmd.Flags().BoolP(flagHex, "x", false, "Treat input as hexadecimal instead of base64")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Height of the block containing the tx to inspect")
+	cmd.Flags().Uint(flagIndex, 0, "Index of the tx to inspect in the block at --height")
+	cmd.Flags().UintSlice(flagAccountNumbers, nil, "Account numbers of the signers of the tx, in order, 0 if missing")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatJSON, "Output format (text|json)")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain")
+
+	return cmd
+}
+
+// DiffCmd returns the command to show the differences between two txs and what
+// their signers signed, e.g. between a tx a multisig member signed and the tx
+// that was broadcast.
+func DiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [tx1] [tx2]",
+		Short: "Show the differences between two txs and what their signers signed",
+		Long: `Inspect two binary encoded txs, given as base64 (default) or hex, and show the
+differences between their inspections, as the paths of their JSON encodings
+whose values differ. See the inspect command for the flags.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var inspectedTxs [2]*InspectedTx
+			for i, arg := range args {
+				txBytes, err := readTx(cmd, arg)
+				if err != nil {
+					return err
+				}
+
+				inspectedTxs[i], err = inspect(cmd, clientCtx, txBytes)
+				if err != nil {
+					return fmt.Errorf("invalid tx%d: %w", i+1, err)
+				}
+			}
+
+			diffs, err := Diff(inspectedTxs[0], inspectedTxs[1])
+			if err != nil {
+				return err
+			}
+			if diffs == nil {
+				diffs = []Difference{}
+			}
+
+			return printJSON(clientCtx, diffs)
+		},
+	}
+
+	cmd.Flags().BoolP(flagHex, "x", false, "Treat input as hexadecimal instead of base64")
+	cmd.Flags().UintSlice(flagAccountNumbers, nil, "Account numbers of the signers of the txs, in order, 0 if missing")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatJSON, "Output format (text|json)")
+
+	return cmd
+}
+
+// inspect inspects a tx with the tx config of the client context.
+func inspect(cmd *cobra.Command, clientCtx client.Context, txBytes []byte) (*InspectedTx, error) {
+	if clientCtx.TxConfig == nil {
+		return nil, errors.New("tx config is required in the client context")
+	}
+
+	inspector, err := NewInspector(InspectorOptions{
+		SigningContext: clientCtx.TxConfig.SigningContext(),
+		HandlerMap:     clientCtx.TxConfig.SignModeHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountNumbers, err := cmd.Flags().GetUintSlice(flagAccountNumbers)
+	if err != nil {
+		return nil, err
+	}
+	accNums := make([]uint64, len(accountNumbers))
+	for i, accNum := range accountNumbers {
+		accNums[i] = uint64(accNum)
+	}
+
+	return inspector.Inspect(cmd.Context(), txBytes, clientCtx.ChainID, accNums)
+}
+
+// readTx decodes a tx given as an argument, or read from stdin if "-".
+func readTx(cmd *cobra.Command, arg string) ([]byte, error) {
+	if arg == "-" {
+		bz, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return nil, err
+		}
+		arg = string(bz)
+	}
+	arg = strings.TrimSpace(arg)
+
+	if useHex, _ := cmd.Flags().GetBool(flagHex); useHex {
+		return hex.DecodeString(arg)
+	}
+	return base64.StdEncoding.DecodeString(arg)
+}
+
+// readBlockTx fetches the tx at --index of the block at the height of the
+// client context.
+func readBlockTx(cmd *cobra.Command, clientCtx client.Context) ([]byte, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	height := clientCtx.Height
+	res, err := node.Block(cmd.Context(), &height)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := cmd.Flags().GetUint(flagIndex)
+	if err != nil {
+		return nil, err
+	}
+	if index >= uint(len(res.Block.Data.Txs)) {
+		return nil, fmt.Errorf("block %d has %d txs, no tx at index %d", height, len(res.Block.Data.Txs), index)
+	}
+
+	return res.Block.Data.Txs[index], nil
+}
+
+func printJSON(clientCtx client.Context, v any) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintRaw(bz)
+}
@@ -0,0 +1,384 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// InspectedTx is a decoded tx, with what each of its signers signed.
+type InspectedTx struct {
+	// Hash is the hash of the tx, as indexed by CometBFT.
+	Hash string `json:"hash"`
+	// Tx is the protojson encoding of the tx.
+	Tx json.RawMessage `json:"tx"`
+	// Signers are the signers of the tx, in the order of its signatures.
+	Signers []InspectedSigner `json:"signers"`
+}
+
+// InspectedSigner is a signer of a tx, with its signature and the sign bytes
+// of each sign mode.
+type InspectedSigner struct {
+	// Address is the address of the signer, resolved from the messages and the
+	// fee of the tx.
+	Address string `json:"address"`
+	// PubKey is the protojson encoding of the public key of the signer, if set
+	// in the tx.
+	PubKey json.RawMessage `json:"pub_key,omitempty"`
+	// AccountNumber is the account number of the signer used in its sign bytes.
+	AccountNumber uint64 `json:"account_number,string"`
+	// Sequence is the sequence of the signer.
+	Sequence uint64 `json:"sequence,string"`
+	// SignModes are the sign modes of the signature, which are the sign modes
+	// of the members of a multisig.
+	SignModes []string `json:"sign_modes"`
+	// Signature is the signature of the signer.
+	Signature []byte `json:"signature"`
+	// SignBytes are the sign bytes of the signer in each supported sign mode.
+	SignBytes []SignModeSignBytes `json:"sign_bytes"`
+}
+
+// SignModeSignBytes are the sign bytes of a signer in a sign mode, rendered for
+// the sign modes with a human-readable encoding.
+type SignModeSignBytes struct {
+	// SignMode is the sign mode.
+	SignMode string `json:"sign_mode"`
+	// SignBytes are the sign bytes.
+	SignBytes []byte `json:"sign_bytes,omitempty"`
+	// SignDoc is the JSON sign doc of SIGN_MODE_LEGACY_AMINO_JSON.
+	SignDoc json.RawMessage `json:"sign_doc,omitempty"`
+	// Screens are the screens of SIGN_MODE_TEXTUAL.
+	Screens []Screen `json:"screens,omitempty"`
+	// Error is the error returned while getting the sign bytes, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Inspector decodes txs and renders what their signers signed, in each sign
+// mode of a sign mode handler map.
+type Inspector struct {
+	decoder      *decode.Decoder
+	signingCtx   *signing.Context
+	handlerMap   *signing.HandlerMap
+	typeResolver signing.TypeResolver
+}
+
+// InspectorOptions are the options for the Inspector.
+type InspectorOptions struct {
+	// SigningContext is the signing.Context used to decode txs and resolve their signers.
+	SigningContext *signing.Context
+
+	// HandlerMap is the sign mode handler map used to get the sign bytes of signers.
+	HandlerMap *signing.HandlerMap
+}
+
+// NewInspector returns a new Inspector.
+func NewInspector(options InspectorOptions) (*Inspector, error) {
+	if options.SigningContext == nil {
+		return nil, errors.New("signing context is required")
+	}
+	if options.HandlerMap == nil {
+		return nil, errors.New("sign mode handler map is required")
+	}
+
+	decoder, err := decode.NewDecoder(decode.Options{SigningContext: options.SigningContext})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Inspector{
+		decoder:    decoder,
+		signingCtx: options.SigningContext,
+		handlerMap: options.HandlerMap,
+		typeResolver: dynamicTypeResolver{
+			types: options.SigningContext.TypeResolver(),
+			files: options.SigningContext.FileResolver(),
+		},
+	}, nil
+}
+
+// dynamicTypeResolver resolves the types of the type resolver of a signing
+// context, and otherwise resolves dynamic types from the descriptors of its file
+// resolver, so that the Anys of txs can be encoded to JSON even if their
+// generated types are not linked.
+type dynamicTypeResolver struct {
+	types protoregistry.MessageTypeResolver
+	files signing.ProtoFileResolver
+}
+
+func (r dynamicTypeResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	typ, err := r.types.FindMessageByName(name)
+	if err == nil || !errors.Is(err, protoregistry.NotFound) {
+		return typ, err
+	}
+
+	desc, err := r.files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+	return dynamicpb.NewMessageType(msgDesc), nil
+}
+
+func (r dynamicTypeResolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+func (r dynamicTypeResolver) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByName(field)
+}
+
+func (r dynamicTypeResolver) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByNumber(message, field)
+}
+
+// Inspect decodes a tx and returns what each of its signers signed. The sign
+// bytes depend on the chain id and on the account numbers of the signers, in
+// the order of the signers of the tx, which are not part of the tx: the
+// account number of a signer defaults to 0 if missing.
+func (i *Inspector) Inspect(ctx context.Context, txBytes []byte, chainID string, accountNumbers []uint64) (*InspectedTx, error) {
+	decodedTx, err := i.decoder.Decode(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalOpts := protojson.MarshalOptions{Resolver: i.typeResolver, UseProtoNames: true}
+	txJSON, err := marshalOpts.Marshal(decodedTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(txBytes)
+	inspectedTx := &InspectedTx{
+		Hash:    fmt.Sprintf("%X", hash),
+		Tx:      txJSON,
+		Signers: make([]InspectedSigner, len(decodedTx.Signers)),
+	}
+
+	txData := signing.TxData{
+		Body:                       decodedTx.Tx.Body,
+		AuthInfo:                   decodedTx.Tx.AuthInfo,
+		BodyBytes:                  decodedTx.TxRaw.BodyBytes,
+		AuthInfoBytes:              decodedTx.TxRaw.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: decodedTx.TxBodyHasUnknownNonCriticals,
+	}
+
+	signerInfos := decodedTx.Tx.AuthInfo.SignerInfos
+	for j, signer := range decodedTx.Signers {
+		address, err := i.signingCtx.AddressCodec().BytesToString(signer)
+		if err != nil {
+			return nil, err
+		}
+
+		inspectedSigner := InspectedSigner{Address: address}
+		if j < len(accountNumbers) {
+			inspectedSigner.AccountNumber = accountNumbers[j]
+		}
+		if j < len(decodedTx.Tx.Signatures) {
+			inspectedSigner.Signature = decodedTx.Tx.Signatures[j]
+		}
+
+		signerData := signing.SignerData{
+			Address:       address,
+			ChainID:       chainID,
+			AccountNumber: inspectedSigner.AccountNumber,
+		}
+		if j < len(signerInfos) {
+			signerInfo := signerInfos[j]
+			inspectedSigner.Sequence = signerInfo.Sequence
+			inspectedSigner.SignModes = signModes(signerInfo.ModeInfo)
+			signerData.Sequence = signerInfo.Sequence
+			signerData.PubKey = signerInfo.PublicKey
+			if signerInfo.PublicKey != nil {
+				inspectedSigner.PubKey, err = marshalOpts.Marshal(signerInfo.PublicKey)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for _, mode := range i.handlerMap.SupportedModes() {
+			inspectedSigner.SignBytes = append(inspectedSigner.SignBytes, i.signBytes(ctx, mode, signerData, txData))
+		}
+
+		inspectedTx.Signers[j] = inspectedSigner
+	}
+
+	return inspectedTx, nil
+}
+
+// signBytes returns the sign bytes of a signer in a sign mode.
+func (i *Inspector) signBytes(ctx context.Context, mode signingv1beta1.SignMode, signerData signing.SignerData, txData signing.TxData) SignModeSignBytes {
+	signBytes := SignModeSignBytes{SignMode: mode.String()}
+
+	bz, err := i.handlerMap.GetSignBytes(ctx, mode, signerData, txData)
+	if err != nil {
+		signBytes.Error = err.Error()
+		return signBytes
+	}
+	signBytes.SignBytes = bz
+
+	switch mode {
+	case signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		if json.Valid(bz) {
+			signBytes.SignDoc = bz
+		}
+	case signingv1beta1.SignMode_SIGN_MODE_TEXTUAL:
+		screens, err := DecodeScreens(bz)
+		if err != nil {
+			signBytes.Error = err.Error()
+			return signBytes
+		}
+		signBytes.Screens = screens
+	}
+
+	return signBytes
+}
+
+// signModes returns the sign modes of a mode info, flattening the sign modes
+// of the members of multisigs.
+func signModes(modeInfo *txv1beta1.ModeInfo) []string {
+	switch sum := modeInfo.GetSum().(type) {
+	case *txv1beta1.ModeInfo_Single_:
+		return []string{sum.Single.Mode.String()}
+	case *txv1beta1.ModeInfo_Multi_:
+		var modes []string
+		for _, memberModeInfo := range sum.Multi.ModeInfos {
+			modes = append(modes, signModes(memberModeInfo)...)
+		}
+		return modes
+	default:
+		return nil
+	}
+}
+
+// Difference is a difference between two inspected txs, at a path of their
+// JSON encodings.
+type Difference struct {
+	// Path is the path of the difference, as dot-separated keys and indexes.
+	Path string `json:"path"`
+	// Before is the JSON value in the first tx, if any.
+	Before json.RawMessage `json:"before,omitempty"`
+	// After is the JSON value in the second tx, if any.
+	After json.RawMessage `json:"after,omitempty"`
+}
+
+// Diff returns the differences between two inspected txs, e.g. between a tx
+// a multisig member signed and the tx that was broadcast.
+func Diff(before, after *InspectedTx) ([]Difference, error) {
+	beforeValue, err := toJSONValue(before)
+	if err != nil {
+		return nil, err
+	}
+	afterValue, err := toJSONValue(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []Difference
+	if err := diffValues(nil, beforeValue, afterValue, &diffs); err != nil {
+		return nil, err
+	}
+	return diffs, nil
+}
+
+func toJSONValue(v any) (any, error) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// diffValues appends the differences between two JSON values, recursing into
+// objects and arrays.
+func diffValues(path []string, before, after any, diffs *[]Difference) error {
+	switch b := before.(type) {
+	case map[string]any:
+		if a, ok := after.(map[string]any); ok {
+			keys := map[string]bool{}
+			for key := range b {
+				keys[key] = true
+			}
+			for key := range a {
+				keys[key] = true
+			}
+			for _, key := range sortedKeys(keys) {
+				if err := diffValues(append(path, key), b[key], a[key], diffs); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []any:
+		if a, ok := after.([]any); ok {
+			for j := 0; j < len(b) || j < len(a); j++ {
+				var beforeItem, afterItem any
+				if j < len(b) {
+					beforeItem = b[j]
+				}
+				if j < len(a) {
+					afterItem = a[j]
+				}
+				if err := diffValues(append(path, fmt.Sprint(j)), beforeItem, afterItem, diffs); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	beforeBz, err := marshalOptional(before)
+	if err != nil {
+		return err
+	}
+	afterBz, err := marshalOptional(after)
+	if err != nil {
+		return err
+	}
+	if string(beforeBz) != string(afterBz) {
+		*diffs = append(*diffs, Difference{Path: strings.Join(path, "."), Before: beforeBz, After: afterBz})
+	}
+	return nil
+}
+
+func sortedKeys(keys map[string]bool) []string {
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func marshalOptional(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,220 @@
+package tx_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/client/v2/tx"
+	authsigning "cosmossdk.io/x/auth/signing"
+	authtx "cosmossdk.io/x/auth/tx"
+	"cosmossdk.io/x/bank"
+	banktypes "cosmossdk.io/x/bank/types"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const chainID = "inspect-test"
+
+func newTxConfig(t *testing.T) client.TxConfig {
+	t.Helper()
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	txConfig, err := authtx.NewTxConfigWithOptions(encodingConfig.Codec, authtx.ConfigOptions{
+		EnabledSignModes: append(authtx.DefaultSignModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL),
+		TextualCoinMetadataQueryFn: func(context.Context, string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		},
+	})
+	assert.NilError(t, err)
+	return txConfig
+}
+
+// newTx returns a MsgSend tx signed in SIGN_MODE_DIRECT with the given memo.
+func newTx(t *testing.T, txConfig client.TxConfig, memo string) ([]byte, sdk.AccAddress, []byte) {
+	t.Helper()
+	privKey := secp256k1.GenPrivKey()
+	from := sdk.AccAddress(privKey.PubKey().Address())
+
+	txBuilder := txConfig.NewTxBuilder()
+	assert.NilError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(from.String(), from.String(), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetMemo(memo)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	txBuilder.SetGasLimit(200000)
+
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT}
+	sig := signingtypes.SignatureV2{PubKey: privKey.PubKey(), Data: sigData, Sequence: 3}
+	assert.NilError(t, txBuilder.SetSignatures(sig))
+
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+		Address:       from.String(),
+		ChainID:       chainID,
+		AccountNumber: 7,
+		Sequence:      3,
+		PubKey:        privKey.PubKey(),
+	}, txBuilder.GetTx())
+	assert.NilError(t, err)
+	sigData.Signature, err = privKey.Sign(signBytes)
+	assert.NilError(t, err)
+	assert.NilError(t, txBuilder.SetSignatures(sig))
+
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	assert.NilError(t, err)
+	return txBytes, from, signBytes
+}
+
+func newInspector(t *testing.T, txConfig client.TxConfig) *tx.Inspector {
+	t.Helper()
+	inspector, err := tx.NewInspector(tx.InspectorOptions{
+		SigningContext: txConfig.SigningContext(),
+		HandlerMap:     txConfig.SignModeHandler(),
+	})
+	assert.NilError(t, err)
+	return inspector
+}
+
+func TestInspect(t *testing.T) {
+	txConfig := newTxConfig(t)
+	txBytes, from, directSignBytes := newTx(t, txConfig, "inspect me")
+
+	inspectedTx, err := newInspector(t, txConfig).Inspect(context.Background(), txBytes, chainID, []uint64{7})
+	assert.NilError(t, err)
+	assert.Equal(t, len(inspectedTx.Hash), 64)
+
+	var txJSON map[string]any
+	assert.NilError(t, json.Unmarshal(inspectedTx.Tx, &txJSON))
+	assert.Equal(t, txJSON["body"].(map[string]any)["memo"], "inspect me")
+
+	assert.Equal(t, len(inspectedTx.Signers), 1)
+	signer := inspectedTx.Signers[0]
+	assert.Equal(t, signer.Address, from.String())
+	assert.Equal(t, signer.AccountNumber, uint64(7))
+	assert.Equal(t, signer.Sequence, uint64(3))
+	assert.DeepEqual(t, signer.SignModes, []string{signingv1beta1.SignMode_SIGN_MODE_DIRECT.String()})
+	assert.Assert(t, signer.PubKey != nil)
+
+	signBytes := map[string]tx.SignModeSignBytes{}
+	for _, sb := range signer.SignBytes {
+		signBytes[sb.SignMode] = sb
+	}
+	assert.Equal(t, len(signBytes), len(txConfig.SignModeHandler().SupportedModes()))
+
+	// the sign bytes are the ones the signer signed
+	direct := signBytes[signingv1beta1.SignMode_SIGN_MODE_DIRECT.String()]
+	assert.Equal(t, direct.Error, "")
+	assert.DeepEqual(t, direct.SignBytes, directSignBytes)
+
+	aminoJSON := signBytes[signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON.String()]
+	assert.Equal(t, aminoJSON.Error, "")
+	assert.Assert(t, json.Valid(aminoJSON.SignDoc))
+
+	textual := signBytes[signingv1beta1.SignMode_SIGN_MODE_TEXTUAL.String()]
+	assert.Equal(t, textual.Error, "")
+	assert.DeepEqual(t, textual.Screens[0], tx.Screen{Title: "Chain id", Content: chainID})
+	screens, err := tx.DecodeScreens(textual.SignBytes)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, screens, textual.Screens)
+
+	_, err = newInspector(t, txConfig).Inspect(context.Background(), []byte("not a tx"), chainID, nil)
+	assert.ErrorContains(t, err, "")
+}
+
+func TestNewInspector(t *testing.T) {
+	_, err := tx.NewInspector(tx.InspectorOptions{HandlerMap: &txsigning.HandlerMap{}})
+	assert.ErrorContains(t, err, "signing context is required")
+
+	_, err = tx.NewInspector(tx.InspectorOptions{SigningContext: newTxConfig(t).SigningContext()})
+	assert.ErrorContains(t, err, "sign mode handler map is required")
+}
+
+func TestDecodeScreens(t *testing.T) {
+	testCases := []struct {
+		name    string
+		bz      []byte
+		screens []tx.Screen
+		err     string
+	}{
+		{
+			name:    "no screens",
+			bz:      []byte{0xa1, 0x01, 0x80},
+			screens: nil,
+		},
+		{
+			name: "all screen fields",
+			// {1: [{1: "T", 2: "C", 3: 2, 4: true}, {2: ""}]}
+			bz: []byte{0xa1, 0x01, 0x82, 0xa4, 0x01, 0x61, 'T', 0x02, 0x61, 'C', 0x03, 0x02, 0x04, 0xf5, 0xa1, 0x02, 0x60},
+			screens: []tx.Screen{
+				{Title: "T", Content: "C", Indent: 2, Expert: true},
+				{},
+			},
+		},
+		{
+			name: "not a map",
+			bz:   []byte{0x80},
+			err:  "expected CBOR major type 5, got 4",
+		},
+		{
+			name: "unknown screen key",
+			bz:   []byte{0xa1, 0x01, 0x81, 0xa1, 0x05, 0x60},
+			err:  "invalid screen 0: unknown screen key 5",
+		},
+		{
+			name: "truncated text",
+			bz:   []byte{0xa1, 0x01, 0x81, 0xa1, 0x01, 0x62, 'T'},
+			err:  "unexpected end of text string",
+		},
+		{
+			name: "trailing bytes",
+			bz:   []byte{0xa1, 0x01, 0x80, 0x00},
+			err:  "trailing bytes after sign doc",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			screens, err := tx.DecodeScreens(tc.bz)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, screens, tc.screens)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := &tx.InspectedTx{
+		Hash: "A",
+		Tx:   json.RawMessage(`{"body":{"memo":"before","messages":[{"a":1}]}}`),
+		Signers: []tx.InspectedSigner{
+			{Address: "cosmos1a", SignModes: []string{"SIGN_MODE_DIRECT"}},
+		},
+	}
+	after := &tx.InspectedTx{
+		Hash: "A",
+		Tx:   json.RawMessage(`{"body":{"memo":"after","messages":[{"a":1},{"b":2}]}}`),
+		Signers: []tx.InspectedSigner{
+			{Address: "cosmos1a", SignModes: []string{"SIGN_MODE_DIRECT"}},
+		},
+	}
+
+	diffs, err := tx.Diff(before, after)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, diffs, []tx.Difference{
+		{Path: "tx.body.memo", Before: json.RawMessage(`"before"`), After: json.RawMessage(`"after"`)},
+		{Path: "tx.body.messages.1", After: json.RawMessage(`{"b":2}`)},
+	})
+
+	diffs, err = tx.Diff(before, before)
+	assert.NilError(t, err)
+	assert.Equal(t, len(diffs), 0)
+}
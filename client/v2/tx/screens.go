@@ -0,0 +1,202 @@
+package tx
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Screen is a screen of SIGN_MODE_TEXTUAL, as displayed by signing devices.
+type Screen struct {
+	Title   string `json:"title,omitempty"`
+	Content string `json:"content"`
+	Indent  uint64 `json:"indent,omitempty"`
+	Expert  bool   `json:"expert,omitempty"`
+}
+
+// CBOR major types of the encoding of SIGN_MODE_TEXTUAL sign bytes.
+const (
+	cborUint   = 0
+	cborText   = 3
+	cborArray  = 4
+	cborMap    = 5
+	cborSimple = 7
+
+	cborFalse = 20
+	cborTrue  = 21
+)
+
+// Keys of the CBOR maps of SIGN_MODE_TEXTUAL sign bytes.
+const (
+	screensKey = 1
+
+	titleKey   = 1
+	contentKey = 2
+	indentKey  = 3
+	expertKey  = 4
+)
+
+// DecodeScreens decodes the screens of SIGN_MODE_TEXTUAL sign bytes, which are
+// encoded as the CBOR of the CDDL:
+//
+//	sign_doc = {
+//	  screens_key: [* screen],
+//	}
+//	screens_key = 1
+//
+//	screen = {
+//	  ? title_key: tstr,
+//	  ? content_key: tstr,
+//	  ? indent_key: uint,
+//	  ? expert_key: bool,
+//	}
+//	title_key = 1
+//	content_key = 2
+//	indent_key = 3
+//	expert_key = 4
+func DecodeScreens(signBytes []byte) ([]Screen, error) {
+	d := &cborDecoder{bz: signBytes}
+
+	if n, err := d.expect(cborMap); err != nil {
+		return nil, err
+	} else if n != 1 {
+		return nil, fmt.Errorf("expected sign doc with a single entry, got %d", n)
+	}
+	if key, err := d.expect(cborUint); err != nil {
+		return nil, err
+	} else if key != screensKey {
+		return nil, fmt.Errorf("expected screens key, got %d", key)
+	}
+
+	n, err := d.expect(cborArray)
+	if err != nil {
+		return nil, err
+	}
+
+	var screens []Screen
+	for i := uint64(0); i < n; i++ {
+		screen, err := d.screen()
+		if err != nil {
+			return nil, fmt.Errorf("invalid screen %d: %w", i, err)
+		}
+		screens = append(screens, screen)
+	}
+
+	if len(d.bz) > 0 {
+		return nil, errors.New("trailing bytes after sign doc")
+	}
+
+	return screens, nil
+}
+
+// cborDecoder decodes the subset of CBOR used by SIGN_MODE_TEXTUAL: unsigned
+// integers, text strings, booleans, and definite-length arrays and maps.
+type cborDecoder struct {
+	bz []byte
+}
+
+func (d *cborDecoder) screen() (Screen, error) {
+	var screen Screen
+
+	n, err := d.expect(cborMap)
+	if err != nil {
+		return screen, err
+	}
+
+	for i := uint64(0); i < n; i++ {
+		key, err := d.expect(cborUint)
+		if err != nil {
+			return screen, err
+		}
+
+		switch key {
+		case titleKey:
+			screen.Title, err = d.text()
+		case contentKey:
+			screen.Content, err = d.text()
+		case indentKey:
+			screen.Indent, err = d.expect(cborUint)
+		case expertKey:
+			screen.Expert, err = d.bool()
+		default:
+			err = fmt.Errorf("unknown screen key %d", key)
+		}
+		if err != nil {
+			return screen, err
+		}
+	}
+
+	return screen, nil
+}
+
+func (d *cborDecoder) text() (string, error) {
+	n, err := d.expect(cborText)
+	if err != nil {
+		return "", err
+	}
+	if uint64(len(d.bz)) < n {
+		return "", errors.New("unexpected end of text string")
+	}
+
+	s := string(d.bz[:n])
+	d.bz = d.bz[n:]
+	return s, nil
+}
+
+func (d *cborDecoder) bool() (bool, error) {
+	arg, err := d.expect(cborSimple)
+	if err != nil {
+		return false, err
+	}
+
+	switch arg {
+	case cborFalse:
+		return false, nil
+	case cborTrue:
+		return true, nil
+	default:
+		return false, fmt.Errorf("expected bool, got simple value %d", arg)
+	}
+}
+
+// expect decodes the head of a data item of the given major type, and returns
+// its argument.
+func (d *cborDecoder) expect(major byte) (uint64, error) {
+	if len(d.bz) == 0 {
+		return 0, errors.New("unexpected end of sign bytes")
+	}
+
+	head := d.bz[0]
+	if head>>5 != major {
+		return 0, fmt.Errorf("expected CBOR major type %d, got %d", major, head>>5)
+	}
+	d.bz = d.bz[1:]
+
+	info := head & 0x1f
+	if info < 24 {
+		return uint64(info), nil
+	}
+	if info > 27 {
+		return 0, fmt.Errorf("unsupported CBOR additional information %d", info)
+	}
+
+	size := 1 << (info - 24)
+	if len(d.bz) < size {
+		return 0, errors.New("unexpected end of sign bytes")
+	}
+
+	var arg uint64
+	switch size {
+	case 1:
+		arg = uint64(d.bz[0])
+	case 2:
+		arg = uint64(binary.BigEndian.Uint16(d.bz))
+	case 4:
+		arg = uint64(binary.BigEndian.Uint32(d.bz))
+	default:
+		arg = binary.BigEndian.Uint64(d.bz)
+	}
+	d.bz = d.bz[size:]
+
+	return arg, nil
+}
@@ -53,6 +53,10 @@ func (b boolKey[T]) KeyType() string {
 	return "bool"
 }
 
+func (b boolKey[T]) Schema() Schema {
+	return Schema{Kind: BoolKind, Type: b.KeyType()}
+}
+
 func (b boolKey[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return b.Encode(buffer, key)
 }
@@ -45,6 +45,10 @@ func (b bytesKey[T]) KeyType() string {
 	return "bytes"
 }
 
+func (b bytesKey[T]) Schema() Schema {
+	return Schema{Kind: BytesKind, Type: b.KeyType()}
+}
+
 func (b bytesKey[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	if len(key) > MaxBytesKeyNonTerminalSize {
 		return 0, fmt.Errorf(
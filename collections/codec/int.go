@@ -52,6 +52,10 @@ func (i int64Key[T]) KeyType() string {
 	return "int64"
 }
 
+func (i int64Key[T]) Schema() Schema {
+	return Schema{Kind: Int64Kind, Type: i.KeyType()}
+}
+
 func (i int64Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return i.Encode(buffer, key)
 }
@@ -110,6 +114,10 @@ func (i int32Key[T]) KeyType() string {
 	return "int32"
 }
 
+func (i int32Key[T]) Schema() Schema {
+	return Schema{Kind: Int32Kind, Type: i.KeyType()}
+}
+
 func (i int32Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return i.Encode(buffer, key)
 }
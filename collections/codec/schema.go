@@ -0,0 +1,77 @@
+package codec
+
+// Kind defines the kind of the type described by a Schema. The numeric kinds
+// are JSON encoded as strings by the collections codecs, whereas bytes are JSON
+// encoded as base64 strings.
+type Kind string
+
+const (
+	// StringKind is the kind of strings.
+	StringKind Kind = "string"
+	// BytesKind is the kind of bytes.
+	BytesKind Kind = "bytes"
+	// BoolKind is the kind of booleans.
+	BoolKind Kind = "bool"
+	// Int32Kind is the kind of int32 numbers.
+	Int32Kind Kind = "int32"
+	// Int64Kind is the kind of int64 numbers.
+	Int64Kind Kind = "int64"
+	// Uint16Kind is the kind of uint16 numbers.
+	Uint16Kind Kind = "uint16"
+	// Uint32Kind is the kind of uint32 numbers.
+	Uint32Kind Kind = "uint32"
+	// Uint64Kind is the kind of uint64 numbers.
+	Uint64Kind Kind = "uint64"
+	// TupleKind is the kind of multipart types, such as Pair and Triple keys,
+	// which are JSON encoded as arrays of their components. The components are
+	// described by the Fields of the Schema.
+	TupleKind Kind = "tuple"
+	// EmptyKind is the kind of types which carry no data, such as the values
+	// of a KeySet.
+	EmptyKind Kind = "empty"
+	// JSONKind is the kind of types which are only described by their JSON
+	// encoding, such as protobuf messages. It is also the kind of any type whose
+	// codec does not implement HasSchema.
+	JSONKind Kind = "json"
+)
+
+// Schema is a machine-readable description of a type encoded by a KeyCodec or
+// a ValueCodec, which allows to interpret its JSON encoding without knowing the
+// Go type.
+type Schema struct {
+	// Name is the name of the field described by the Schema, if any.
+	Name string `json:"name,omitempty"`
+	// Kind is the kind of the type.
+	Kind Kind `json:"kind"`
+	// Type is the identifier of the type, as returned by KeyCodec.KeyType and
+	// ValueCodec.ValueType.
+	Type string `json:"type"`
+	// Fields describes the components of a TupleKind type, in order.
+	Fields []Schema `json:"fields,omitempty"`
+}
+
+// HasSchema is implemented by the codecs which can describe the type they encode.
+type HasSchema interface {
+	// Schema returns the Schema of the type encoded by the codec.
+	Schema() Schema
+}
+
+// KeySchema returns the Schema of the type encoded by the provided KeyCodec.
+// If the KeyCodec does not implement HasSchema, the type is described as a
+// JSONKind.
+func KeySchema[T any](keyCodec KeyCodec[T]) Schema {
+	if s, ok := keyCodec.(HasSchema); ok {
+		return s.Schema()
+	}
+	return Schema{Kind: JSONKind, Type: keyCodec.KeyType()}
+}
+
+// ValueSchema returns the Schema of the type encoded by the provided ValueCodec.
+// If the ValueCodec does not implement HasSchema, the type is described as a
+// JSONKind.
+func ValueSchema[T any](valueCodec ValueCodec[T]) Schema {
+	if s, ok := valueCodec.(HasSchema); ok {
+		return s.Schema()
+	}
+	return Schema{Kind: JSONKind, Type: valueCodec.ValueType()}
+}
@@ -66,3 +66,7 @@ func (stringKey[T]) Stringify(key T) string {
 func (stringKey[T]) KeyType() string {
 	return "string"
 }
+
+func (s stringKey[T]) Schema() Schema {
+	return Schema{Kind: StringKind, Type: s.KeyType()}
+}
@@ -55,6 +55,8 @@ func (uint64Key[T]) KeyType() string {
 	return "uint64"
 }
 
+func (u uint64Key[T]) Schema() Schema { return Schema{Kind: Uint64Kind, Type: u.KeyType()} }
+
 func NewUint32Key[T ~uint32]() KeyCodec[T] { return uint32Key[T]{} }
 
 type uint32Key[T ~uint32] struct{}
@@ -87,6 +89,8 @@ func (uint32Key[T]) Stringify(key T) string { return strconv.FormatUint(uint64(k
 
 func (uint32Key[T]) KeyType() string { return "uint32" }
 
+func (u uint32Key[T]) Schema() Schema { return Schema{Kind: Uint32Kind, Type: u.KeyType()} }
+
 func (u uint32Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return u.Encode(buffer, key)
 }
@@ -127,6 +131,8 @@ func (uint16Key[T]) Stringify(key T) string { return strconv.FormatUint((uint64)
 
 func (uint16Key[T]) KeyType() string { return "uint16" }
 
+func (u uint16Key[T]) Schema() Schema { return Schema{Kind: Uint16Kind, Type: u.KeyType()} }
+
 func (u uint16Key[T]) EncodeNonTerminal(buffer []byte, key T) (int, error) {
 	return u.Encode(buffer, key)
 }
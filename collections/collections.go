@@ -2,6 +2,7 @@ package collections
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	io "io"
 	"math"
@@ -16,6 +17,9 @@ var (
 	ErrEncoding = codec.ErrEncoding
 	// ErrConflict is returned when there are conflicts, for example in UniqueIndex.
 	ErrConflict = errors.New("collections: conflict")
+	// ErrInconsistentIndex is returned when the references of an index do not match
+	// the values of the IndexedMap it belongs to.
+	ErrInconsistentIndex = errors.New("collections: inconsistent index")
 )
 
 // KEYS
@@ -89,7 +93,14 @@ type Collection interface {
 	// ValueCodec returns the codec used to encode/decode values of the collection.
 	ValueCodec() codec.UntypedValueCodec
 
+	// Schema returns the machine-readable description of the collection.
+	Schema() CollectionSchema
+
 	genesisHandler
+
+	// decodeJSON decodes the provided key, stripped of the collection prefix, and
+	// value and returns their JSON encoding.
+	decodeJSON(key, value []byte) (keyJSON, valueJSON json.RawMessage, err error)
 }
 
 // Prefix defines a segregation bytes namespace for specific collections objects.
@@ -146,6 +157,41 @@ func (c collectionImpl[K, V]) GetName() string { return c.m.name }
 
 func (c collectionImpl[K, V]) GetPrefix() []byte { return NewPrefix(c.m.prefix) }
 
+func (c collectionImpl[K, V]) Schema() CollectionSchema {
+	key := codec.KeySchema(c.m.kc)
+	key.Name = "key"
+	value := codec.ValueSchema(c.m.vc)
+	value.Name = "value"
+	return CollectionSchema{
+		Name:   c.m.name,
+		Prefix: c.GetPrefix(),
+		Key:    key,
+		Value:  value,
+	}
+}
+
+func (c collectionImpl[K, V]) decodeJSON(key, value []byte) (keyJSON, valueJSON json.RawMessage, err error) {
+	_, k, err := c.m.kc.Decode(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyJSON, err = c.m.kc.EncodeJSON(k)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v, err := c.m.vc.Decode(value)
+	if err != nil {
+		return nil, nil, err
+	}
+	valueJSON, err = c.m.vc.EncodeJSON(v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keyJSON, valueJSON, nil
+}
+
 func (c collectionImpl[K, V]) validateGenesis(r io.Reader) error { return c.m.validateGenesis(r) }
 
 func (c collectionImpl[K, V]) importGenesis(ctx context.Context, r io.Reader) error {
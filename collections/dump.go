@@ -0,0 +1,235 @@
+package collections
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/store"
+)
+
+// CollectionSchema is a machine-readable description of a collection, which
+// allows to interpret its entries without knowing the Go types of its keys and
+// values.
+type CollectionSchema struct {
+	// Name is the name of the collection.
+	Name string `json:"name"`
+	// Prefix is the prefix of the collection.
+	Prefix []byte `json:"prefix"`
+	// Key describes the keys of the collection. It is an EmptyKind for Items.
+	Key codec.Schema `json:"key"`
+	// Value describes the values of the collection. It is an EmptyKind for KeySets.
+	Value codec.Schema `json:"value"`
+}
+
+// DumpFormat defines the format in which the entries of a collection are dumped.
+type DumpFormat string
+
+const (
+	// DumpFormatJSON dumps a collection as a JSON object containing the
+	// CollectionSchema of the collection and the JSON encoded entries of the
+	// collection, in the same format as its genesis.
+	DumpFormatJSON DumpFormat = "json"
+	// DumpFormatCSV dumps a collection as CSV, where the header is followed by
+	// one record per entry of the collection. The parts of the multipart keys
+	// and values are split into distinct columns, named after their path in the
+	// CollectionSchema, e.g. "key.k1".
+	DumpFormatCSV DumpFormat = "csv"
+)
+
+// Dump dumps the entries of every collection of the Schema read from the provided
+// store, which can be a snapshot of the store of the Schema, in the provided format.
+// The entries of each collection are written to the writer returned by the target
+// function for the collection name. Keys and values are decoded through the codecs
+// of the collections, s.t. dumping does not require knowing their Go types.
+func (s Schema) Dump(kvStore store.KVStore, format DumpFormat, target func(name string) (io.WriteCloser, error)) error {
+	for _, name := range s.collectionsOrdered {
+		err := s.dump(kvStore, format, target, name)
+		if err != nil {
+			return fmt.Errorf("failed to dump %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s Schema) dump(kvStore store.KVStore, format DumpFormat, target func(name string) (io.WriteCloser, error), name string) error {
+	wc, err := target(name)
+	if err != nil {
+		return err
+	}
+	defer wc.Close()
+
+	return s.DumpCollection(kvStore, name, format, wc)
+}
+
+// DumpCollection dumps the entries of the collection with the provided name,
+// read from the provided store, in the provided format. See Dump.
+func (s Schema) DumpCollection(kvStore store.KVStore, name string, format DumpFormat, w io.Writer) error {
+	coll, err := s.getCollection(name)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case DumpFormatJSON:
+		return dumpJSON(kvStore, coll, w)
+	case DumpFormatCSV:
+		return dumpCSV(kvStore, coll, w)
+	default:
+		return fmt.Errorf("unknown dump format: %s", format)
+	}
+}
+
+func dumpJSON(kvStore store.KVStore, coll Collection, w io.Writer) error {
+	schemaBz, err := json.Marshal(coll.Schema())
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, `{"schema":%s,"entries":[`, schemaBz)
+	if err != nil {
+		return err
+	}
+
+	first := true
+	err = walkCollection(kvStore, coll, func(key, value json.RawMessage) error {
+		// add a comma before encoding the object
+		// for all objects besides the first one.
+		if !first {
+			_, err := io.WriteString(w, ",")
+			if err != nil {
+				return err
+			}
+		}
+		first = false
+
+		bz, err := json.Marshal(jsonMapEntry{Key: key, Value: value})
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(bz)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
+}
+
+func dumpCSV(kvStore store.KVStore, coll Collection, w io.Writer) error {
+	schema := coll.Schema()
+
+	csvWriter := csv.NewWriter(w)
+	err := csvWriter.Write(append(csvColumns(schema.Key, "key"), csvColumns(schema.Value, "value")...))
+	if err != nil {
+		return err
+	}
+
+	err = walkCollection(kvStore, coll, func(key, value json.RawMessage) error {
+		record, err := csvCells(schema.Key, key, nil)
+		if err != nil {
+			return err
+		}
+		record, err = csvCells(schema.Value, value, record)
+		if err != nil {
+			return err
+		}
+		return csvWriter.Write(record)
+	})
+	if err != nil {
+		return err
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// csvColumns returns the names of the columns of a type described by the provided
+// schema, named after the provided path.
+func csvColumns(schema codec.Schema, path string) []string {
+	switch schema.Kind {
+	case codec.EmptyKind:
+		return nil
+	case codec.TupleKind:
+		var columns []string
+		for i, field := range schema.Fields {
+			name := field.Name
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			columns = append(columns, csvColumns(field, path+"."+name)...)
+		}
+		return columns
+	default:
+		return []string{path}
+	}
+}
+
+// csvCells appends to the record the cells of the provided JSON encoded value of
+// a type described by the provided schema.
+func csvCells(schema codec.Schema, value json.RawMessage, record []string) ([]string, error) {
+	switch schema.Kind {
+	case codec.EmptyKind:
+		return record, nil
+
+	case codec.TupleKind:
+		var parts []json.RawMessage
+		err := json.Unmarshal(value, &parts)
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) != len(schema.Fields) {
+			return nil, fmt.Errorf("%w: expected %d parts for %s, got %d", ErrEncoding, len(schema.Fields), schema.Type, len(parts))
+		}
+		for i, field := range schema.Fields {
+			record, err = csvCells(field, parts[i], record)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return record, nil
+
+	case codec.JSONKind:
+		return append(record, string(value)), nil
+
+	default:
+		// strings, bytes and numbers are JSON encoded as strings
+		var s string
+		if json.Unmarshal(value, &s) == nil {
+			return append(record, s), nil
+		}
+		return append(record, string(value)), nil
+	}
+}
+
+// walkCollection walks over the entries of the collection in the provided store,
+// calling walkFunc with the JSON encoded key and value of each entry.
+func walkCollection(kvStore store.KVStore, coll Collection, walkFunc func(key, value json.RawMessage) error) error {
+	prefix := coll.GetPrefix()
+	iter, err := kvStore.Iterator(prefix, nextBytesPrefixKey(prefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, value, err := coll.decodeJSON(iter.Key()[len(prefix):], iter.Value())
+		if err != nil {
+			return err
+		}
+
+		err = walkFunc(key, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return iter.Error()
+}
@@ -0,0 +1,121 @@
+package collections_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/colltest"
+)
+
+type nopCloser struct{ *bytes.Buffer }
+
+func (nopCloser) Close() error { return nil }
+
+func TestCollectionSchema(t *testing.T) {
+	sk, _ := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(sk)
+
+	balances := collections.NewMap(sb, collections.NewPrefix(0), "balances",
+		collections.NamedPairKeyCodec("address", collections.StringKey, "denom", collections.StringKey),
+		collections.Uint64Value,
+	)
+	collections.NewKeySet(sb, collections.NewPrefix(1), "delegations", collections.TripleKeyCodec(collections.StringKey, collections.BytesKey, collections.BoolKey))
+	collections.NewItem(sb, collections.NewPrefix(2), "params", colltest.MockValueCodec[company]())
+
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	schemas := make(map[string]collections.CollectionSchema)
+	for _, coll := range schema.ListCollections() {
+		schemas[coll.GetName()] = coll.Schema()
+	}
+
+	require.Equal(t, collections.CollectionSchema{
+		Name:   "balances",
+		Prefix: []byte{0},
+		Key: codec.Schema{
+			Name: "key",
+			Kind: codec.TupleKind,
+			Type: balances.KeyCodec().KeyType(),
+			Fields: []codec.Schema{
+				{Name: "address", Kind: codec.StringKind, Type: "string"},
+				{Name: "denom", Kind: codec.StringKind, Type: "string"},
+			},
+		},
+		Value: codec.Schema{Name: "value", Kind: codec.Uint64Kind, Type: "uint64"},
+	}, schemas["balances"])
+
+	require.Equal(t, []codec.Schema{
+		{Name: "k1", Kind: codec.StringKind, Type: "string"},
+		{Name: "k2", Kind: codec.BytesKind, Type: "bytes"},
+		{Name: "k3", Kind: codec.BoolKind, Type: "bool"},
+	}, schemas["delegations"].Key.Fields)
+	require.Equal(t, codec.EmptyKind, schemas["delegations"].Value.Kind)
+
+	require.Equal(t, codec.EmptyKind, schemas["params"].Key.Kind)
+	require.Equal(t, codec.JSONKind, schemas["params"].Value.Kind)
+	require.Equal(t, "cosmossdk.io/collections_test.company", schemas["params"].Value.Type)
+
+	bz, err := json.Marshal(schemas["delegations"].Value)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"value","kind":"empty","type":"no_value"}`, string(bz))
+}
+
+func TestSchemaDump(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(sk)
+
+	balances := collections.NewMap(sb, collections.NewPrefix(0), "balances",
+		collections.NamedPairKeyCodec("address", collections.StringKey, "denom", collections.StringKey),
+		collections.Uint64Value,
+	)
+	delegations := collections.NewKeySet(sb, collections.NewPrefix(1), "delegations", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.BoolKey))
+	params := collections.NewItem(sb, collections.NewPrefix(2), "params", colltest.MockValueCodec[company]())
+
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, balances.Set(ctx, collections.Join("alice", "atom"), 100))
+	require.NoError(t, balances.Set(ctx, collections.Join("bob", "osmo, \"ibc\""), 200))
+	require.NoError(t, delegations.Set(ctx, collections.Join3("alice", uint64(1), true)))
+	require.NoError(t, params.Set(ctx, company{City: "milan", Vat: 1}))
+
+	// dump from the raw store, without using the typed collections
+	kvStore := sk.OpenKVStore(ctx)
+
+	dumps := make(map[string]*bytes.Buffer)
+	target := func(name string) (io.WriteCloser, error) {
+		dumps[name] = new(bytes.Buffer)
+		return nopCloser{dumps[name]}, nil
+	}
+
+	require.NoError(t, schema.Dump(kvStore, collections.DumpFormatCSV, target))
+	require.Equal(t, "key.address,key.denom,value\nalice,atom,100\nbob,\"osmo, \"\"ibc\"\"\",200\n", dumps["balances"].String())
+	require.Equal(t, "key.k1,key.k2,key.k3\nalice,1,true\n", dumps["delegations"].String())
+	require.Equal(t, "value\n\"{\"\"type_name\"\":\"\"cosmossdk.io/collections_test.company\"\",\"\"value\"\":{\"\"City\"\":\"\"milan\"\",\"\"Vat\"\":1}}\"\n", dumps["params"].String())
+
+	require.NoError(t, schema.Dump(kvStore, collections.DumpFormatJSON, target))
+
+	var dump struct {
+		Schema  collections.CollectionSchema `json:"schema"`
+		Entries []json.RawMessage            `json:"entries"`
+	}
+	require.NoError(t, json.Unmarshal(dumps["balances"].Bytes(), &dump))
+	require.Equal(t, balances.GetName(), dump.Schema.Name)
+	require.Equal(t, codec.TupleKind, dump.Schema.Key.Kind)
+	require.Len(t, dump.Entries, 2)
+	require.JSONEq(t, `{"key":["alice","atom"],"value":"100"}`, string(dump.Entries[0]))
+
+	require.NoError(t, json.Unmarshal(dumps["delegations"].Bytes(), &dump))
+	require.JSONEq(t, `{"key":["alice","1",true]}`, string(dump.Entries[0]))
+
+	buf := new(bytes.Buffer)
+	require.Error(t, schema.DumpCollection(kvStore, "unknown", collections.DumpFormatJSON, buf))
+	require.Error(t, schema.DumpCollection(kvStore, "balances", "xml", buf))
+}
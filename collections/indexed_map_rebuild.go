@@ -0,0 +1,184 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RebuildableIndex represents an Index which can be rebuilt from the primary
+// data of the IndexedMap it belongs to, and whose consistency with the primary
+// data can be verified. This is useful when an index is added to an IndexedMap
+// which already contains values, or when an index needs to be repaired.
+type RebuildableIndex[PrimaryKey, Value any] interface {
+	Index[PrimaryKey, Value]
+	// Clear removes all the references of the index.
+	Clear(ctx context.Context) error
+	// HasReference reports if the index contains the reference between the provided
+	// primary key and value.
+	HasReference(ctx context.Context, pk PrimaryKey, value Value) (bool, error)
+	// WalkPrimaryKeys walks over the primary keys of every reference of the index.
+	WalkPrimaryKeys(ctx context.Context, walkFunc func(pk PrimaryKey) (stop bool, err error)) error
+}
+
+// PopulateIndex references in the provided index at most limit values of the
+// IndexedMap, starting from the provided primary key included, or from the first
+// primary key if nil. It returns the primary key to resume from, or nil once all
+// the values have been referenced.
+//
+// Values already referenced by the index are skipped, s.t. an interrupted
+// population can be safely resumed. Together with an Item storing the returned
+// primary key, it can be used to populate an index lazily over multiple blocks,
+// e.g. in an EndBlock.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) PopulateIndex(
+	ctx context.Context,
+	index RebuildableIndex[PrimaryKey, Value],
+	start *PrimaryKey,
+	limit int,
+) (*PrimaryKey, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit: %d", limit)
+	}
+
+	var ranger Ranger[PrimaryKey]
+	if start != nil {
+		ranger = new(Range[PrimaryKey]).StartInclusive(*start)
+	}
+
+	// NOTE: the values are collected before being referenced, s.t. the index
+	// is never written to while the primary map is being iterated.
+	var (
+		kvs  []KeyValue[PrimaryKey, Value]
+		next *PrimaryKey
+	)
+	err := m.m.Walk(ctx, ranger, func(pk PrimaryKey, value Value) (bool, error) {
+		if len(kvs) == limit {
+			next = &pk
+			return true, nil
+		}
+		kvs = append(kvs, KeyValue[PrimaryKey, Value]{Key: pk, Value: value})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kv := range kvs {
+		has, err := index.HasReference(ctx, kv.Key, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			continue
+		}
+
+		err = index.Reference(ctx, kv.Key, kv.Value, func() (Value, error) {
+			var v Value
+			return v, ErrNotFound
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return next, nil
+}
+
+// RebuildIndex clears the provided index and references every value of the
+// IndexedMap in it, walking the IndexedMap in batches of batchSize values.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) RebuildIndex(
+	ctx context.Context,
+	index RebuildableIndex[PrimaryKey, Value],
+	batchSize int,
+) error {
+	err := index.Clear(ctx)
+	if err != nil {
+		return err
+	}
+
+	return MigrateIndex(ctx, m, index, batchSize)
+}
+
+// VerifyIndex asserts that the provided index contains exactly one reference for
+// every value of the IndexedMap, and no other reference. It returns an error
+// wrapping ErrInconsistentIndex otherwise. It is meant to be used in invariant
+// checks, as it walks over both the IndexedMap and the index.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) VerifyIndex(ctx context.Context, index RebuildableIndex[PrimaryKey, Value]) error {
+	var numValues uint64
+	err := m.m.Walk(ctx, nil, func(pk PrimaryKey, value Value) (bool, error) {
+		has, err := index.HasReference(ctx, pk, value)
+		if err != nil {
+			return true, err
+		}
+		if !has {
+			return true, fmt.Errorf("%w: missing reference for primary key %s", ErrInconsistentIndex, m.m.kc.Stringify(pk))
+		}
+		numValues++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var numReferences uint64
+	err = index.WalkPrimaryKeys(ctx, func(pk PrimaryKey) (bool, error) {
+		has, err := m.m.Has(ctx, pk)
+		if err != nil {
+			return true, err
+		}
+		if !has {
+			return true, fmt.Errorf("%w: dangling reference to primary key %s", ErrInconsistentIndex, m.m.kc.Stringify(pk))
+		}
+		numReferences++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// every value is referenced, so any additional reference is a stale one
+	if numReferences != numValues {
+		return fmt.Errorf("%w: found %d references for %d values", ErrInconsistentIndex, numReferences, numValues)
+	}
+
+	return nil
+}
+
+// VerifyIndexes asserts the consistency of every index of the IndexedMap that
+// implements RebuildableIndex, see VerifyIndex.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) VerifyIndexes(ctx context.Context) error {
+	var errs []error
+	for _, index := range m.Indexes.IndexesList() {
+		rebuildable, ok := index.(RebuildableIndex[PrimaryKey, Value])
+		if !ok {
+			continue
+		}
+		errs = append(errs, m.VerifyIndex(ctx, rebuildable))
+	}
+	return errors.Join(errs...)
+}
+
+// MigrateIndex is a helper meant to be used in module migrations, which populates
+// an index added to an IndexedMap already containing values. The IndexedMap is
+// walked in batches of batchSize values, s.t. at most batchSize values are held
+// in memory at once.
+func MigrateIndex[PrimaryKey, Value any, Idx Indexes[PrimaryKey, Value]](
+	ctx context.Context,
+	m *IndexedMap[PrimaryKey, Value, Idx],
+	index RebuildableIndex[PrimaryKey, Value],
+	batchSize int,
+) error {
+	var (
+		next *PrimaryKey
+		err  error
+	)
+	for {
+		next, err = m.PopulateIndex(ctx, index, next, batchSize)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package collections_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestIndexedMap_MigrateIndex(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+
+	// companies are saved before the indexes are added
+	m := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(0), "companies", collections.StringKey, colltest.MockValueCodec[company]())
+	for i := 0; i < 10; i++ {
+		err := m.Set(ctx, fmt.Sprintf("%d", i), company{City: fmt.Sprintf("city%d", i%3), Vat: uint64(i)})
+		require.NoError(t, err)
+	}
+
+	im := newTestIndexedMap(collections.NewSchemaBuilder(sk))
+	require.ErrorIs(t, im.VerifyIndex(ctx, im.Indexes.City), collections.ErrInconsistentIndex)
+	require.ErrorIs(t, im.VerifyIndexes(ctx), collections.ErrInconsistentIndex)
+
+	// populate the city index in batches
+	next, err := im.PopulateIndex(ctx, im.Indexes.City, nil, 4)
+	require.NoError(t, err)
+	require.Equal(t, "4", *next)
+
+	pks, err := im.Indexes.City.MatchExact(ctx, "city0")
+	require.NoError(t, err)
+	cityPks, err := pks.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"0", "3"}, cityPks)
+	require.ErrorIs(t, im.VerifyIndex(ctx, im.Indexes.City), collections.ErrInconsistentIndex)
+
+	// populating is idempotent
+	next, err = im.PopulateIndex(ctx, im.Indexes.City, nil, 4)
+	require.NoError(t, err)
+	require.Equal(t, "4", *next)
+
+	next, err = im.PopulateIndex(ctx, im.Indexes.City, next, 4)
+	require.NoError(t, err)
+	require.Equal(t, "8", *next)
+
+	next, err = im.PopulateIndex(ctx, im.Indexes.City, next, 4)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.NoError(t, im.VerifyIndex(ctx, im.Indexes.City))
+
+	// migrate the vat index at once
+	require.NoError(t, collections.MigrateIndex(ctx, im, im.Indexes.Vat, 3))
+	require.NoError(t, im.VerifyIndexes(ctx))
+
+	pk, err := im.Indexes.Vat.MatchExact(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, "7", pk)
+
+	// further writes maintain the indexes
+	require.NoError(t, im.Set(ctx, "10", company{City: "city0", Vat: 10}))
+	require.NoError(t, im.Remove(ctx, "0"))
+	require.NoError(t, im.VerifyIndexes(ctx))
+
+	_, err = im.PopulateIndex(ctx, im.Indexes.City, nil, 0)
+	require.Error(t, err)
+}
+
+func TestIndexedMap_VerifyAndRebuildIndex(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schema := collections.NewSchemaBuilder(sk)
+	im := newTestIndexedMap(schema)
+
+	for i := 0; i < 5; i++ {
+		err := im.Set(ctx, fmt.Sprintf("%d", i), company{City: "milan", Vat: uint64(i)})
+		require.NoError(t, err)
+	}
+	require.NoError(t, im.VerifyIndexes(ctx))
+
+	// the primary map is updated bypassing the indexes, s.t. the indexes
+	// contain stale references
+	m := collections.NewMap(collections.NewSchemaBuilder(sk), collections.NewPrefix(0), "companies", collections.StringKey, colltest.MockValueCodec[company]())
+	require.NoError(t, m.Set(ctx, "1", company{City: "rome", Vat: 1}))
+	require.NoError(t, m.Set(ctx, "2", company{City: "milan", Vat: 20}))
+	require.NoError(t, m.Remove(ctx, "3"))
+
+	err := im.VerifyIndex(ctx, im.Indexes.City)
+	require.ErrorIs(t, err, collections.ErrInconsistentIndex)
+	require.ErrorContains(t, err, "missing reference for primary key 1")
+
+	err = im.VerifyIndex(ctx, im.Indexes.Vat)
+	require.ErrorIs(t, err, collections.ErrInconsistentIndex)
+	require.ErrorContains(t, err, "missing reference for primary key 2")
+
+	// the values are referenced again, but the stale references are left
+	_, err = im.PopulateIndex(ctx, im.Indexes.City, nil, 10)
+	require.NoError(t, err)
+	err = im.VerifyIndex(ctx, im.Indexes.City)
+	require.ErrorIs(t, err, collections.ErrInconsistentIndex)
+	require.ErrorContains(t, err, "dangling reference to primary key 3")
+
+	require.NoError(t, im.RebuildIndex(ctx, im.Indexes.City, 2))
+	require.NoError(t, im.RebuildIndex(ctx, im.Indexes.Vat, 2))
+	require.NoError(t, im.VerifyIndexes(ctx))
+
+	pks, err := im.Indexes.City.MatchExact(ctx, "milan")
+	require.NoError(t, err)
+	cityPks, err := pks.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"0", "2", "4"}, cityPks)
+
+	_, err = im.Indexes.Vat.MatchExact(ctx, 2)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// a stale reference to an existing primary key is detected
+	require.NoError(t, im.Indexes.City.Reference(ctx, "4", company{City: "turin"}, func() (company, error) {
+		return company{}, collections.ErrNotFound
+	}))
+	err = im.VerifyIndex(ctx, im.Indexes.City)
+	require.ErrorIs(t, err, collections.ErrInconsistentIndex)
+	require.ErrorContains(t, err, "found 5 references for 4 values")
+}
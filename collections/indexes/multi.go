@@ -101,6 +101,27 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context
 	return m.refKeys.Remove(ctx, collections.Join(refKey, pk))
 }
 
+// HasReference implements collections.RebuildableIndex.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) HasReference(ctx context.Context, pk PrimaryKey, value Value) (bool, error) {
+	refKey, err := m.getRefKey(pk, value)
+	if err != nil {
+		return false, err
+	}
+	return m.refKeys.Has(ctx, collections.Join(refKey, pk))
+}
+
+// WalkPrimaryKeys implements collections.RebuildableIndex.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) WalkPrimaryKeys(ctx context.Context, walkFunc func(pk PrimaryKey) (stop bool, err error)) error {
+	return m.refKeys.Walk(ctx, nil, func(key collections.Pair[ReferenceKey, PrimaryKey]) (bool, error) {
+		return walkFunc(key.K2())
+	})
+}
+
+// Clear implements collections.RebuildableIndex.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) Clear(ctx context.Context) error {
+	return m.refKeys.Clear(ctx, nil)
+}
+
 func (m *Multi[ReferenceKey, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]]) (MultiIterator[ReferenceKey, PrimaryKey], error) {
 	iter, err := m.refKeys.Iterate(ctx, ranger)
 	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), err
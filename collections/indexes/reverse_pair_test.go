@@ -106,3 +106,35 @@ func TestUncheckedReversePair(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte{}, rawValue)
 }
+
+func TestReversePairRebuild(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("balances"), "balances",
+		keyCodec,
+		collections.Uint64Value,
+		balanceIndex{
+			Denom: NewReversePair[Amount](sb, collections.NewPrefix("denom_index"), "denom_index", keyCodec),
+		},
+	)
+
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address1", "atom"), 100))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address2", "osmo"), 200))
+	require.NoError(t, indexedMap.VerifyIndexes(ctx))
+
+	require.NoError(t, indexedMap.Indexes.Denom.Clear(ctx))
+	require.ErrorIs(t, indexedMap.VerifyIndexes(ctx), collections.ErrInconsistentIndex)
+
+	require.NoError(t, indexedMap.RebuildIndex(ctx, indexedMap.Indexes.Denom, 1))
+	require.NoError(t, indexedMap.VerifyIndexes(ctx))
+
+	iter, err := indexedMap.Indexes.Denom.MatchExact(ctx, "osmo")
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[Address, Denom]{collections.Join("address2", "osmo")}, pks)
+}
@@ -0,0 +1,193 @@
+package indexes
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+type reverseQuadOptions struct {
+	uncheckedValue bool
+}
+
+// WithReverseQuadUncheckedValue is an option that can be passed to NewReverseQuad to
+// ignore index values different from '[]byte{}' and continue with the operation.
+// Refer to WithReversePairUncheckedValue for more information.
+func WithReverseQuadUncheckedValue() func(*reverseQuadOptions) {
+	return func(o *reverseQuadOptions) {
+		o.uncheckedValue = true
+	}
+}
+
+// ReverseQuad is an index that is used with collections.Quad keys. It indexes objects by the
+// parts of their key in reverse order. When the value is being indexed by collections.IndexedMap
+// then ReverseQuad will create a relationship between Join4(K4, K3, K2, K1) and the primary key,
+// which allows to efficiently find objects given the last parts of their key.
+type ReverseQuad[K1, K2, K3, K4, Value any] struct {
+	refKeys collections.KeySet[collections.Quad[K4, K3, K2, K1]] // refKeys has the relationships between Join4(K4, K3, K2, K1)
+}
+
+// quadKeyCodec is an interface to cast a collections.KeyCodec
+// to a quad codec, see pairKeyCodec.
+type quadKeyCodec[K1, K2, K3, K4 any] interface {
+	KeyCodec1() codec.KeyCodec[K1]
+	KeyCodec2() codec.KeyCodec[K2]
+	KeyCodec3() codec.KeyCodec[K3]
+	KeyCodec4() codec.KeyCodec[K4]
+}
+
+// NewReverseQuad instantiates a new ReverseQuad index.
+// NOTE: when using this function you will need to type hint: doing NewReverseQuad[Value]()
+// Example: if the value of the indexed map is string, you need to do NewReverseQuad[string](...)
+func NewReverseQuad[Value, K1, K2, K3, K4 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	quadCodec codec.KeyCodec[collections.Quad[K1, K2, K3, K4]],
+	options ...func(*reverseQuadOptions),
+) *ReverseQuad[K1, K2, K3, K4, Value] {
+	qkc := quadCodec.(quadKeyCodec[K1, K2, K3, K4])
+	o := new(reverseQuadOptions)
+	for _, option := range options {
+		option(o)
+	}
+
+	refCodec := collections.QuadKeyCodec(qkc.KeyCodec4(), qkc.KeyCodec3(), qkc.KeyCodec2(), qkc.KeyCodec1())
+	if o.uncheckedValue {
+		return &ReverseQuad[K1, K2, K3, K4, Value]{
+			refKeys: collections.NewKeySet(sb, prefix, name, refCodec, collections.WithKeySetUncheckedValue()),
+		}
+	}
+
+	return &ReverseQuad[K1, K2, K3, K4, Value]{
+		refKeys: collections.NewKeySet(sb, prefix, name, refCodec),
+	}
+}
+
+// Iterate exposes the raw iterator API.
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) Iterate(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Quad[K4, K3, K2, K1]],
+) (iter ReverseQuadIterator[K4, K3, K2, K1], err error) {
+	sIter, err := i.refKeys.Iterate(ctx, ranger)
+	if err != nil {
+		return
+	}
+	return (ReverseQuadIterator[K4, K3, K2, K1])(sIter), nil
+}
+
+// MatchExact will return an iterator containing only the primary keys ending with the provided
+// fourth part of the multipart quad key.
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) MatchExact(ctx context.Context, k4 K4) (ReverseQuadIterator[K4, K3, K2, K1], error) {
+	return i.Iterate(ctx, collections.NewPrefixedQuadRange[K4, K3, K2, K1](k4))
+}
+
+// MatchExact2 will return an iterator containing only the primary keys ending with the provided
+// third and fourth parts of the multipart quad key.
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) MatchExact2(ctx context.Context, k3 K3, k4 K4) (ReverseQuadIterator[K4, K3, K2, K1], error) {
+	return i.Iterate(ctx, collections.NewSuperPrefixedQuadRange[K4, K3, K2, K1](k4, k3))
+}
+
+// MatchExact3 will return an iterator containing only the primary keys ending with the provided
+// second, third and fourth parts of the multipart quad key.
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) MatchExact3(ctx context.Context, k2 K2, k3 K3, k4 K4) (ReverseQuadIterator[K4, K3, K2, K1], error) {
+	return i.Iterate(ctx, collections.NewSuperPrefixedQuadRange3[K4, K3, K2, K1](k4, k3, k2))
+}
+
+// Reference implements collections.Index
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) Reference(ctx context.Context, pk collections.Quad[K1, K2, K3, K4], _ Value, _ func() (Value, error)) error {
+	return i.refKeys.Set(ctx, reverseQuad(pk))
+}
+
+// Unreference implements collections.Index
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) Unreference(ctx context.Context, pk collections.Quad[K1, K2, K3, K4], _ func() (Value, error)) error {
+	return i.refKeys.Remove(ctx, reverseQuad(pk))
+}
+
+// HasReference implements collections.RebuildableIndex
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) HasReference(ctx context.Context, pk collections.Quad[K1, K2, K3, K4], _ Value) (bool, error) {
+	return i.refKeys.Has(ctx, reverseQuad(pk))
+}
+
+// WalkPrimaryKeys implements collections.RebuildableIndex
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) WalkPrimaryKeys(ctx context.Context, walkFunc func(pk collections.Quad[K1, K2, K3, K4]) (stop bool, err error)) error {
+	return i.refKeys.Walk(ctx, nil, func(key collections.Quad[K4, K3, K2, K1]) (bool, error) {
+		return walkFunc(reverseQuad(key))
+	})
+}
+
+// Clear implements collections.RebuildableIndex
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) Clear(ctx context.Context) error {
+	return i.refKeys.Clear(ctx, nil)
+}
+
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Quad[K4, K3, K2, K1]],
+	walkFunc func(indexingKey collections.Quad[K4, K3, K2, K1], indexedKey collections.Quad[K1, K2, K3, K4]) (stop bool, err error),
+) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Quad[K4, K3, K2, K1]) (bool, error) {
+		return walkFunc(key, reverseQuad(key))
+	})
+}
+
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) IterateRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (
+	iter collections.Iterator[collections.Quad[K4, K3, K2, K1], collections.NoValue], err error,
+) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+func (i *ReverseQuad[K1, K2, K3, K4, Value]) KeyCodec() codec.KeyCodec[collections.Quad[K4, K3, K2, K1]] {
+	return i.refKeys.KeyCodec()
+}
+
+// reverseQuad returns the quad key composed of the parts of the provided quad key, in reverse order.
+func reverseQuad[K1, K2, K3, K4 any](key collections.Quad[K1, K2, K3, K4]) collections.Quad[K4, K3, K2, K1] {
+	return collections.Join4(key.K4(), key.K3(), key.K2(), key.K1())
+}
+
+// ReverseQuadIterator is a helper type around a collections.KeySetIterator when used to work
+// with ReverseQuad indexes iterations.
+type ReverseQuadIterator[K4, K3, K2, K1 any] collections.KeySetIterator[collections.Quad[K4, K3, K2, K1]]
+
+// PrimaryKey returns the primary key from the index. The index is composed like a reverse
+// quad key. So we just fetch the quad key from the index and return the reverse.
+func (m ReverseQuadIterator[K4, K3, K2, K1]) PrimaryKey() (quad collections.Quad[K1, K2, K3, K4], err error) {
+	reversed, err := m.FullKey()
+	if err != nil {
+		return quad, err
+	}
+	return reverseQuad(reversed), nil
+}
+
+// PrimaryKeys returns all the primary keys contained in the iterator.
+func (m ReverseQuadIterator[K4, K3, K2, K1]) PrimaryKeys() (quads []collections.Quad[K1, K2, K3, K4], err error) {
+	defer m.Close()
+	for ; m.Valid(); m.Next() {
+		quad, err := m.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		quads = append(quads, quad)
+	}
+	return quads, err
+}
+
+func (m ReverseQuadIterator[K4, K3, K2, K1]) FullKey() (q collections.Quad[K4, K3, K2, K1], err error) {
+	return (collections.KeySetIterator[collections.Quad[K4, K3, K2, K1]])(m).Key()
+}
+
+func (m ReverseQuadIterator[K4, K3, K2, K1]) Next() {
+	(collections.KeySetIterator[collections.Quad[K4, K3, K2, K1]])(m).Next()
+}
+
+func (m ReverseQuadIterator[K4, K3, K2, K1]) Valid() bool {
+	return (collections.KeySetIterator[collections.Quad[K4, K3, K2, K1]])(m).Valid()
+}
+
+func (m ReverseQuadIterator[K4, K3, K2, K1]) Close() error {
+	return (collections.KeySetIterator[collections.Quad[K4, K3, K2, K1]])(m).Close()
+}
@@ -0,0 +1,84 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+)
+
+type (
+	Owner = string
+	Epoch = uint64
+	ID    = uint64
+)
+
+// our lots index, allows us to efficiently create an index between the key that maps
+// lots which is a collections.Quad[Denom, Owner, Epoch, ID] and its last parts.
+type lotIndex struct {
+	Reverse *ReverseQuad[Denom, Owner, Epoch, ID, Amount]
+}
+
+func (l lotIndex) IndexesList() []collections.Index[collections.Quad[Denom, Owner, Epoch, ID], Amount] {
+	return []collections.Index[collections.Quad[Denom, Owner, Epoch, ID], Amount]{l.Reverse}
+}
+
+func TestReverseQuad(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Uint64Key)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix(0), "lots",
+		keyCodec,
+		collections.Uint64Value,
+		lotIndex{
+			Reverse: NewReverseQuad[Amount](sb, collections.NewPrefix(1), "lots_reverse", keyCodec),
+		},
+	)
+
+	keys := []collections.Quad[Denom, Owner, Epoch, ID]{
+		collections.Join4("atom", "address1", uint64(1), uint64(1)),
+		collections.Join4("osmo", "address1", uint64(1), uint64(1)),
+		collections.Join4("osmo", "address2", uint64(2), uint64(1)),
+		collections.Join4("atom", "address2", uint64(1), uint64(2)),
+	}
+	for i, key := range keys {
+		require.NoError(t, indexedMap.Set(ctx, key, uint64(i)))
+	}
+
+	// assert if we match over id 1 we find the first three keys, ordered by epoch, owner and denom
+	iter, err := indexedMap.Indexes.Reverse.MatchExact(ctx, 1)
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Quad[Denom, Owner, Epoch, ID]{keys[0], keys[1], keys[2]}, pks)
+
+	iter, err = indexedMap.Indexes.Reverse.MatchExact2(ctx, 1, 1)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Quad[Denom, Owner, Epoch, ID]{keys[0], keys[1]}, pks)
+
+	iter, err = indexedMap.Indexes.Reverse.MatchExact3(ctx, "address2", 1, 2)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Quad[Denom, Owner, Epoch, ID]{keys[3]}, pks)
+
+	// assert if we remove a key, we can no longer find it in the index
+	require.NoError(t, indexedMap.Remove(ctx, keys[0]))
+	iter, err = indexedMap.Indexes.Reverse.MatchExact2(ctx, 1, 1)
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Quad[Denom, Owner, Epoch, ID]{keys[1]}, pks)
+
+	require.NoError(t, indexedMap.VerifyIndexes(ctx))
+	require.NoError(t, indexedMap.Indexes.Reverse.Clear(ctx))
+	require.ErrorIs(t, indexedMap.VerifyIndexes(ctx), collections.ErrInconsistentIndex)
+	require.NoError(t, indexedMap.RebuildIndex(ctx, indexedMap.Indexes.Reverse, 2))
+	require.NoError(t, indexedMap.VerifyIndexes(ctx))
+}
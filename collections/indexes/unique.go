@@ -1,6 +1,7 @@
 package indexes
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -78,6 +79,44 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Contex
 	return i.refKeys.Remove(ctx, refKey)
 }
 
+// HasReference implements collections.RebuildableIndex.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) HasReference(ctx context.Context, pk PrimaryKey, value Value) (bool, error) {
+	refKey, err := i.getRefKey(pk, value)
+	if err != nil {
+		return false, err
+	}
+	storedPk, err := i.refKeys.Get(ctx, refKey)
+	switch {
+	case err == nil:
+	case errors.Is(err, collections.ErrNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+	// the reference key might be referencing a different primary key
+	pkBytes, err := i.refKeys.ValueCodec().Encode(pk)
+	if err != nil {
+		return false, err
+	}
+	storedPkBytes, err := i.refKeys.ValueCodec().Encode(storedPk)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(pkBytes, storedPkBytes), nil
+}
+
+// WalkPrimaryKeys implements collections.RebuildableIndex.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) WalkPrimaryKeys(ctx context.Context, walkFunc func(pk PrimaryKey) (stop bool, err error)) error {
+	return i.refKeys.Walk(ctx, nil, func(_ ReferenceKey, pk PrimaryKey) (bool, error) {
+		return walkFunc(pk)
+	})
+}
+
+// Clear implements collections.RebuildableIndex.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) Clear(ctx context.Context) error {
+	return i.refKeys.Clear(ctx, nil)
+}
+
 func (i *Unique[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, ref ReferenceKey) (PrimaryKey, error) {
 	return i.refKeys.Get(ctx, ref)
 }
@@ -52,6 +52,7 @@ type noKey struct{}
 
 func (noKey) Stringify(_ noKey) string              { return "no_key" }
 func (noKey) KeyType() string                       { return "no_key" }
+func (k noKey) Schema() codec.Schema                { return codec.Schema{Kind: codec.EmptyKind, Type: k.KeyType()} }
 func (noKey) Size(_ noKey) int                      { return 0 }
 func (noKey) Encode(_ []byte, _ noKey) (int, error) { return 0, nil }
 func (noKey) Decode(_ []byte) (int, noKey, error)   { return 0, noKey{}, nil }
@@ -140,3 +140,7 @@ func (NoValue) Stringify(_ NoValue) string {
 func (n NoValue) ValueType() string {
 	return noValueValueType
 }
+
+func (n NoValue) Schema() codec.Schema {
+	return codec.Schema{Kind: codec.EmptyKind, Type: n.ValueType()}
+}
@@ -48,14 +48,24 @@ func PairPrefix[K1, K2 any](key K1) Pair[K1, K2] {
 // PairKeyCodec instantiates a new KeyCodec instance that can encode the Pair, given the KeyCodec of the
 // first part of the key and the KeyCodec of the second part of the key.
 func PairKeyCodec[K1, K2 any](keyCodec1 codec.KeyCodec[K1], keyCodec2 codec.KeyCodec[K2]) codec.KeyCodec[Pair[K1, K2]] {
+	return NamedPairKeyCodec("k1", keyCodec1, "k2", keyCodec2)
+}
+
+// NamedPairKeyCodec instantiates a new KeyCodec instance that can encode the Pair, like PairKeyCodec,
+// which additionally names the two parts of the key in the Schema of the Pair.
+func NamedPairKeyCodec[K1, K2 any](keyName1 string, keyCodec1 codec.KeyCodec[K1], keyName2 string, keyCodec2 codec.KeyCodec[K2]) codec.KeyCodec[Pair[K1, K2]] {
 	return pairKeyCodec[K1, K2]{
+		keyName1:  keyName1,
 		keyCodec1: keyCodec1,
+		keyName2:  keyName2,
 		keyCodec2: keyCodec2,
 	}
 }
 
 type pairKeyCodec[K1, K2 any] struct {
+	keyName1  string
 	keyCodec1 codec.KeyCodec[K1]
+	keyName2  string
 	keyCodec2 codec.KeyCodec[K2]
 }
 
@@ -135,6 +145,21 @@ func (p pairKeyCodec[K1, K2]) KeyType() string {
 	return fmt.Sprintf("Pair[%s, %s]", p.keyCodec1.KeyType(), p.keyCodec2.KeyType())
 }
 
+func (p pairKeyCodec[K1, K2]) Schema() codec.Schema {
+	return codec.Schema{
+		Kind:   codec.TupleKind,
+		Type:   p.KeyType(),
+		Fields: []codec.Schema{namedKeySchema(p.keyName1, p.keyCodec1), namedKeySchema(p.keyName2, p.keyCodec2)},
+	}
+}
+
+// namedKeySchema returns the Schema of the provided KeyCodec with the provided name.
+func namedKeySchema[K any](name string, keyCodec codec.KeyCodec[K]) codec.Schema {
+	schema := codec.KeySchema(keyCodec)
+	schema.Name = name
+	return schema
+}
+
 func (p pairKeyCodec[K1, K2]) EncodeNonTerminal(buffer []byte, pair Pair[K1, K2]) (int, error) {
 	writtenTotal := 0
 	if pair.key1 != nil {
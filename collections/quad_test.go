@@ -0,0 +1,91 @@
+package collections_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestQuad(t *testing.T) {
+	kc := collections.QuadKeyCodec(collections.StringKey, collections.BytesKey, collections.Uint64Key, collections.BoolKey)
+
+	t.Run("conformance", func(t *testing.T) {
+		colltest.TestKeyCodec(t, kc, collections.Join4("1", []byte("2"), uint64(3), true))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		bz, err := kc.EncodeJSON(collections.Join4("denom", []byte("owner"), uint64(3), true))
+		require.NoError(t, err)
+		require.JSONEq(t, `["denom","b3duZXI=","3",true]`, string(bz))
+	})
+}
+
+func TestQuadRange(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schema := collections.NewSchemaBuilder(sk)
+	// this is a key composed of 4 parts: string, string, uint64, uint64
+	kc := collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Uint64Key)
+
+	keySet := collections.NewKeySet(schema, collections.NewPrefix(0), "quad", kc)
+
+	keys := []collections.Quad[string, string, uint64, uint64]{
+		collections.Join4("atom", "A", uint64(1), uint64(1)),
+		collections.Join4("atom", "A", uint64(1), uint64(2)),
+		collections.Join4("atom", "A", uint64(2), uint64(1)),
+		collections.Join4("atom", "B", uint64(1), uint64(1)),
+		collections.Join4("osmo", "A", uint64(1), uint64(1)),
+	}
+
+	// keys are inserted in reverse order, they must be iterated in order
+	for i := len(keys) - 1; i >= 0; i-- {
+		require.NoError(t, keySet.Set(ctx, keys[i]))
+	}
+
+	iter, err := keySet.Iterate(ctx, nil)
+	require.NoError(t, err)
+	gotKeys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, keys, gotKeys)
+
+	// we prefix over ("atom") we expect 4 results
+	iter, err = keySet.Iterate(ctx, collections.NewPrefixedQuadRange[string, string, uint64, uint64]("atom"))
+	require.NoError(t, err)
+	gotKeys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, keys[:4], gotKeys)
+
+	// we super prefix over ("atom", "A") we expect 3 results
+	iter, err = keySet.Iterate(ctx, collections.NewSuperPrefixedQuadRange[string, string, uint64, uint64]("atom", "A"))
+	require.NoError(t, err)
+	gotKeys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, keys[:3], gotKeys)
+
+	// we super prefix over ("atom", "A", 1) we expect 2 results
+	iter, err = keySet.Iterate(ctx, collections.NewSuperPrefixedQuadRange3[string, string, uint64, uint64]("atom", "A", 1))
+	require.NoError(t, err)
+	gotKeys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, keys[:2], gotKeys)
+
+	// we range until ("atom") we expect 4 results
+	iter, err = keySet.Iterate(ctx, collections.NewPrefixUntilQuadRange[string, string, uint64, uint64]("atom"))
+	require.NoError(t, err)
+	gotKeys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, keys[:4], gotKeys)
+
+	// the genesis of the collection round trips
+	buf := new(bytes.Buffer)
+	genesisSchema, err := schema.Build()
+	require.NoError(t, err)
+	require.NoError(t, genesisSchema.ExportGenesis(ctx, func(string) (io.WriteCloser, error) {
+		return nopCloser{buf}, nil
+	}))
+	require.Contains(t, buf.String(), `["atom","A","1","2"]`)
+}
@@ -57,16 +57,32 @@ func TripleSuperPrefix[K1, K2, K3 any](k1 K1, k2 K2) Triple[K1, K2, K3] {
 // TripleKeyCodec instantiates a new KeyCodec instance that can encode the Triple, given
 // the KeyCodecs of the three parts of the key, in order.
 func TripleKeyCodec[K1, K2, K3 any](keyCodec1 codec.KeyCodec[K1], keyCodec2 codec.KeyCodec[K2], keyCodec3 codec.KeyCodec[K3]) codec.KeyCodec[Triple[K1, K2, K3]] {
+	return NamedTripleKeyCodec("k1", keyCodec1, "k2", keyCodec2, "k3", keyCodec3)
+}
+
+// NamedTripleKeyCodec instantiates a new KeyCodec instance that can encode the Triple, like
+// TripleKeyCodec, which additionally names the three parts of the key in the Schema of the Triple.
+func NamedTripleKeyCodec[K1, K2, K3 any](
+	keyName1 string, keyCodec1 codec.KeyCodec[K1],
+	keyName2 string, keyCodec2 codec.KeyCodec[K2],
+	keyName3 string, keyCodec3 codec.KeyCodec[K3],
+) codec.KeyCodec[Triple[K1, K2, K3]] {
 	return tripleKeyCodec[K1, K2, K3]{
+		keyName1:  keyName1,
 		keyCodec1: keyCodec1,
+		keyName2:  keyName2,
 		keyCodec2: keyCodec2,
+		keyName3:  keyName3,
 		keyCodec3: keyCodec3,
 	}
 }
 
 type tripleKeyCodec[K1, K2, K3 any] struct {
+	keyName1  string
 	keyCodec1 codec.KeyCodec[K1]
+	keyName2  string
 	keyCodec2 codec.KeyCodec[K2]
+	keyName3  string
 	keyCodec3 codec.KeyCodec[K3]
 }
 
@@ -153,6 +169,18 @@ func (t tripleKeyCodec[K1, K2, K3]) KeyType() string {
 	return fmt.Sprintf("Triple[%s,%s,%s]", t.keyCodec1.KeyType(), t.keyCodec2.KeyType(), t.keyCodec3.KeyType())
 }
 
+func (t tripleKeyCodec[K1, K2, K3]) Schema() codec.Schema {
+	return codec.Schema{
+		Kind: codec.TupleKind,
+		Type: t.KeyType(),
+		Fields: []codec.Schema{
+			namedKeySchema(t.keyName1, t.keyCodec1),
+			namedKeySchema(t.keyName2, t.keyCodec2),
+			namedKeySchema(t.keyName3, t.keyCodec3),
+		},
+	}
+}
+
 func (t tripleKeyCodec[K1, K2, K3]) Encode(buffer []byte, key Triple[K1, K2, K3]) (int, error) {
 	writtenTotal := 0
 	if key.k1 != nil {
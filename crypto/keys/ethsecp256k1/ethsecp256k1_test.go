@@ -0,0 +1,89 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestPubKeyAddress(t *testing.T) {
+	// Ethereum addresses of well-known private keys
+	cases := []struct {
+		priv string
+		addr string
+	}{
+		{"0000000000000000000000000000000000000000000000000000000000000001", "7e5f4552091a69125d5dfcb7b8c2659029395bdf"},
+		{"0000000000000000000000000000000000000000000000000000000000000002", "2b5ad5c4795c026514f8317c7a215e218dccd6cf"},
+	}
+
+	for _, tc := range cases {
+		privBz, err := hex.DecodeString(tc.priv)
+		require.NoError(t, err)
+		priv := &ethsecp256k1.PrivKey{Key: privBz}
+
+		pubKey := priv.PubKey()
+		require.Len(t, pubKey.Bytes(), ethsecp256k1.PubKeySize)
+		require.Equal(t, tc.addr, hex.EncodeToString(pubKey.Address()))
+
+		// the keys differ from secp256k1 keys only by their address and signatures
+		secpPubKey := (&secp256k1.PrivKey{Key: privBz}).PubKey()
+		require.Equal(t, secpPubKey.Bytes(), pubKey.Bytes())
+		require.NotEqual(t, secpPubKey.Address(), pubKey.Address())
+		require.False(t, pubKey.Equals(secpPubKey))
+	}
+}
+
+func TestSignAndVerifySignature(t *testing.T) {
+	priv := ethsecp256k1.GenPrivKey()
+	pubKey := priv.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// wrong message
+	require.False(t, pubKey.VerifySignature([]byte("hello"), sig))
+
+	// wrong key
+	require.False(t, ethsecp256k1.GenPrivKey().PubKey().VerifySignature(msg, sig))
+
+	// corrupted signature
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// secp256k1 signatures, which are not of the keccak256 hash of the msg
+	secpSig, err := (&secp256k1.PrivKey{Key: priv.Key}).Sign(msg)
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature(msg, secpSig))
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	cryptocodec.RegisterCrypto(aminoCdc)
+	priv := ethsecp256k1.GenPrivKey()
+
+	for _, key := range []any{priv, priv.PubKey()} {
+		bz, err := aminoCdc.MarshalJSON(key)
+		require.NoError(t, err)
+
+		switch key.(type) {
+		case cryptotypes.PrivKey:
+			var decoded cryptotypes.PrivKey
+			require.NoError(t, aminoCdc.UnmarshalJSON(bz, &decoded))
+			require.True(t, priv.Equals(decoded))
+		case cryptotypes.PubKey:
+			var decoded cryptotypes.PubKey
+			require.NoError(t, aminoCdc.UnmarshalJSON(bz, &decoded))
+			require.True(t, priv.PubKey().Equals(decoded))
+		}
+	}
+}
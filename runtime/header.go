@@ -0,0 +1,18 @@
+package runtime
+
+import (
+	"context"
+
+	"cosmossdk.io/core/header"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ header.Service = (*HeaderService)(nil)
+
+// HeaderService implements header.Service using the header info of the sdk.Context.
+type HeaderService struct{}
+
+func (h HeaderService) GetHeaderInfo(ctx context.Context) header.Info {
+	return sdk.UnwrapSDKContext(ctx).HeaderInfo()
+}
@@ -118,6 +118,7 @@ var (
 						evidencetypes.ModuleName,
 						stakingtypes.ModuleName,
 						authz.ModuleName,
+						authtypes.ModuleName,
 					},
 					EndBlockers: []string{
 						govtypes.ModuleName,
@@ -0,0 +1,156 @@
+package commitment_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/smt"
+)
+
+const storeKey1 = "store1"
+
+var trees = map[string]func(db dbm.DB) commitment.Tree{
+	"iavl": func(db dbm.DB) commitment.Tree {
+		return iavl.NewIavlTree(db, log.NewNopLogger(), iavl.DefaultConfig())
+	},
+	"smt": func(db dbm.DB) commitment.Tree {
+		return smt.NewSparseMerkleTree(db)
+	},
+}
+
+func newCommitStore(b *testing.B, newTree func(db dbm.DB) commitment.Tree) *commitment.CommitStore {
+	b.Helper()
+
+	cs, err := commitment.NewCommitStore(map[string]commitment.Tree{storeKey1: newTree(dbm.NewMemDB())}, log.NewNopLogger())
+	require.NoError(b, err)
+
+	return cs
+}
+
+func newChangeset(rng *rand.Rand, numKVs int) *store.Changeset {
+	cs := store.NewChangeset(map[string]store.KVPairs{})
+	for i := 0; i < numKVs; i++ {
+		key := make([]byte, 32)
+		val := make([]byte, 128)
+		rng.Read(key)
+		rng.Read(val)
+
+		cs.Add(storeKey1, key, val)
+	}
+
+	return cs
+}
+
+func BenchmarkCommit(b *testing.B) {
+	for _, numKVs := range []int{100, 1_000} {
+		for name, newTree := range trees {
+			b.Run(fmt.Sprintf("%s-%d", name, numKVs), func(b *testing.B) {
+				rng := rand.New(rand.NewSource(567320))
+				cs := newCommitStore(b, newTree)
+				defer cs.Close()
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					changeset := newChangeset(rng, numKVs)
+					b.StartTimer()
+
+					require.NoError(b, cs.WriteBatch(changeset))
+					cs.WorkingStoreInfos(uint64(i + 1))
+					_, err := cs.Commit()
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkGetProof(b *testing.B) {
+	const numKVs = 10_000
+
+	for name, newTree := range trees {
+		b.Run(name, func(b *testing.B) {
+			rng := rand.New(rand.NewSource(567320))
+			cs := newCommitStore(b, newTree)
+			defer cs.Close()
+
+			changeset := newChangeset(rng, numKVs)
+			require.NoError(b, cs.WriteBatch(changeset))
+			cs.WorkingStoreInfos(1)
+			_, err := cs.Commit()
+			require.NoError(b, err)
+
+			keys := make([][]byte, 0, numKVs)
+			for _, kv := range changeset.Pairs[storeKey1] {
+				keys = append(keys, kv.Key)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := cs.GetProof(storeKey1, 1, keys[i%len(keys)])
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelCommit(b *testing.B) {
+	const (
+		numStores = 24
+		numKVs    = 500
+	)
+
+	storeKeys := make([]string, numStores)
+	for i := range storeKeys {
+		storeKeys[i] = fmt.Sprintf("store%02d", i)
+	}
+
+	for name, newTree := range trees {
+		for _, maxWorkers := range []int{1, 2, 4, 8} {
+			b.Run(fmt.Sprintf("%s-workers-%d", name, maxWorkers), func(b *testing.B) {
+				rng := rand.New(rand.NewSource(567320))
+
+				multiTrees := make(map[string]commitment.Tree, numStores)
+				for _, storeKey := range storeKeys {
+					multiTrees[storeKey] = newTree(dbm.NewMemDB())
+				}
+
+				cs, err := commitment.NewCommitStore(multiTrees, log.NewNopLogger())
+				require.NoError(b, err)
+				defer cs.Close()
+
+				cs.SetMaxWorkers(maxWorkers)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					changeset := store.NewChangeset(map[string]store.KVPairs{})
+					for _, storeKey := range storeKeys {
+						for j := 0; j < numKVs; j++ {
+							key := make([]byte, 32)
+							val := make([]byte, 128)
+							rng.Read(key)
+							rng.Read(val)
+
+							changeset.Add(storeKey, key, val)
+						}
+					}
+					b.StartTimer()
+
+					require.NoError(b, cs.WriteBatch(changeset))
+					cs.WorkingStoreInfos(uint64(i + 1))
+					_, err := cs.Commit()
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
@@ -8,10 +8,14 @@ import (
 	ics23 "github.com/cosmos/ics23/go"
 
 	log "cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
 )
 
-var _ commitment.Tree = (*IavlTree)(nil)
+var (
+	_ commitment.Tree            = (*IavlTree)(nil)
+	_ commitment.ChangesetGetter = (*IavlTree)(nil)
+)
 
 // IavlTree is a wrapper around iavl.MutableTree.
 type IavlTree struct {
@@ -70,6 +74,28 @@ func (t *IavlTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof,
 	return imutableTree.GetProof(key)
 }
 
+// GetChangeset returns the key/value pairs written at the given version.
+func (t *IavlTree) GetChangeset(version uint64) (store.KVPairs, error) {
+	var pairs store.KVPairs
+	err := t.tree.TraverseStateChanges(int64(version), int64(version), func(_ int64, changeSet *iavl.ChangeSet) error {
+		for _, pair := range changeSet.Pairs {
+			kvPair := store.KVPair{Key: pair.Key}
+			if !pair.Delete {
+				kvPair.Value = pair.Value
+			}
+
+			pairs = append(pairs, kvPair)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
 // GetLatestVersion returns the latest version of the database.
 func (t *IavlTree) GetLatestVersion() uint64 {
 	return uint64(t.tree.Version())
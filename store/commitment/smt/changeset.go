@@ -0,0 +1,129 @@
+package smt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/store/v2"
+)
+
+// GetChangeset returns the key/value pairs written at the given version, ordered
+// by key, by comparing the tree of the given version with the tree of the
+// previous version. Subtrees with matching hashes are skipped, so only the paths
+// leading to modified leaves are loaded.
+func (t *SparseMerkleTree) GetChangeset(version uint64) (store.KVPairs, error) {
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	prevRoot, err := t.getPrevRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs store.KVPairs
+	if err := t.diff(prevRoot, root, 0, &pairs); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(pairs, func(a, b store.KVPair) int {
+		return bytes.Compare(a.Key, b.Key)
+	})
+
+	return pairs, nil
+}
+
+// getPrevRoot returns the root node of the version preceding the given version,
+// or nil if the given version is the first persisted version.
+func (t *SparseMerkleTree) getPrevRoot(version uint64) (*node, error) {
+	if version == 0 {
+		return nil, nil
+	}
+
+	ok, err := t.db.Has(rootKey(version - 1))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return t.getRoot(version - 1)
+	}
+
+	itr, err := t.db.ReverseIterator([]byte{prefixRoot}, rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	if itr.Valid() {
+		return nil, fmt.Errorf("version %d is pruned", version-1)
+	}
+
+	return nil, itr.Error()
+}
+
+// diff appends the key/value pairs that differ between the subtrees rooted at
+// prev and next, both located at the given depth, to pairs. Leaves that only
+// exist in prev are appended with a nil value.
+func (t *SparseMerkleTree) diff(prev, next *node, depth int, pairs *store.KVPairs) error {
+	if prev == nil && next == nil {
+		return nil
+	}
+	if prev != nil && next != nil && bytes.Equal(t.hash(prev), t.hash(next)) {
+		return nil
+	}
+
+	for _, n := range []*node{prev, next} {
+		if n != nil {
+			if err := t.load(n); err != nil {
+				return err
+			}
+		}
+	}
+
+	if (prev == nil || prev.isLeaf) && (next == nil || next.isLeaf) {
+		if prev != nil && (next == nil || !bytes.Equal(prev.key, next.key)) {
+			*pairs = append(*pairs, store.KVPair{Key: prev.key})
+		}
+		if next != nil {
+			*pairs = append(*pairs, store.KVPair{Key: next.key, Value: next.value})
+		}
+
+		return nil
+	}
+
+	if depth >= hashSize*8 {
+		return errors.New("maximum tree depth exceeded")
+	}
+
+	prevLeft, prevRight := children(prev, depth)
+	nextLeft, nextRight := children(next, depth)
+
+	if err := t.diff(prevLeft, nextLeft, depth+1, pairs); err != nil {
+		return err
+	}
+
+	return t.diff(prevRight, nextRight, depth+1, pairs)
+}
+
+// children returns the left and right subtrees of the given loaded node located
+// at the given depth. Since the tree is compact, a leaf is its own subtree on the
+// side of its path.
+func children(n *node, depth int) (left, right *node) {
+	switch {
+	case n == nil:
+		return nil, nil
+
+	case n.isLeaf:
+		if getBit(n.path, depth) == 0 {
+			return n, nil
+		}
+
+		return nil, n
+
+	default:
+		return n.left, n.right
+	}
+}
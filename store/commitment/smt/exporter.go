@@ -0,0 +1,60 @@
+package smt
+
+import (
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Exporter exports the leaves of a SparseMerkleTree version in order of their
+// paths. Since the structure of the tree only depends on its set of keys, the
+// leaves are sufficient to restore the tree, so inner nodes are not exported.
+type Exporter struct {
+	tree  *SparseMerkleTree
+	stack []*node
+}
+
+func newExporter(tree *SparseMerkleTree, root *node) *Exporter {
+	e := &Exporter{tree: tree}
+	if root != nil {
+		e.stack = append(e.stack, root)
+	}
+
+	return e
+}
+
+// Next returns the next item in the exporter.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	for len(e.stack) > 0 {
+		n := e.stack[len(e.stack)-1]
+		e.stack = e.stack[:len(e.stack)-1]
+
+		if err := e.tree.load(n); err != nil {
+			return nil, err
+		}
+
+		if n.isLeaf {
+			return &snapshotstypes.SnapshotIAVLItem{
+				Key:     n.key,
+				Value:   n.value,
+				Version: int64(n.version()),
+				Height:  0,
+			}, nil
+		}
+
+		// push the right child first s.t. the left child is exported first
+		for _, child := range []*node{n.right, n.left} {
+			if child != nil {
+				e.stack = append(e.stack, child)
+			}
+		}
+	}
+
+	return nil, commitment.ErrorExportDone
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.stack = nil
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package smt
+
+import (
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Importer imports the leaves of a tree into an empty SparseMerkleTree. Inner
+// nodes, e.g. from a snapshot of an IAVL tree, are ignored since the tree is
+// rebuilt from its leaves.
+type Importer struct {
+	tree    *SparseMerkleTree
+	version uint64
+}
+
+// Add adds the given item to the importer.
+func (i *Importer) Add(item *snapshotstypes.SnapshotIAVLItem) error {
+	if item.Height > 0 {
+		return nil
+	}
+
+	return i.tree.Set(item.Key, item.Value)
+}
+
+// Commit commits the imported leaves as the version of the importer.
+func (i *Importer) Commit() error {
+	_, err := i.tree.commit(i.version)
+	return err
+}
+
+// Close closes the importer.
+func (i *Importer) Close() error {
+	return nil
+}
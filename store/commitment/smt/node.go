@@ -0,0 +1,237 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	hashSize    = sha256.Size
+	nodeKeySize = 12 // <version (8 bytes)><sequence (4 bytes)>
+
+	nodeTypeLeaf  byte = 0
+	nodeTypeInner byte = 1
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+
+	// emptyHash defines the hash of an empty subtree, which is also the root hash
+	// of an empty tree.
+	emptyHash = make([]byte, hashSize)
+)
+
+// node defines a node of the sparse Merkle tree. A node is either a leaf, which
+// holds a key/value pair, or an inner node with two children, either of which
+// may be empty, i.e. nil. The tree is compact, meaning that a subtree holding a
+// single leaf is replaced by the leaf itself.
+//
+// A node that has been persisted has a non-nil nodeKey. Persisted nodes are
+// loaded lazily, i.e. a node referenced by its parent only has its hash and
+// nodeKey set until it is loaded.
+type node struct {
+	hash    []byte
+	nodeKey []byte
+	loaded  bool
+	isLeaf  bool
+
+	// leaf node fields
+	key, value, path []byte
+
+	// inner node fields
+	left, right *node
+}
+
+func newLeaf(key, value []byte) *node {
+	path := sha256.Sum256(key)
+
+	return &node{
+		loaded: true,
+		isLeaf: true,
+		key:    key,
+		value:  value,
+		path:   path[:],
+	}
+}
+
+func newInner(left, right *node) *node {
+	return &node{
+		loaded: true,
+		left:   left,
+		right:  right,
+	}
+}
+
+// child returns the child of an inner node in the given direction, where 0
+// denotes left and 1 denotes right.
+func (n *node) child(bit byte) *node {
+	if bit == 0 {
+		return n.left
+	}
+
+	return n.right
+}
+
+// version returns the version at which the node was persisted, or zero if the
+// node is not persisted.
+func (n *node) version() uint64 {
+	if n.nodeKey == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(n.nodeKey)
+}
+
+// getBit returns the bit of the path at the given depth, where the most
+// significant bit of the first byte is at depth zero. This ensures an in-order
+// traversal of the tree yields leaves in lexicographical order of their paths.
+func getBit(path []byte, depth int) byte {
+	return (path[depth/8] >> (7 - depth%8)) & 1
+}
+
+// leafHash returns SHA256(0x00 || SHA256(key) || SHA256(value)).
+func leafHash(path, value []byte) []byte {
+	valueHash := sha256.Sum256(value)
+
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write(path)
+	h.Write(valueHash[:])
+
+	return h.Sum(nil)
+}
+
+// innerHash returns SHA256(0x01 || left || right).
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(innerPrefix)
+	h.Write(left)
+	h.Write(right)
+
+	return h.Sum(nil)
+}
+
+// encodeNode encodes a loaded node. Leaf nodes are encoded as:
+//
+//	<0x00><uvarint len(key)><key><value>
+//
+// Inner nodes are encoded as <0x01><left><right>, where each child is encoded as
+// <0x00> if it is empty, or as <0x01><hash><nodeKey> otherwise. Note, children
+// must be persisted before their parent is encoded.
+func encodeNode(n *node) []byte {
+	var buf bytes.Buffer
+
+	if n.isLeaf {
+		buf.WriteByte(nodeTypeLeaf)
+		buf.Write(binary.AppendUvarint(nil, uint64(len(n.key))))
+		buf.Write(n.key)
+		buf.Write(n.value)
+
+		return buf.Bytes()
+	}
+
+	buf.WriteByte(nodeTypeInner)
+	for _, child := range []*node{n.left, n.right} {
+		if child == nil {
+			buf.WriteByte(0)
+			continue
+		}
+
+		buf.WriteByte(1)
+		buf.Write(child.hash)
+		buf.Write(child.nodeKey)
+	}
+
+	return buf.Bytes()
+}
+
+// decodeNode decodes the given bytes into the given node, which is expected to
+// have its hash and nodeKey already set.
+func decodeNode(n *node, bz []byte) error {
+	if len(bz) == 0 {
+		return errors.New("empty node")
+	}
+
+	switch bz[0] {
+	case nodeTypeLeaf:
+		keyLen, m := binary.Uvarint(bz[1:])
+		if m <= 0 || uint64(len(bz)-1-m) < keyLen {
+			return errors.New("invalid leaf node key length")
+		}
+
+		offset := 1 + m
+		n.isLeaf = true
+		n.key = bz[offset : offset+int(keyLen)]
+		n.value = bz[offset+int(keyLen):]
+
+		path := sha256.Sum256(n.key)
+		n.path = path[:]
+
+	case nodeTypeInner:
+		rest := bz[1:]
+
+		children := make([]*node, 2)
+		for i := range children {
+			if len(rest) == 0 {
+				return errors.New("invalid inner node child")
+			}
+
+			if rest[0] == 0 {
+				rest = rest[1:]
+				continue
+			}
+
+			if len(rest) < 1+hashSize+nodeKeySize {
+				return errors.New("invalid inner node child length")
+			}
+
+			children[i] = &node{
+				hash:    rest[1 : 1+hashSize],
+				nodeKey: rest[1+hashSize : 1+hashSize+nodeKeySize],
+			}
+			rest = rest[1+hashSize+nodeKeySize:]
+		}
+
+		if len(rest) != 0 {
+			return errors.New("invalid inner node length")
+		}
+
+		n.left, n.right = children[0], children[1]
+
+	default:
+		return fmt.Errorf("invalid node type: %d", bz[0])
+	}
+
+	n.loaded = true
+	return nil
+}
+
+// encodeNodeRef encodes a reference to a persisted node as <hash><nodeKey>. An
+// empty reference denotes an empty tree.
+func encodeNodeRef(n *node) []byte {
+	if n == nil {
+		return []byte{}
+	}
+
+	return append(append([]byte{}, n.hash...), n.nodeKey...)
+}
+
+// decodeNodeRef decodes a reference to a persisted node into an unloaded node.
+func decodeNodeRef(bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	if len(bz) != hashSize+nodeKeySize {
+		return nil, fmt.Errorf("invalid node reference length: %d", len(bz))
+	}
+
+	return &node{
+		hash:    bz[:hashSize],
+		nodeKey: bz[hashSize:],
+	}, nil
+}
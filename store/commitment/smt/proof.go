@@ -0,0 +1,195 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// createProof returns an existence proof of the given key in the tree rooted at
+// root if it exists, otherwise a non-existence proof containing existence proofs
+// of its left and right neighbors, if any. Neighbors are defined by the order of
+// the hashed keys, per the ICS23 SMT proof spec.
+func (t *SparseMerkleTree) createProof(root *node, key []byte) (*ics23.CommitmentProof, error) {
+	if root == nil {
+		return nil, errors.New("cannot create a proof for an empty tree")
+	}
+
+	path := sha256.Sum256(key)
+
+	leaf, err := t.getLeaf(root, path[:])
+	if err != nil {
+		return nil, err
+	}
+	if leaf != nil && bytes.Equal(leaf.key, key) {
+		exist, err := t.createExistenceProof(root, leaf)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{Exist: exist},
+		}, nil
+	}
+
+	nonexist := &ics23.NonExistenceProof{Key: key}
+
+	left, err := t.getNeighbor(root, 0, path[:], 0)
+	if err != nil {
+		return nil, err
+	}
+	if left != nil {
+		if nonexist.Left, err = t.createExistenceProof(root, left); err != nil {
+			return nil, err
+		}
+	}
+
+	right, err := t.getNeighbor(root, 0, path[:], 1)
+	if err != nil {
+		return nil, err
+	}
+	if right != nil {
+		if nonexist.Right, err = t.createExistenceProof(root, right); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonexist},
+	}, nil
+}
+
+// createExistenceProof returns an existence proof of the given leaf, which must
+// exist in the tree rooted at root.
+func (t *SparseMerkleTree) createExistenceProof(root, leaf *node) (*ics23.ExistenceProof, error) {
+	var ops []*ics23.InnerOp
+
+	n := root
+	for depth := 0; ; depth++ {
+		if err := t.load(n); err != nil {
+			return nil, err
+		}
+		if n.isLeaf {
+			break
+		}
+
+		bit := getBit(leaf.path, depth)
+
+		sibling := t.hash(n.child(1 - bit))
+		if bit == 0 {
+			ops = append(ops, &ics23.InnerOp{
+				Hash:   ics23.HashOp_SHA256,
+				Prefix: innerPrefix,
+				Suffix: sibling,
+			})
+		} else {
+			ops = append(ops, &ics23.InnerOp{
+				Hash:   ics23.HashOp_SHA256,
+				Prefix: append(slices.Clone(innerPrefix), sibling...),
+			})
+		}
+
+		n = n.child(bit)
+		if n == nil {
+			return nil, fmt.Errorf("leaf %X not found", leaf.key)
+		}
+	}
+
+	if !bytes.Equal(n.key, leaf.key) {
+		return nil, fmt.Errorf("leaf %X not found", leaf.key)
+	}
+
+	// ICS23 expects the path ordered from the leaf to the root
+	slices.Reverse(ops)
+
+	return &ics23.ExistenceProof{
+		Key:   leaf.key,
+		Value: leaf.value,
+		Leaf: &ics23.LeafOp{
+			Hash:         ics23.HashOp_SHA256,
+			PrehashKey:   ics23.HashOp_SHA256,
+			PrehashValue: ics23.HashOp_SHA256,
+			Length:       ics23.LengthOp_NO_PREFIX,
+			Prefix:       leafPrefix,
+		},
+		Path: ops,
+	}, nil
+}
+
+// getLeaf returns the leaf located on the given path in the tree rooted at root,
+// if any. Note, the key of the returned leaf may differ from the key of the path
+// as the tree is compact.
+func (t *SparseMerkleTree) getLeaf(root *node, path []byte) (*node, error) {
+	n := root
+	for depth := 0; n != nil; depth++ {
+		if err := t.load(n); err != nil {
+			return nil, err
+		}
+		if n.isLeaf {
+			return n, nil
+		}
+
+		n = n.child(getBit(path, depth))
+	}
+
+	return nil, nil
+}
+
+// getNeighbor returns the leaf of the subtree rooted at n, located at the given
+// depth, whose path is the closest to the given path from the given direction,
+// i.e. the largest path lower than the given path for direction 0 and the
+// smallest path greater than the given path for direction 1.
+func (t *SparseMerkleTree) getNeighbor(n *node, depth int, path []byte, dir byte) (*node, error) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if err := t.load(n); err != nil {
+		return nil, err
+	}
+
+	if n.isLeaf {
+		cmp := bytes.Compare(n.path, path)
+		if (dir == 0 && cmp < 0) || (dir == 1 && cmp > 0) {
+			return n, nil
+		}
+
+		return nil, nil
+	}
+
+	bit := getBit(path, depth)
+
+	neighbor, err := t.getNeighbor(n.child(bit), depth+1, path, dir)
+	if err != nil || neighbor != nil || bit == dir {
+		return neighbor, err
+	}
+
+	// The path is in the subtree farther from the direction of the neighbor, so
+	// the neighbor is the closest leaf of the other subtree, if any.
+	return t.getOutermost(n.child(dir), 1-dir)
+}
+
+// getOutermost returns the leftmost (dir = 0) or rightmost (dir = 1) leaf of the
+// subtree rooted at n.
+func (t *SparseMerkleTree) getOutermost(n *node, dir byte) (*node, error) {
+	for n != nil {
+		if err := t.load(n); err != nil {
+			return nil, err
+		}
+		if n.isLeaf {
+			return n, nil
+		}
+
+		if child := n.child(dir); child != nil {
+			n = child
+		} else {
+			n = n.child(1 - dir)
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,541 @@
+package smt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+)
+
+const (
+	// key prefixes of the data persisted in the underlying database
+	prefixNode   byte = 'n' // n<nodeKey> -> encoded node
+	prefixOrphan byte = 'o' // o<version><nodeKey> -> nil, the node is not reachable from <version> on
+	prefixRoot   byte = 'r' // r<version> -> root node reference
+)
+
+var (
+	_ commitment.Tree                = (*SparseMerkleTree)(nil)
+	_ commitment.CommitmentOpCreator = (*SparseMerkleTree)(nil)
+	_ commitment.ChangesetGetter     = (*SparseMerkleTree)(nil)
+)
+
+// SparseMerkleTree is a versioned, compact sparse Merkle tree whose proofs follow
+// the ICS23 SMT proof spec. Keys are placed in the tree at the path defined by
+// their SHA256 hash, which makes the root hash independent of the order in
+// which keys are written.
+//
+// Nodes are persisted once per version, keyed by the version and a sequence
+// number. When a persisted node is replaced, it is recorded as an orphan of the
+// version that replaced it, which allows pruning old versions and rolling back
+// to a previous version without reference counting.
+type SparseMerkleTree struct {
+	db dbm.DB
+
+	root           *node
+	version        uint64
+	initialVersion uint64
+
+	// orphans reflects the keys of the persisted nodes replaced in the working tree
+	orphans [][]byte
+}
+
+// NewSparseMerkleTree creates a new SparseMerkleTree instance.
+func NewSparseMerkleTree(db dbm.DB) *SparseMerkleTree {
+	return &SparseMerkleTree{
+		db: db,
+	}
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *SparseMerkleTree) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+
+	leaf := newLeaf(key, value)
+
+	root, err := t.set(t.root, 0, leaf)
+	if err != nil {
+		return err
+	}
+
+	t.root = root
+	return nil
+}
+
+// Remove removes the given key from the tree.
+func (t *SparseMerkleTree) Remove(key []byte) error {
+	leaf := newLeaf(key, nil)
+
+	root, found, err := t.remove(t.root, 0, leaf)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("key %x not found", key)
+	}
+
+	t.root = root
+	return nil
+}
+
+// GetLatestVersion returns the latest version of the tree.
+func (t *SparseMerkleTree) GetLatestVersion() uint64 {
+	return t.version
+}
+
+// WorkingHash returns the root hash of the working tree.
+func (t *SparseMerkleTree) WorkingHash() []byte {
+	return t.hash(t.root)
+}
+
+// LoadVersion loads the tree at the given version, or the latest version if
+// zero is provided. All versions greater than the given version are deleted,
+// s.t. subsequent commits overwrite them.
+func (t *SparseMerkleTree) LoadVersion(version uint64) error {
+	if version == 0 {
+		latestVersion, err := t.getLatestPersistedVersion()
+		if err != nil {
+			return err
+		}
+
+		version = latestVersion
+	}
+
+	var root *node
+	if version > 0 {
+		var err error
+		root, err = t.getRoot(version)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := t.deleteVersionsFrom(version + 1); err != nil {
+		return err
+	}
+
+	t.root = root
+	t.version = version
+	t.orphans = nil
+
+	return nil
+}
+
+// Commit persists the working tree as a new version and returns its root hash.
+func (t *SparseMerkleTree) Commit() ([]byte, error) {
+	version := t.version + 1
+	if t.version == 0 && t.initialVersion > 0 {
+		version = t.initialVersion
+	}
+
+	return t.commit(version)
+}
+
+// SetInitialVersion sets the initial version of the tree.
+func (t *SparseMerkleTree) SetInitialVersion(version uint64) error {
+	t.initialVersion = version
+	return nil
+}
+
+// GetProof returns an ICS23 proof for the given key and version. An existence
+// proof is returned if the key exists at the given version, otherwise a
+// non-existence proof is returned.
+func (t *SparseMerkleTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.createProof(root, key)
+}
+
+// NewCommitmentOp implements commitment.CommitmentOpCreator.
+func (t *SparseMerkleTree) NewCommitmentOp(key []byte, proof *ics23.CommitmentProof) store.CommitmentOp {
+	return store.NewSMTCommitmentOp(key, proof)
+}
+
+// Prune prunes all versions up to and including the provided version.
+func (t *SparseMerkleTree) Prune(version uint64) error {
+	if version >= t.version {
+		return fmt.Errorf("cannot prune version %d; latest version is %d", version, t.version)
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// Nodes orphaned at version v are only reachable from versions < v, so they
+	// can be deleted once all versions up to and including v-1 are pruned.
+	itr, err := t.db.Iterator([]byte{prefixOrphan}, orphanKey(version+2, nil))
+	if err != nil {
+		return err
+	}
+
+	for ; itr.Valid(); itr.Next() {
+		nodeKey := itr.Key()[1+8:]
+		if err := batch.Delete(nodeDBKey(nodeKey)); err != nil {
+			_ = itr.Close()
+			return err
+		}
+		if err := batch.Delete(bytes.Clone(itr.Key())); err != nil {
+			_ = itr.Close()
+			return err
+		}
+	}
+
+	if err := errors.Join(itr.Error(), itr.Close()); err != nil {
+		return err
+	}
+
+	if err := deleteRange(t.db, batch, []byte{prefixRoot}, rootKey(version+1)); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// Export exports the leaves of the tree at the given version.
+func (t *SparseMerkleTree) Export(version uint64) (commitment.Exporter, error) {
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return newExporter(t, root), nil
+}
+
+// Import returns an importer that restores the tree at the given version. The
+// tree must be empty.
+func (t *SparseMerkleTree) Import(version uint64) (commitment.Importer, error) {
+	if t.version > 0 || t.root != nil {
+		return nil, errors.New("cannot import into a non-empty tree")
+	}
+
+	return &Importer{
+		tree:    t,
+		version: version,
+	}, nil
+}
+
+// Close closes the tree. Note, the underlying database is owned by the caller.
+func (t *SparseMerkleTree) Close() error {
+	return nil
+}
+
+// set inserts the given leaf into the subtree rooted at n, which is located at
+// the given depth, and returns the new root of the subtree.
+func (t *SparseMerkleTree) set(n *node, depth int, leaf *node) (*node, error) {
+	if n == nil {
+		return leaf, nil
+	}
+
+	if err := t.load(n); err != nil {
+		return nil, err
+	}
+
+	if n.isLeaf {
+		if bytes.Equal(n.key, leaf.key) {
+			if bytes.Equal(n.value, leaf.value) {
+				return n, nil
+			}
+
+			t.orphan(n)
+			return leaf, nil
+		}
+
+		return split(n, leaf, depth), nil
+	}
+
+	bit := getBit(leaf.path, depth)
+
+	child, err := t.set(n.child(bit), depth+1, leaf)
+	if err != nil {
+		return nil, err
+	}
+	if child == n.child(bit) {
+		return n, nil
+	}
+
+	t.orphan(n)
+
+	if bit == 0 {
+		return newInner(child, n.right), nil
+	}
+
+	return newInner(n.left, child), nil
+}
+
+// split returns a subtree, located at the given depth, holding the two given
+// leaves, where inner nodes are created until the paths of the leaves diverge.
+func split(a, b *node, depth int) *node {
+	bitA, bitB := getBit(a.path, depth), getBit(b.path, depth)
+	if bitA != bitB {
+		if bitA == 0 {
+			return newInner(a, b)
+		}
+
+		return newInner(b, a)
+	}
+
+	child := split(a, b, depth+1)
+	if bitA == 0 {
+		return newInner(child, nil)
+	}
+
+	return newInner(nil, child)
+}
+
+// remove removes the key of the given leaf from the subtree rooted at n, which
+// is located at the given depth, and returns the new root of the subtree.
+func (t *SparseMerkleTree) remove(n *node, depth int, leaf *node) (*node, bool, error) {
+	if n == nil {
+		return nil, false, nil
+	}
+
+	if err := t.load(n); err != nil {
+		return nil, false, err
+	}
+
+	if n.isLeaf {
+		if !bytes.Equal(n.key, leaf.key) {
+			return n, false, nil
+		}
+
+		t.orphan(n)
+		return nil, true, nil
+	}
+
+	bit := getBit(leaf.path, depth)
+
+	child, found, err := t.remove(n.child(bit), depth+1, leaf)
+	if err != nil || !found {
+		return n, found, err
+	}
+
+	t.orphan(n)
+
+	sibling := n.child(1 - bit)
+	if sibling != nil {
+		if err := t.load(sibling); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Keep the tree compact, i.e. a subtree that holds a single leaf is replaced
+	// by the leaf itself.
+	switch {
+	case child == nil && (sibling == nil || sibling.isLeaf):
+		return sibling, true, nil
+
+	case sibling == nil && child.isLeaf:
+		return child, true, nil
+
+	case bit == 0:
+		return newInner(child, sibling), true, nil
+
+	default:
+		return newInner(sibling, child), true, nil
+	}
+}
+
+// orphan records the given node as replaced in the working tree, if persisted.
+func (t *SparseMerkleTree) orphan(n *node) {
+	if n.nodeKey != nil {
+		t.orphans = append(t.orphans, n.nodeKey)
+	}
+}
+
+// load loads the given node from the database, if not already loaded.
+func (t *SparseMerkleTree) load(n *node) error {
+	if n.loaded {
+		return nil
+	}
+
+	bz, err := t.db.Get(nodeDBKey(n.nodeKey))
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("node %X not found", n.nodeKey)
+	}
+
+	return decodeNode(n, bz)
+}
+
+// hash returns the hash of the given node, computing the hashes of the working
+// tree as needed.
+func (t *SparseMerkleTree) hash(n *node) []byte {
+	if n == nil {
+		return emptyHash
+	}
+
+	if n.hash == nil {
+		if n.isLeaf {
+			n.hash = leafHash(n.path, n.value)
+		} else {
+			n.hash = innerHash(t.hash(n.left), t.hash(n.right))
+		}
+	}
+
+	return n.hash
+}
+
+// commit persists all nodes of the working tree that are not yet persisted
+// along with the root reference and orphans at the given version.
+func (t *SparseMerkleTree) commit(version uint64) ([]byte, error) {
+	hash := t.hash(t.root)
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	var seq uint32
+	if err := t.persist(batch, t.root, version, &seq); err != nil {
+		return nil, err
+	}
+
+	if err := batch.Set(rootKey(version), encodeNodeRef(t.root)); err != nil {
+		return nil, err
+	}
+
+	for _, nodeKey := range t.orphans {
+		if err := batch.Set(orphanKey(version, nodeKey), []byte{}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+
+	t.version = version
+	t.orphans = nil
+
+	return hash, nil
+}
+
+// persist writes the given node and its descendants that are not yet persisted
+// to the given batch in post-order, assigning node keys as it goes.
+func (t *SparseMerkleTree) persist(batch dbm.Batch, n *node, version uint64, seq *uint32) error {
+	if n == nil || n.nodeKey != nil {
+		return nil
+	}
+
+	if !n.isLeaf {
+		if err := t.persist(batch, n.left, version, seq); err != nil {
+			return err
+		}
+		if err := t.persist(batch, n.right, version, seq); err != nil {
+			return err
+		}
+	}
+
+	*seq++
+	n.nodeKey = newNodeKey(version, *seq)
+
+	return batch.Set(nodeDBKey(n.nodeKey), encodeNode(n))
+}
+
+// getRoot returns the root node of the given persisted version.
+func (t *SparseMerkleTree) getRoot(version uint64) (*node, error) {
+	// Note, the root of an empty tree is persisted as an empty reference, so the
+	// existence of the version is checked explicitly.
+	ok, err := t.db.Has(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("version %d does not exist or is pruned", version)
+	}
+
+	bz, err := t.db.Get(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeNodeRef(bz)
+}
+
+// getLatestPersistedVersion returns the latest version persisted in the database,
+// or zero if none exists.
+func (t *SparseMerkleTree) getLatestPersistedVersion() (uint64, error) {
+	itr, err := t.db.ReverseIterator([]byte{prefixRoot}, []byte{prefixRoot + 1})
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return 0, itr.Error()
+	}
+
+	return binary.BigEndian.Uint64(itr.Key()[1:]), nil
+}
+
+// deleteVersionsFrom deletes all roots, nodes and orphan records of versions
+// greater than or equal to the given version.
+func (t *SparseMerkleTree) deleteVersionsFrom(version uint64) error {
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	ranges := [][2][]byte{
+		{rootKey(version), {prefixRoot + 1}},
+		{nodeDBKey(newNodeKey(version, 0)), {prefixNode + 1}},
+		{orphanKey(version, nil), {prefixOrphan + 1}},
+	}
+	for _, r := range ranges {
+		if err := deleteRange(t.db, batch, r[0], r[1]); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// deleteRange adds deletes of all keys in the domain [start, end) to the batch.
+func deleteRange(db dbm.DB, batch dbm.Batch, start, end []byte) (err error) {
+	itr, err := db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, itr.Close())
+	}()
+
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Delete(bytes.Clone(itr.Key())); err != nil {
+			return err
+		}
+	}
+
+	return itr.Error()
+}
+
+func newNodeKey(version uint64, seq uint32) []byte {
+	nodeKey := make([]byte, nodeKeySize)
+	binary.BigEndian.PutUint64(nodeKey, version)
+	binary.BigEndian.PutUint32(nodeKey[8:], seq)
+
+	return nodeKey
+}
+
+func nodeDBKey(nodeKey []byte) []byte {
+	return append([]byte{prefixNode}, nodeKey...)
+}
+
+func rootKey(version uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{prefixRoot}, version)
+}
+
+func orphanKey(version uint64, nodeKey []byte) []byte {
+	return append(binary.BigEndian.AppendUint64([]byte{prefixOrphan}, version), nodeKey...)
+}
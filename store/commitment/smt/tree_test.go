@@ -0,0 +1,246 @@
+package smt
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/commitment"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db dbm.DB, storeKeys []string, logger log.Logger) (*commitment.CommitStore, error) {
+			multiTrees := make(map[string]commitment.Tree)
+			for _, storeKey := range storeKeys {
+				prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+				multiTrees[storeKey] = NewSparseMerkleTree(prefixDB)
+			}
+			return commitment.NewCommitStore(multiTrees, logger)
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func generateTree() *SparseMerkleTree {
+	return NewSparseMerkleTree(dbm.NewMemDB())
+}
+
+func TestSparseMerkleTree(t *testing.T) {
+	// generate a new tree
+	tree := generateTree()
+	require.NotNil(t, tree)
+
+	initVersion := tree.GetLatestVersion()
+	require.Equal(t, uint64(0), initVersion)
+
+	// write a batch of version 1
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+
+	workingHash := tree.WorkingHash()
+	require.NotNil(t, workingHash)
+	require.Equal(t, uint64(0), tree.GetLatestVersion())
+
+	// commit the batch
+	commitHash, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, workingHash, commitHash)
+	require.Equal(t, uint64(1), tree.GetLatestVersion())
+
+	// write a batch of version 2
+	require.NoError(t, tree.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, tree.Set([]byte("key5"), []byte("value5")))
+	require.NoError(t, tree.Set([]byte("key6"), []byte("value6")))
+	require.NoError(t, tree.Remove([]byte("key1"))) // delete key1
+	require.Error(t, tree.Remove([]byte("key1")))   // key1 no longer exists
+	version2Hash := tree.WorkingHash()
+	require.NotNil(t, version2Hash)
+	commitHash, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version2Hash, commitHash)
+
+	// get proof for key1
+	proof, err := tree.GetProof(1, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetExist())
+	require.True(t, ics23.VerifyMembership(ics23.SmtSpec, workingHash, proof, []byte("key1"), []byte("value1")))
+
+	proof, err = tree.GetProof(2, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetNonexist())
+	require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, version2Hash, proof, []byte("key1")))
+
+	// write a batch of version 3
+	require.NoError(t, tree.Set([]byte("key7"), []byte("value7")))
+	require.NoError(t, tree.Set([]byte("key8"), []byte("value8")))
+	_, err = tree.Commit()
+	require.NoError(t, err)
+
+	// prune version 1
+	err = tree.Prune(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), tree.GetLatestVersion())
+	err = tree.LoadVersion(1)
+	require.Error(t, err)
+
+	// load version 2
+	err = tree.LoadVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, version2Hash, tree.WorkingHash())
+
+	// version 3 is overwritten once loading version 2
+	_, err = tree.GetProof(3, []byte("key7"))
+	require.Error(t, err)
+
+	// close the db
+	require.NoError(t, tree.Close())
+}
+
+func TestSparseMerkleTree_Proofs(t *testing.T) {
+	tree := generateTree()
+
+	r := rand.New(rand.NewSource(1))
+	kvs := make(map[string][]byte)
+	for i := 0; i < 200; i++ {
+		key := []byte(fmt.Sprintf("key%03d", r.Intn(1000)))
+		value := []byte(fmt.Sprintf("value%03d", i))
+		kvs[string(key)] = value
+		require.NoError(t, tree.Set(key, value))
+	}
+
+	root, err := tree.Commit()
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+
+		proof, err := tree.GetProof(1, key)
+		require.NoError(t, err)
+
+		if value, ok := kvs[string(key)]; ok {
+			require.True(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, key, value), string(key))
+		} else {
+			require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, root, proof, key), string(key))
+		}
+	}
+
+	// ensure proofs of a single leaf tree are valid
+	tree = generateTree()
+	require.NoError(t, tree.Set([]byte("key"), []byte("value")))
+	root, err = tree.Commit()
+	require.NoError(t, err)
+
+	proof, err := tree.GetProof(1, []byte("key"))
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, []byte("key"), []byte("value")))
+
+	proof, err = tree.GetProof(1, []byte("other_key"))
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, root, proof, []byte("other_key")))
+}
+
+func TestSparseMerkleTree_HistoryIndependence(t *testing.T) {
+	keys := make([][]byte, 100)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%03d", i))
+	}
+
+	// write all keys in order and remove the odd ones
+	tree1 := generateTree()
+	for _, key := range keys {
+		require.NoError(t, tree1.Set(key, key))
+	}
+	for i := 1; i < len(keys); i += 2 {
+		require.NoError(t, tree1.Remove(keys[i]))
+	}
+
+	// write the even keys in reverse order over multiple versions
+	tree2 := generateTree()
+	for i := len(keys) - 2; i >= 0; i -= 2 {
+		require.NoError(t, tree2.Set(keys[i], keys[i]))
+		if i%10 == 0 {
+			_, err := tree2.Commit()
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, tree1.WorkingHash(), tree2.WorkingHash())
+
+	// removing all keys results in an empty tree
+	for i := 0; i < len(keys); i += 2 {
+		require.NoError(t, tree1.Remove(keys[i]))
+	}
+	require.Equal(t, emptyHash, tree1.WorkingHash())
+}
+
+func TestSparseMerkleTree_Prune(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := NewSparseMerkleTree(db)
+
+	hashes := make([][]byte, 0, 10)
+	for v := 1; v <= 10; v++ {
+		for i := 0; i < 10; i++ {
+			key := []byte(fmt.Sprintf("key%03d", i))
+			require.NoError(t, tree.Set(key, []byte(fmt.Sprintf("value%03d-%03d", i, v))))
+		}
+
+		hash, err := tree.Commit()
+		require.NoError(t, err)
+		hashes = append(hashes, hash)
+	}
+
+	// the latest version cannot be pruned
+	require.Error(t, tree.Prune(10))
+	require.NoError(t, tree.Prune(9))
+
+	// only the nodes of the latest version remain, i.e. as many as a tree that
+	// only committed the latest version
+	latestDB := dbm.NewMemDB()
+	latestTree := NewSparseMerkleTree(latestDB)
+	for i := 0; i < 10; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		require.NoError(t, latestTree.Set(key, []byte(fmt.Sprintf("value%03d-%03d", i, 10))))
+	}
+	_, err := latestTree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, countNodes(t, latestDB), countNodes(t, db))
+
+	// a fresh tree loads the latest version from the database
+	tree = NewSparseMerkleTree(db)
+	require.NoError(t, tree.LoadVersion(0))
+	require.Equal(t, uint64(10), tree.GetLatestVersion())
+	require.Equal(t, hashes[9], tree.WorkingHash())
+
+	for i := 0; i < 10; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		value := []byte(fmt.Sprintf("value%03d-%03d", i, 10))
+
+		proof, err := tree.GetProof(10, key)
+		require.NoError(t, err)
+		require.True(t, ics23.VerifyMembership(ics23.SmtSpec, hashes[9], proof, key, value))
+	}
+}
+
+func countNodes(t *testing.T, db dbm.DB) int {
+	t.Helper()
+
+	itr, err := db.Iterator([]byte{prefixNode}, []byte{prefixNode + 1})
+	require.NoError(t, err)
+	defer itr.Close()
+
+	var count int
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+
+	return count
+}
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"runtime"
+	"slices"
+	"sync"
 
 	protoio "github.com/cosmos/gogoproto/io"
 	ics23 "github.com/cosmos/ics23/go"
+	"golang.org/x/exp/maps"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
@@ -17,6 +21,8 @@ import (
 
 var (
 	_ store.Committer             = (*CommitStore)(nil)
+	_ store.CommitmentOpCreator   = (*CommitStore)(nil)
+	_ store.ChangesetGetter       = (*CommitStore)(nil)
 	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
 )
 
@@ -25,27 +31,56 @@ var (
 // can construct a CommitStore with one or more store keys. It is expected that a
 // RootStore use a CommitStore as an abstraction to handle multiple store keys
 // and trees.
+//
+// Since trees are independent of each other, writing batches to, hashing and
+// committing the trees is performed concurrently by a bounded pool of workers.
+// The results are always ordered by store key, so they do not depend on the
+// order in which the workers complete.
 type CommitStore struct {
 	logger log.Logger
 
 	multiTrees map[string]Tree
+
+	// storeKeys reflects the sorted store keys of multiTrees
+	storeKeys []string
+
+	// maxWorkers defines the maximum number of trees processed concurrently
+	maxWorkers int
 }
 
 // NewCommitStore creates a new CommitStore instance.
 func NewCommitStore(multiTrees map[string]Tree, logger log.Logger) (*CommitStore, error) {
+	storeKeys := maps.Keys(multiTrees)
+	slices.Sort(storeKeys)
+
 	return &CommitStore{
 		logger:     logger,
 		multiTrees: multiTrees,
+		storeKeys:  storeKeys,
+		maxWorkers: runtime.NumCPU(),
 	}, nil
 }
 
+// SetMaxWorkers sets the maximum number of trees that are written to, hashed and
+// committed concurrently. A value of one or lower processes the trees
+// sequentially.
+func (c *CommitStore) SetMaxWorkers(n int) {
+	c.maxWorkers = n
+}
+
 func (c *CommitStore) WriteBatch(cs *store.Changeset) error {
-	for storeKey, pairs := range cs.Pairs {
-		tree, ok := c.multiTrees[storeKey]
-		if !ok {
+	storeKeys := make([]string, 0, len(cs.Pairs))
+	for storeKey := range cs.Pairs {
+		if _, ok := c.multiTrees[storeKey]; !ok {
 			return fmt.Errorf("store key %s not found in multiTrees", storeKey)
 		}
-		for _, kv := range pairs {
+
+		storeKeys = append(storeKeys, storeKey)
+	}
+	slices.Sort(storeKeys)
+
+	return c.forEachTree(storeKeys, func(_ int, storeKey string, tree Tree) error {
+		for _, kv := range cs.Pairs[storeKey] {
 			if kv.Value == nil {
 				if err := tree.Remove(kv.Key); err != nil {
 					return err
@@ -54,22 +89,24 @@ func (c *CommitStore) WriteBatch(cs *store.Changeset) error {
 				return err
 			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
 func (c *CommitStore) WorkingStoreInfos(version uint64) []store.StoreInfo {
-	storeInfos := make([]store.StoreInfo, 0, len(c.multiTrees))
-	for storeKey, tree := range c.multiTrees {
-		storeInfos = append(storeInfos, store.StoreInfo{
+	storeInfos := make([]store.StoreInfo, len(c.storeKeys))
+	_ = c.forEachTree(c.storeKeys, func(i int, storeKey string, tree Tree) error {
+		storeInfos[i] = store.StoreInfo{
 			Name: storeKey,
 			CommitID: store.CommitID{
 				Version: version,
 				Hash:    tree.WorkingHash(),
 			},
-		})
-	}
+		}
+
+		return nil
+	})
 
 	return storeInfos
 }
@@ -98,24 +135,76 @@ func (c *CommitStore) LoadVersion(targetVersion uint64) error {
 }
 
 func (c *CommitStore) Commit() ([]store.StoreInfo, error) {
-	storeInfos := make([]store.StoreInfo, 0, len(c.multiTrees))
-	for storeKey, tree := range c.multiTrees {
+	storeInfos := make([]store.StoreInfo, len(c.storeKeys))
+	err := c.forEachTree(c.storeKeys, func(i int, storeKey string, tree Tree) error {
 		hash, err := tree.Commit()
 		if err != nil {
-			return nil, err
+			return err
 		}
-		storeInfos = append(storeInfos, store.StoreInfo{
+
+		storeInfos[i] = store.StoreInfo{
 			Name: storeKey,
 			CommitID: store.CommitID{
 				Version: tree.GetLatestVersion(),
 				Hash:    hash,
 			},
-		})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return storeInfos, nil
 }
 
+// forEachTree calls fn with the index, store key and tree of each of the given
+// store keys, using at most maxWorkers goroutines. Each tree is processed by a
+// single goroutine. If one or more calls fail, the error of the first failing
+// store key in the given order is returned, regardless of scheduling.
+func (c *CommitStore) forEachTree(storeKeys []string, fn func(i int, storeKey string, tree Tree) error) error {
+	if c.maxWorkers <= 1 || len(storeKeys) <= 1 {
+		for i, storeKey := range storeKeys {
+			if err := fn(i, storeKey, c.multiTrees[storeKey]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(storeKeys))
+		sem  = make(chan struct{}, c.maxWorkers)
+	)
+
+	for i, storeKey := range storeKeys {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, storeKey string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = fn(i, storeKey, c.multiTrees[storeKey])
+		}(i, storeKey)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *CommitStore) SetInitialVersion(version uint64) error {
 	for _, tree := range c.multiTrees {
 		if err := tree.SetInitialVersion(version); err != nil {
@@ -135,6 +224,44 @@ func (c *CommitStore) GetProof(storeKey string, version uint64, key []byte) (*ic
 	return tree.GetProof(version, key)
 }
 
+// NewCommitmentOp implements store.CommitmentOpCreator. Proofs of trees that do
+// not implement CommitmentOpCreator are assumed to be IAVL proofs.
+func (c *CommitStore) NewCommitmentOp(storeKey string, key []byte, proof *ics23.CommitmentProof) store.CommitmentOp {
+	if tree, ok := c.multiTrees[storeKey].(CommitmentOpCreator); ok {
+		return tree.NewCommitmentOp(key, proof)
+	}
+
+	return store.NewIAVLCommitmentOp(key, proof)
+}
+
+// GetChangeset implements store.ChangesetGetter. It returns an error if any of
+// the trees does not implement ChangesetGetter.
+func (c *CommitStore) GetChangeset(version uint64) (*store.Changeset, error) {
+	pairs := make([]store.KVPairs, len(c.storeKeys))
+	err := c.forEachTree(c.storeKeys, func(i int, storeKey string, tree Tree) error {
+		getter, ok := tree.(ChangesetGetter)
+		if !ok {
+			return fmt.Errorf("store %s does not support retrieving changesets", storeKey)
+		}
+
+		var err error
+		pairs[i], err = getter.GetChangeset(version)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cs := store.NewChangeset(make(map[string]store.KVPairs))
+	for i, storeKey := range c.storeKeys {
+		if len(pairs[i]) > 0 {
+			cs.Pairs[storeKey] = pairs[i]
+		}
+	}
+
+	return cs, nil
+}
+
 func (c *CommitStore) Prune(version uint64) (ferr error) {
 	for _, tree := range c.multiTrees {
 		if err := tree.Prune(version); err != nil {
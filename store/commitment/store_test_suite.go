@@ -119,3 +119,88 @@ func (s *CommitStoreTestSuite) TestSnapshotter() {
 		s.Require().True(matched)
 	}
 }
+
+func (s *CommitStoreTestSuite) TestParallelCommit() {
+	storeKeys := make([]string, 24)
+	for i := range storeKeys {
+		storeKeys[i] = fmt.Sprintf("store%02d", i)
+	}
+
+	sequentialStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	sequentialStore.SetMaxWorkers(1)
+
+	parallelStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	parallelStore.SetMaxWorkers(4)
+
+	for v := uint64(1); v <= 5; v++ {
+		kvPairs := make(map[string]store.KVPairs)
+		for i, storeKey := range storeKeys {
+			// leave some stores untouched in every version
+			if (i+int(v))%3 == 0 {
+				continue
+			}
+
+			for j := 0; j < 10; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", v, j))
+				value := []byte(fmt.Sprintf("value-%d-%d-%s", v, j, storeKey))
+				kvPairs[storeKey] = append(kvPairs[storeKey], store.KVPair{Key: key, Value: value})
+			}
+		}
+
+		s.Require().NoError(sequentialStore.WriteBatch(store.NewChangeset(kvPairs)))
+		s.Require().NoError(parallelStore.WriteBatch(store.NewChangeset(kvPairs)))
+
+		workingStoreInfos := sequentialStore.WorkingStoreInfos(v)
+		s.Require().Equal(workingStoreInfos, parallelStore.WorkingStoreInfos(v))
+
+		sequentialStoreInfos, err := sequentialStore.Commit()
+		s.Require().NoError(err)
+		parallelStoreInfos, err := parallelStore.Commit()
+		s.Require().NoError(err)
+
+		s.Require().Equal(sequentialStoreInfos, parallelStoreInfos)
+		s.Require().Equal(
+			store.CommitInfo{Version: v, StoreInfos: workingStoreInfos}.Hash(),
+			store.CommitInfo{Version: v, StoreInfos: parallelStoreInfos}.Hash(),
+		)
+	}
+
+	// writing to an unknown store key fails without writing to any tree
+	kvPairs := map[string]store.KVPairs{
+		storeKeys[0]: {{Key: []byte("key"), Value: []byte("value")}},
+		"unknown":    {{Key: []byte("key"), Value: []byte("value")}},
+	}
+	s.Require().Error(parallelStore.WriteBatch(store.NewChangeset(kvPairs)))
+	s.Require().Equal(sequentialStore.WorkingStoreInfos(6), parallelStore.WorkingStoreInfos(6))
+}
+
+func (s *CommitStoreTestSuite) TestGetChangeset() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	changesets := []*store.Changeset{
+		store.NewChangeset(map[string]store.KVPairs{
+			storeKey1: {{Key: []byte("key1"), Value: []byte("value1")}, {Key: []byte("key2"), Value: []byte("value2")}},
+			storeKey2: {{Key: []byte("key1"), Value: []byte("value1")}},
+		}),
+		store.NewChangeset(map[string]store.KVPairs{
+			storeKey1: {{Key: []byte("key1"), Value: []byte("value1-updated")}, {Key: []byte("key2")}, {Key: []byte("key3"), Value: []byte("value3")}},
+		}),
+		store.NewChangeset(map[string]store.KVPairs{}),
+	}
+
+	for _, cs := range changesets {
+		s.Require().NoError(commitStore.WriteBatch(cs))
+		_, err = commitStore.Commit()
+		s.Require().NoError(err)
+	}
+
+	for i, expected := range changesets {
+		cs, err := commitStore.GetChangeset(uint64(i + 1))
+		s.Require().NoError(err)
+		s.Require().Equal(expected.Pairs, cs.Pairs, "version %d", i+1)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	"cosmossdk.io/store/v2"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -29,6 +30,20 @@ type Tree interface {
 	io.Closer
 }
 
+// CommitmentOpCreator is an optional interface that a Tree can implement when its
+// proofs do not follow the IAVL proof spec. It allows wrapping proofs returned by
+// GetProof in a CommitmentOp with the matching proof spec.
+type CommitmentOpCreator interface {
+	NewCommitmentOp(key []byte, proof *ics23.CommitmentProof) store.CommitmentOp
+}
+
+// ChangesetGetter is an optional interface that a Tree can implement to return
+// the key/value pairs written at a given committed version, where removed keys
+// have a nil value.
+type ChangesetGetter interface {
+	GetChangeset(version uint64) (store.KVPairs, error)
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
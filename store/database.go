@@ -53,6 +53,14 @@ type VersionedDatabase interface {
 	Iterator(storeKey string, version uint64, start, end []byte) (Iterator, error)
 	ReverseIterator(storeKey string, version uint64, start, end []byte) (Iterator, error)
 
+	// DiffIterator returns an iterator over every write applied to the keys in
+	// the domain [start, end) at versions within [startVersion, endVersion]. Note,
+	// both start and end are optional.
+	DiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (DiffIterator, error)
+	// ReverseDiffIterator behaves identically to DiffIterator except that entries
+	// are yielded in reverse order.
+	ReverseDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (DiffIterator, error)
+
 	ApplyChangeset(version uint64, cs *Changeset) error
 
 	// Prune attempts to prune all versions up to and including the provided
@@ -84,3 +92,22 @@ type Committer interface {
 	// only be called once and any call after may panic.
 	io.Closer
 }
+
+// IncrementalPruner defines an optional interface for SS backends that are able
+// to reclaim the space of pruned versions in bounded steps, s.t. pruning does
+// not stall concurrent writes.
+type IncrementalPruner interface {
+	// PruneStep prunes all versions up to and including the provided version of
+	// roughly limit entries, starting from the given cursor. The first step, i.e.
+	// with a nil cursor, marks the versions as pruned s.t. they are no longer
+	// readable. It returns the cursor of the next step, or nil once complete.
+	PruneStep(version uint64, cursor []byte, limit int) ([]byte, error)
+}
+
+// ChangesetGetter defines an optional interface for SC backends that are able to
+// reconstruct the changeset committed at a given version. It allows recovering
+// SS versions that are missing, e.g. due to a crash while SS writes were in
+// flight, from SC.
+type ChangesetGetter interface {
+	GetChangeset(version uint64) (*Changeset, error)
+}
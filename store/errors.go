@@ -36,6 +36,10 @@ var (
 	ErrUnknownStoreKey = errors.Register(StoreCodespace, 10, "unknown store key")
 	ErrKeyEmpty        = errors.Register(StoreCodespace, 11, "key empty")
 	ErrStartAfterEnd   = errors.Register(StoreCodespace, 12, "start key after end key")
+
+	// ErrStartVersionAfterEndVersion is returned when a version window is requested
+	// where the start version is greater than the end version.
+	ErrStartVersionAfterEndVersion = errors.Register(StoreCodespace, 13, "start version after end version")
 )
 
 // ErrVersionPruned defines an error returned when a version queried is pruned
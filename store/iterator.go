@@ -26,6 +26,24 @@ type Iterator interface {
 	Close()
 }
 
+// DiffIterator defines an interface for iterating over the individual versioned
+// writes, i.e. sets and deletes, applied to a domain of keys over a window of
+// versions. Entries are ordered by key first and by version second. A forward
+// DiffIterator yields keys in ascending order and, for each key, versions in
+// ascending order. A reverse DiffIterator yields keys in descending order and,
+// for each key, versions in descending order.
+type DiffIterator interface {
+	Iterator
+
+	// Version returns the version at which the current key/value pair was written
+	// or deleted.
+	Version() uint64
+
+	// Deleted returns true if the current entry denotes the deletion of the key at
+	// Version(). Value() returns nil for deletions.
+	Deleted() bool
+}
+
 // IteratorCreator defines an interface for creating forward and reverse iterators.
 type IteratorCreator interface {
 	// Iterator creates a new iterator for the given store name and domain, where
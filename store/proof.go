@@ -27,6 +27,14 @@ type CommitmentOp struct {
 	Proof *ics23.CommitmentProof
 }
 
+// CommitmentOpCreator defines an optional interface for SC backends that are able
+// to wrap a proof of a store key in a CommitmentOp with the proof spec matching
+// the commitment structure backing that store key. Proofs of SC backends that do
+// not implement it are assumed to be IAVL proofs.
+type CommitmentOpCreator interface {
+	NewCommitmentOp(storeKey string, key []byte, proof *ics23.CommitmentProof) CommitmentOp
+}
+
 func NewIAVLCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
 	return CommitmentOp{
 		Type:  ProofOpIAVLCommitment,
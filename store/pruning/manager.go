@@ -5,9 +5,18 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/metrics"
 )
 
-// Manager is an abstraction to handle pruning of SS and SC backends.
+// defaultStorageStepSize defines the number of entries pruned per step from SS
+// backends supporting incremental pruning.
+const defaultStorageStepSize = 10000
+
+// Manager is an abstraction to handle pruning of SS and SC backends. Each
+// backend has its own retention policy and, unless configured to prune
+// synchronously, is pruned by a dedicated background pruner s.t. pruning never
+// stalls Commit. SS backends implementing store.IncrementalPruner are pruned in
+// bounded steps, which allows concurrent writes to proceed in between.
 type Manager struct {
 	mtx       sync.Mutex
 	isStarted bool
@@ -19,8 +28,11 @@ type Manager struct {
 	storageOpts    Options
 	commitmentOpts Options
 
-	chStorage    chan struct{}
-	chCommitment chan struct{}
+	// telemetry reflects a telemetry agent responsible for emitting metrics (if any)
+	telemetry metrics.StoreMetrics
+
+	storagePruner    *pruner
+	commitmentPruner *pruner
 }
 
 // NewManager creates a new Manager instance.
@@ -54,6 +66,20 @@ func (m *Manager) SetCommitmentOptions(opts Options) {
 	m.commitmentOpts = opts
 }
 
+// SetMetrics sets the telemetry agent used to report pruning durations and the
+// pruning backlog, i.e. the number of versions pending to be pruned.
+func (m *Manager) SetMetrics(telemetry metrics.StoreMetrics) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.telemetry = telemetry
+
+	if m.isStarted {
+		m.storagePruner.setTelemetry(telemetry)
+		m.commitmentPruner.setTelemetry(telemetry)
+	}
+}
+
 // Start starts the manager.
 func (m *Manager) Start() {
 	m.mtx.Lock()
@@ -64,14 +90,8 @@ func (m *Manager) Start() {
 	}
 	m.isStarted = true
 
-	if !m.storageOpts.Sync {
-		m.chStorage = make(chan struct{}, 1)
-		m.chStorage <- struct{}{}
-	}
-	if !m.commitmentOpts.Sync {
-		m.chCommitment = make(chan struct{}, 1)
-		m.chCommitment <- struct{}{}
-	}
+	m.storagePruner = newPruner("storage", m.logger, m.telemetry, m.storageOpts.Sync, m.pruneStorage)
+	m.commitmentPruner = newPruner("commitment", m.logger, m.telemetry, m.commitmentOpts.Sync, m.pruneCommitment)
 }
 
 // Stop stops the manager and waits for all goroutines to finish.
@@ -84,14 +104,8 @@ func (m *Manager) Stop() {
 	}
 	m.isStarted = false
 
-	if !m.storageOpts.Sync {
-		<-m.chStorage
-		close(m.chStorage)
-	}
-	if !m.commitmentOpts.Sync {
-		<-m.chCommitment
-		close(m.chCommitment)
-	}
+	m.storagePruner.stop()
+	m.commitmentPruner.stop()
 }
 
 // Prune prunes the state storage and state commitment.
@@ -106,61 +120,33 @@ func (m *Manager) Prune(height uint64) {
 
 	// storage pruning
 	if m.storageOpts.Interval > 0 && height > m.storageOpts.KeepRecent && height%m.storageOpts.Interval == 0 {
-		pruneHeight := height - m.storageOpts.KeepRecent - 1
-		if m.storageOpts.Sync {
-			m.pruneStorage(pruneHeight)
-		} else {
-			// it will not block if the previous pruning is still running
-			select {
-			case _, stillOpen := <-m.chStorage:
-				if stillOpen {
-					go func() {
-						m.pruneStorage(pruneHeight)
-						m.chStorage <- struct{}{}
-					}()
-				}
-
-			default:
-				m.logger.Debug("storage pruning is still running; skipping", "version", pruneHeight)
-			}
-		}
+		m.storagePruner.request(height - m.storageOpts.KeepRecent - 1)
 	}
 
 	// commitment pruning
 	if m.commitmentOpts.Interval > 0 && height > m.commitmentOpts.KeepRecent && height%m.commitmentOpts.Interval == 0 {
-		pruneHeight := height - m.commitmentOpts.KeepRecent - 1
-		if m.commitmentOpts.Sync {
-			m.pruneCommitment(pruneHeight)
-		} else {
-			// it will not block if the previous pruning is still running
-			select {
-			case _, stillOpen := <-m.chCommitment:
-				if stillOpen {
-					go func() {
-						m.pruneCommitment(pruneHeight)
-						m.chCommitment <- struct{}{}
-					}()
-				}
-
-			default:
-				m.logger.Debug("commitment pruning is still running; skipping", "version", pruneHeight)
-			}
-		}
+		m.commitmentPruner.request(height - m.commitmentOpts.KeepRecent - 1)
 	}
 }
 
-func (m *Manager) pruneStorage(height uint64) {
-	m.logger.Debug("pruning state storage", "height", height)
+func (m *Manager) pruneStorage(height uint64) error {
+	pruner, ok := m.stateStorage.(store.IncrementalPruner)
+	if !ok {
+		return m.stateStorage.Prune(height)
+	}
 
-	if err := m.stateStorage.Prune(height); err != nil {
-		m.logger.Error("failed to prune state storage", "err", err)
+	var (
+		cursor []byte
+		err    error
+	)
+	for {
+		cursor, err = pruner.PruneStep(height, cursor, defaultStorageStepSize)
+		if err != nil || cursor == nil {
+			return err
+		}
 	}
 }
 
-func (m *Manager) pruneCommitment(height uint64) {
-	m.logger.Debug("pruning state commitment", "height", height)
-
-	if err := m.stateCommitment.Prune(height); err != nil {
-		m.logger.Error("failed to prune state commitment", "err", err)
-	}
+func (m *Manager) pruneCommitment(height uint64) error {
+	return m.stateCommitment.Prune(height)
 }
@@ -2,7 +2,10 @@ package pruning
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/suite"
@@ -17,6 +20,29 @@ import (
 
 const defaultStoreKey = "default"
 
+// mockMetrics records the latest value of each gauge.
+type mockMetrics struct {
+	mtx    sync.Mutex
+	gauges map[string]float32
+}
+
+func (m *mockMetrics) MeasureSince(time.Time, ...string) {}
+
+func (m *mockMetrics) SetGauge(val float32, keys ...string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.gauges[strings.Join(keys, ",")] = val
+}
+
+func (m *mockMetrics) gauge(keys ...string) (float32, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	val, ok := m.gauges[strings.Join(keys, ",")]
+	return val, ok
+}
+
 type PruningTestSuite struct {
 	suite.Suite
 
@@ -103,3 +129,55 @@ func (s *PruningTestSuite) TestPruning() {
 	s.Require().Error(err)
 	s.Require().Nil(proof)
 }
+
+func (s *PruningTestSuite) TestAsyncStoragePruning() {
+	telemetry := &mockMetrics{gauges: make(map[string]float32)}
+	s.manager.SetMetrics(telemetry)
+
+	// SS retains fewer versions than SC and is pruned in the background
+	s.manager.SetCommitmentOptions(Options{20, 5, true})
+	s.manager.SetStorageOptions(Options{2, 1, false})
+	s.manager.Start()
+
+	latestVersion := uint64(50)
+
+	for i := uint64(0); i < latestVersion; i++ {
+		version := i + 1
+
+		cs := store.NewChangeset(map[string]store.KVPairs{defaultStoreKey: {}})
+		cs.AddKVPair(defaultStoreKey, store.KVPair{
+			Key:   []byte("key"),
+			Value: []byte(fmt.Sprintf("value%d", version)),
+		})
+		s.Require().NoError(s.sc.WriteBatch(cs))
+
+		_, err := s.sc.Commit()
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.ss.ApplyChangeset(version, cs))
+		s.manager.Prune(version)
+	}
+
+	// wait for pruning to finish
+	s.manager.Stop()
+
+	// SS only retains the most recent versions
+	val, err := s.ss.Get(defaultStoreKey, latestVersion-2, []byte("key"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("value48"), val)
+
+	_, err = s.ss.Get(defaultStoreKey, latestVersion-4, []byte("key"))
+	s.Require().Error(err)
+
+	// SC retains versions that are pruned in SS
+	proof, err := s.sc.GetProof(defaultStoreKey, latestVersion-4, []byte("key"))
+	s.Require().NoError(err)
+	s.Require().NotNil(proof.GetExist())
+
+	// no backlog is left once pruning has caught up
+	for _, name := range []string{"storage", "commitment"} {
+		backlog, ok := telemetry.gauge("pruning", name, "backlog")
+		s.Require().True(ok, name)
+		s.Require().Zero(backlog, name)
+	}
+}
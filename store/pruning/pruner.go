@@ -0,0 +1,133 @@
+package pruning
+
+import (
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/metrics"
+)
+
+// pruner prunes a single backend, either synchronously or in a background
+// goroutine. Prune heights requested while a background pruning is in progress
+// are coalesced, i.e. only the latest requested height is pruned next, s.t. the
+// caller never blocks on pruning.
+type pruner struct {
+	name   string
+	logger log.Logger
+	prune  func(height uint64) error
+
+	mtx       sync.Mutex
+	telemetry metrics.StoreMetrics
+	// target reflects the latest requested prune height
+	target uint64
+	// pruned reflects the latest completed prune height
+	pruned uint64
+
+	// chNotify and chDone are only set if pruning runs in the background
+	chNotify chan struct{}
+	chDone   chan struct{}
+}
+
+func newPruner(name string, logger log.Logger, telemetry metrics.StoreMetrics, sync bool, prune func(height uint64) error) *pruner {
+	p := &pruner{
+		name:      name,
+		logger:    logger,
+		telemetry: telemetry,
+		prune:     prune,
+	}
+
+	if !sync {
+		p.chNotify = make(chan struct{}, 1)
+		p.chDone = make(chan struct{})
+
+		go p.run()
+	}
+
+	return p
+}
+
+// setTelemetry sets the telemetry agent of the pruner.
+func (p *pruner) setTelemetry(telemetry metrics.StoreMetrics) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.telemetry = telemetry
+}
+
+// request requests pruning up to and including the given height.
+func (p *pruner) request(height uint64) {
+	p.mtx.Lock()
+	if height <= p.target {
+		p.mtx.Unlock()
+		return
+	}
+
+	p.target = height
+	p.reportBacklog()
+	p.mtx.Unlock()
+
+	if p.chNotify == nil {
+		p.pruneTo(height)
+		return
+	}
+
+	// it will not block if a notification is already pending
+	select {
+	case p.chNotify <- struct{}{}:
+	default:
+		p.logger.Debug("pruning is still running; deferring", "name", p.name, "height", height)
+	}
+}
+
+// run prunes up to the latest requested height upon each notification until
+// stopped.
+func (p *pruner) run() {
+	defer close(p.chDone)
+
+	for range p.chNotify {
+		p.mtx.Lock()
+		height := p.target
+		p.mtx.Unlock()
+
+		p.pruneTo(height)
+	}
+}
+
+func (p *pruner) pruneTo(height uint64) {
+	p.logger.Debug("pruning", "name", p.name, "height", height)
+
+	start := time.Now()
+	if err := p.prune(height); err != nil {
+		p.logger.Error("failed to prune", "name", p.name, "height", height, "err", err)
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.telemetry != nil {
+		p.telemetry.MeasureSince(start, "pruning", p.name)
+	}
+
+	p.pruned = height
+	p.reportBacklog()
+}
+
+// reportBacklog reports the number of versions requested to be pruned that are
+// not yet pruned. Note, it must be called with the mutex held.
+func (p *pruner) reportBacklog() {
+	if p.telemetry != nil {
+		p.telemetry.SetGauge(float32(p.target-p.pruned), "pruning", p.name, "backlog")
+	}
+}
+
+// stop waits for background pruning, if any, to complete and stops it.
+func (p *pruner) stop() {
+	if p.chNotify == nil {
+		return
+	}
+
+	close(p.chNotify)
+	<-p.chDone
+}
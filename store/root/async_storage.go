@@ -0,0 +1,286 @@
+package root
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"cosmossdk.io/store/v2"
+)
+
+// defaultSSWriteQueueSize defines the default maximum number of SS writes that
+// can be in flight before Commit blocks.
+const defaultSSWriteQueueSize = 8
+
+var (
+	_ store.VersionedDatabase = (*asyncStateStorage)(nil)
+	_ store.IncrementalPruner = (*asyncStateStorage)(nil)
+)
+
+// ssWrite defines a single write queued to the SS backend. A nil changeset
+// denotes a write that only sets the latest version.
+type ssWrite struct {
+	version   uint64
+	changeset *store.Changeset
+
+	// values indexes the values of the changeset by store key and key
+	values map[string]map[string][]byte
+}
+
+func newSSWrite(version uint64, cs *store.Changeset) *ssWrite {
+	values := make(map[string]map[string][]byte, len(cs.Pairs))
+	for storeKey, pairs := range cs.Pairs {
+		values[storeKey] = make(map[string][]byte, len(pairs))
+		for _, kv := range pairs {
+			values[storeKey][string(kv.Key)] = kv.Value
+		}
+	}
+
+	return &ssWrite{
+		version:   version,
+		changeset: cs,
+		values:    values,
+	}
+}
+
+// asyncStateStorage wraps an SS backend and applies changesets in a background
+// goroutine, in the order they are submitted, through a bounded queue. Point
+// reads of versions whose write is still in flight are served from the queued
+// changesets, whereas iterators wait until the writes of all versions up to the
+// requested version are applied.
+type asyncStateStorage struct {
+	db store.VersionedDatabase
+
+	// submitMu ensures writes are queued in the same order they are recorded
+	// as pending
+	submitMu sync.Mutex
+
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	// pending reflects the queued writes not yet applied, ordered by submission
+	pending []*ssWrite
+
+	// latestVersion reflects the latest version submitted
+	latestVersion uint64
+
+	// err reflects the first error returned by the SS backend, if any
+	err error
+
+	queue chan *ssWrite
+	done  chan struct{}
+}
+
+func newAsyncStateStorage(db store.VersionedDatabase, queueSize int) (*asyncStateStorage, error) {
+	latestVersion, err := db.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &asyncStateStorage{
+		db:            db,
+		latestVersion: latestVersion,
+		queue:         make(chan *ssWrite, queueSize),
+		done:          make(chan struct{}),
+	}
+	s.cond = sync.NewCond(&s.mu)
+
+	go s.run()
+
+	return s, nil
+}
+
+// run applies queued writes until the queue is closed.
+func (s *asyncStateStorage) run() {
+	defer close(s.done)
+
+	for w := range s.queue {
+		var err error
+		if w.changeset != nil {
+			err = s.db.ApplyChangeset(w.version, w.changeset)
+		} else {
+			err = s.db.SetLatestVersion(w.version)
+		}
+
+		s.mu.Lock()
+		if err != nil && s.err == nil {
+			s.err = errors.Wrapf(err, "failed to write SS version %d", w.version)
+		}
+		s.pending = s.pending[1:]
+		s.cond.Broadcast()
+		s.mu.Unlock()
+	}
+}
+
+// submit queues the given write, blocking if the queue is full. It returns the
+// error of a previous write, if any.
+func (s *asyncStateStorage) submit(w *ssWrite) error {
+	s.submitMu.Lock()
+	defer s.submitMu.Unlock()
+
+	s.mu.Lock()
+	if s.err != nil {
+		s.mu.Unlock()
+		return s.err
+	}
+
+	s.pending = append(s.pending, w)
+	s.latestVersion = w.version
+	s.mu.Unlock()
+
+	s.queue <- w
+	return nil
+}
+
+// waitFor blocks until all queued writes of versions up to and including the
+// given version are applied.
+func (s *asyncStateStorage) waitFor(version uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for s.err == nil && s.hasPendingUpTo(version) {
+		s.cond.Wait()
+	}
+
+	return s.err
+}
+
+func (s *asyncStateStorage) hasPendingUpTo(version uint64) bool {
+	for _, w := range s.pending {
+		if w.version <= version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Flush blocks until all queued writes are applied.
+func (s *asyncStateStorage) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for s.err == nil && len(s.pending) > 0 {
+		s.cond.Wait()
+	}
+
+	return s.err
+}
+
+// getPending returns the value of the given key from the latest queued write at
+// or below the given version, if any. Note, a nil value with ok set denotes a
+// deletion.
+func (s *asyncStateStorage) getPending(storeKey string, version uint64, key []byte) (value []byte, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := len(s.pending) - 1; i >= 0; i-- {
+		w := s.pending[i]
+		if w.changeset == nil || w.version > version {
+			continue
+		}
+
+		if value, ok := w.values[storeKey][string(key)]; ok {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *asyncStateStorage) Has(storeKey string, version uint64, key []byte) (bool, error) {
+	if value, ok := s.getPending(storeKey, version, key); ok {
+		return value != nil, nil
+	}
+
+	return s.db.Has(storeKey, version, key)
+}
+
+func (s *asyncStateStorage) Get(storeKey string, version uint64, key []byte) ([]byte, error) {
+	if value, ok := s.getPending(storeKey, version, key); ok {
+		return value, nil
+	}
+
+	return s.db.Get(storeKey, version, key)
+}
+
+// GetLatestVersion returns the latest version submitted, which may not yet be
+// applied to the SS backend.
+func (s *asyncStateStorage) GetLatestVersion() (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.latestVersion, s.err
+}
+
+func (s *asyncStateStorage) SetLatestVersion(version uint64) error {
+	return s.submit(&ssWrite{version: version})
+}
+
+func (s *asyncStateStorage) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	if err := s.waitFor(version); err != nil {
+		return nil, err
+	}
+
+	return s.db.Iterator(storeKey, version, start, end)
+}
+
+func (s *asyncStateStorage) ReverseIterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	if err := s.waitFor(version); err != nil {
+		return nil, err
+	}
+
+	return s.db.ReverseIterator(storeKey, version, start, end)
+}
+
+func (s *asyncStateStorage) DiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	if err := s.waitFor(endVersion); err != nil {
+		return nil, err
+	}
+
+	return s.db.DiffIterator(storeKey, startVersion, endVersion, start, end)
+}
+
+func (s *asyncStateStorage) ReverseDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	if err := s.waitFor(endVersion); err != nil {
+		return nil, err
+	}
+
+	return s.db.ReverseDiffIterator(storeKey, startVersion, endVersion, start, end)
+}
+
+// ApplyChangeset queues the given changeset to be applied to the SS backend. It
+// blocks if the queue is full and returns the error of a previous write, if any.
+func (s *asyncStateStorage) ApplyChangeset(version uint64, cs *store.Changeset) error {
+	return s.submit(newSSWrite(version, cs))
+}
+
+func (s *asyncStateStorage) Prune(version uint64) error {
+	if err := s.waitFor(version); err != nil {
+		return err
+	}
+
+	return s.db.Prune(version)
+}
+
+// PruneStep prunes the SS backend incrementally if it supports it, otherwise it
+// prunes the SS backend at once.
+func (s *asyncStateStorage) PruneStep(version uint64, cursor []byte, limit int) ([]byte, error) {
+	if err := s.waitFor(version); err != nil {
+		return nil, err
+	}
+
+	if pruner, ok := s.db.(store.IncrementalPruner); ok {
+		return pruner.PruneStep(version, cursor, limit)
+	}
+
+	return nil, s.db.Prune(version)
+}
+
+// Close waits for all queued writes to be applied and closes the SS backend.
+func (s *asyncStateStorage) Close() error {
+	close(s.queue)
+	<-s.done
+
+	return errors.Join(s.err, s.db.Close())
+}
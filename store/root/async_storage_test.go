@@ -0,0 +1,96 @@
+package root
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+// blockingStorage is an SS backend whose writes block until released.
+type blockingStorage struct {
+	store.VersionedDatabase
+
+	release chan struct{}
+}
+
+func (s *blockingStorage) ApplyChangeset(version uint64, cs *store.Changeset) error {
+	<-s.release
+	return s.VersionedDatabase.ApplyChangeset(version, cs)
+}
+
+func TestAsyncStateStorage(t *testing.T) {
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+
+	db := &blockingStorage{
+		VersionedDatabase: storage.NewStorageStore(sqliteDB),
+		release:           make(chan struct{}),
+	}
+
+	ss, err := newAsyncStateStorage(db, defaultSSWriteQueueSize)
+	require.NoError(t, err)
+
+	cs1 := store.NewChangeset(map[string]store.KVPairs{
+		defaultStoreKey: {{Key: []byte("key1"), Value: []byte("value1")}, {Key: []byte("key2"), Value: []byte("value2")}},
+	})
+	cs2 := store.NewChangeset(map[string]store.KVPairs{
+		defaultStoreKey: {{Key: []byte("key1"), Value: []byte("value1-updated")}, {Key: []byte("key2")}},
+	})
+	require.NoError(t, ss.ApplyChangeset(1, cs1))
+	require.NoError(t, ss.ApplyChangeset(2, cs2))
+
+	latestVersion, err := ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latestVersion)
+
+	// point reads of in-flight versions are served from the queued changesets
+	value, err := ss.Get(defaultStoreKey, 1, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), value)
+
+	value, err = ss.Get(defaultStoreKey, 2, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1-updated"), value)
+
+	ok, err := ss.Has(defaultStoreKey, 1, []byte("key2"))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = ss.Has(defaultStoreKey, 2, []byte("key2"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// iterators wait for the writes of all versions up to the requested version
+	itrCh := make(chan store.Iterator)
+	go func() {
+		itr, err := ss.Iterator(defaultStoreKey, 1, nil, nil)
+		require.NoError(t, err)
+		itrCh <- itr
+	}()
+
+	select {
+	case <-itrCh:
+		t.Fatal("expected iterator to wait for version 1 to be written")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	db.release <- struct{}{}
+
+	itr := <-itrCh
+	defer itr.Close()
+
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	require.Equal(t, []string{"key1", "key2"}, keys)
+
+	// closing flushes the remaining writes
+	close(db.release)
+	require.NoError(t, ss.Close())
+}
@@ -72,6 +72,13 @@ func New(
 	ssOpts, scOpts pruning.Options,
 	m metrics.StoreMetrics,
 ) (store.RootStore, error) {
+	// SS writes are applied asynchronously s.t. Commit returns as soon as SC is
+	// committed
+	ss, err := newAsyncStateStorage(ss, defaultSSWriteQueueSize)
+	if err != nil {
+		return nil, err
+	}
+
 	rootKVStore, err := branch.New(defaultStoreKey, ss)
 	if err != nil {
 		return nil, err
@@ -80,6 +87,7 @@ func New(
 	pruningManager := pruning.NewManager(logger, ss, sc)
 	pruningManager.SetStorageOptions(ssOpts)
 	pruningManager.SetCommitmentOptions(scOpts)
+	pruningManager.SetMetrics(m)
 	pruningManager.Start()
 
 	return &Store{
@@ -96,6 +104,9 @@ func New(
 // Close closes the store and resets all internal fields. Note, Close() is NOT
 // idempotent and should only be called once.
 func (s *Store) Close() (err error) {
+	// wait for background pruning to complete before closing the backends
+	s.pruningManager.Stop()
+
 	err = errors.Join(err, s.stateStore.Close())
 	err = errors.Join(err, s.stateCommitment.Close())
 
@@ -104,13 +115,12 @@ func (s *Store) Close() (err error) {
 	s.lastCommitInfo = nil
 	s.commitHeader = nil
 
-	s.pruningManager.Stop()
-
 	return err
 }
 
 func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
+	s.pruningManager.SetMetrics(m)
 }
 
 func (s *Store) SetInitialVersion(v uint64) error {
@@ -132,11 +142,9 @@ func (s *Store) LastCommitID() (store.CommitID, error) {
 		return s.lastCommitInfo.CommitID(), nil
 	}
 
-	// XXX/TODO: We cannot use SS to get the latest version when lastCommitInfo
-	// is nil if SS is flushed asynchronously. This is because the latest version
-	// in SS might not be the latest version in the SC stores.
-	//
-	// Ref: https://github.com/cosmos/cosmos-sdk/issues/17314
+	// Note, SS writes are applied asynchronously, so the latest version in SS
+	// may be behind SC after a crash. Such versions are replayed from SC in
+	// LoadLatestVersion.
 	latestVersion, err := s.stateStore.GetLatestVersion()
 	if err != nil {
 		return store.CommitID{}, err
@@ -190,7 +198,11 @@ func (s *Store) Query(storeKey string, version uint64, key []byte, prove bool) (
 			return store.QueryResult{}, err
 		}
 
-		result.Proof = store.NewIAVLCommitmentOp(key, proof)
+		if opCreator, ok := s.stateCommitment.(store.CommitmentOpCreator); ok {
+			result.Proof = opCreator.NewCommitmentOp(storeKey, key, proof)
+		} else {
+			result.Proof = store.NewIAVLCommitmentOp(key, proof)
+		}
 	}
 
 	return result, nil
@@ -222,6 +234,10 @@ func (s *Store) LoadLatestVersion() error {
 		s.telemetry.MeasureSince(now, "root_store", "load_latest_version")
 	}
 
+	if err := s.recoverStateStorage(); err != nil {
+		return err
+	}
+
 	lv, err := s.GetLatestVersion()
 	if err != nil {
 		return err
@@ -230,6 +246,46 @@ func (s *Store) LoadLatestVersion() error {
 	return s.loadVersion(lv)
 }
 
+// recoverStateStorage replays the changesets of the SC versions that are missing
+// from SS, e.g. due to a crash while SS writes were in flight. Note, SS is never
+// ahead of SC as SS writes are only submitted once SC is committed.
+func (s *Store) recoverStateStorage() error {
+	ssVersion, err := s.stateStore.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	scVersion, err := s.stateCommitment.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if ssVersion >= scVersion {
+		return nil
+	}
+
+	getter, ok := s.stateCommitment.(store.ChangesetGetter)
+	if !ok {
+		return fmt.Errorf("SS version %d is behind SC version %d and SC does not support retrieving changesets", ssVersion, scVersion)
+	}
+
+	startVersion := max(ssVersion+1, s.initialVersion)
+	s.logger.Info("replaying SS versions from SC", "start_version", startVersion, "end_version", scVersion)
+
+	for v := startVersion; v <= scVersion; v++ {
+		cs, err := getter.GetChangeset(v)
+		if err != nil {
+			return fmt.Errorf("failed to get SC changeset of version %d: %w", v, err)
+		}
+
+		if err := s.stateStore.ApplyChangeset(v, cs); err != nil {
+			return fmt.Errorf("failed to replay SS version %d: %w", v, err)
+		}
+	}
+
+	return nil
+}
+
 func (s *Store) LoadVersion(version uint64) error {
 	if s.telemetry != nil {
 		now := time.Now()
@@ -330,7 +386,10 @@ func (s *Store) Write() {
 // retrieved from the rootKVStore and represents the entire set of writes to be
 // committed. The same changeset is used to flush writes to the SS backend.
 //
-// Note, Commit() commits SC and SC synchronously.
+// Note, Commit() commits SC synchronously and then queues the changeset to be
+// written to SS asynchronously, i.e. it returns without waiting for the SS write
+// to complete. Reads of versions whose SS write is still in flight are served
+// from the queued changesets or wait for the write to complete.
 func (s *Store) Commit() ([]byte, error) {
 	if s.telemetry != nil {
 		now := time.Now()
@@ -349,16 +408,16 @@ func (s *Store) Commit() ([]byte, error) {
 
 	changeset := s.rootKVStore.GetChangeset()
 
-	// commit SS
-	if err := s.stateStore.ApplyChangeset(version, changeset); err != nil {
-		return nil, fmt.Errorf("failed to commit SS: %w", err)
-	}
-
 	// commit SC
 	if err := s.commitSC(); err != nil {
 		return nil, fmt.Errorf("failed to commit SC stores: %w", err)
 	}
 
+	// commit SS asynchronously
+	if err := s.stateStore.ApplyChangeset(version, changeset); err != nil {
+		return nil, fmt.Errorf("failed to commit SS: %w", err)
+	}
+
 	if s.commitHeader != nil {
 		s.lastCommitInfo.Timestamp = s.commitHeader.Time
 	}
@@ -6,12 +6,14 @@ import (
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/smt"
 	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/storage"
 	"cosmossdk.io/store/v2/storage/sqlite"
@@ -284,3 +286,129 @@ func (s *RootStoreTestSuite) TestCommit() {
 		s.Require().Equal([]byte(val), bs.Get([]byte(key)))
 	}
 }
+
+func TestQuerySMTProof(t *testing.T) {
+	noopLog := log.NewNopLogger()
+
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(sqliteDB)
+
+	tree := smt.NewSparseMerkleTree(dbm.NewMemDB())
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{defaultStoreKey: tree}, noopLog)
+	require.NoError(t, err)
+
+	rs, err := New(noopLog, ss, sc, pruning.DefaultOptions(), pruning.DefaultOptions(), nil)
+	require.NoError(t, err)
+	defer rs.Close()
+
+	// write and commit a changeset
+	bs := rs.GetBranchedKVStore("")
+	bs.Set([]byte("foo"), []byte("bar"))
+
+	workingHash, err := rs.WorkingHash()
+	require.NoError(t, err)
+	require.NotNil(t, workingHash)
+
+	_, err = rs.Commit()
+	require.NoError(t, err)
+
+	// ensure the proof is wrapped in a commitment op matching the SMT proof spec
+	result, err := rs.Query(defaultStoreKey, 1, []byte("foo"), true)
+	require.NoError(t, err)
+	require.Equal(t, store.ProofOpSMTCommitment, result.Proof.Type)
+
+	roots, err := result.Proof.Run([][]byte{[]byte("bar")})
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+
+	require.Equal(t, tree.WorkingHash(), roots[0])
+}
+
+func TestRecoverStateStorage(t *testing.T) {
+	noopLog := log.NewNopLogger()
+
+	testCases := map[string]commitment.Tree{
+		"iavl": iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+		"smt":  smt.NewSparseMerkleTree(dbm.NewMemDB()),
+	}
+
+	for name, tree := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sqliteDB, err := sqlite.New(t.TempDir())
+			require.NoError(t, err)
+			ss := storage.NewStorageStore(sqliteDB)
+
+			sc, err := commitment.NewCommitStore(map[string]commitment.Tree{defaultStoreKey: tree}, noopLog)
+			require.NoError(t, err)
+
+			rs, err := New(noopLog, ss, sc, pruning.DefaultOptions(), pruning.DefaultOptions(), nil)
+			require.NoError(t, err)
+
+			// write and commit a few changesets through the root store
+			for v := 1; v <= 3; v++ {
+				rs.GetKVStore("").Set([]byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)))
+
+				_, err := rs.WorkingHash()
+				require.NoError(t, err)
+				_, err = rs.Commit()
+				require.NoError(t, err)
+			}
+			require.NoError(t, rs.(*Store).stateStore.(*asyncStateStorage).Flush())
+
+			// simulate a crash after SC was committed but before SS was written
+			for v := uint64(4); v <= 5; v++ {
+				cs := store.NewChangeset(map[string]store.KVPairs{
+					defaultStoreKey: {
+						{Key: []byte("key001")},
+						{Key: []byte(fmt.Sprintf("key%03d", v)), Value: []byte(fmt.Sprintf("val%03d", v))},
+					},
+				})
+				if v == 5 {
+					cs.Pairs[defaultStoreKey] = cs.Pairs[defaultStoreKey][1:]
+				}
+
+				require.NoError(t, sc.WriteBatch(cs))
+				sc.WorkingStoreInfos(v)
+				_, err := sc.Commit()
+				require.NoError(t, err)
+			}
+
+			ssVersion, err := ss.GetLatestVersion()
+			require.NoError(t, err)
+			require.Equal(t, uint64(3), ssVersion)
+
+			// loading the latest version replays the missing SS versions from SC
+			rs, err = New(noopLog, ss, sc, pruning.DefaultOptions(), pruning.DefaultOptions(), nil)
+			require.NoError(t, err)
+			require.NoError(t, rs.LoadLatestVersion())
+
+			latestVersion, err := rs.GetLatestVersion()
+			require.NoError(t, err)
+			require.Equal(t, uint64(5), latestVersion)
+
+			require.NoError(t, rs.(*Store).stateStore.(*asyncStateStorage).Flush())
+
+			ssVersion, err = ss.GetLatestVersion()
+			require.NoError(t, err)
+			require.Equal(t, uint64(5), ssVersion)
+
+			for v := uint64(1); v <= 5; v++ {
+				result, err := rs.Query(defaultStoreKey, 5, []byte(fmt.Sprintf("key%03d", v)), false)
+				require.NoError(t, err)
+
+				if v == 1 {
+					require.Nil(t, result.Value)
+				} else {
+					require.Equal(t, []byte(fmt.Sprintf("val%03d", v)), result.Value)
+				}
+			}
+
+			result, err := rs.Query(defaultStoreKey, 3, []byte("key001"), false)
+			require.NoError(t, err)
+			require.Equal(t, []byte("val001"), result.Value)
+
+			require.NoError(t, rs.Close())
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package snapshots
+
+// This file exists in the snapshots package to expose some private things
+// for the purpose of testing in the snapshots_test package.
+
+// SetBatchSize sets the number of writes buffered during restoration.
+func (s *StorageHistorySnapshotter) SetBatchSize(size int) {
+	s.batchSize = size
+}
@@ -0,0 +1,327 @@
+package snapshots
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+const (
+	// StorageHistorySnapshotName defines the name of the SS history extension.
+	StorageHistorySnapshotName = "storage_history"
+
+	// StorageHistorySnapshotFormat defines the format of the SS history extension
+	// payloads. The first payload is a header encoded as:
+	//
+	//	<uvarint startVersion><uvarint endVersion>
+	//
+	// Each subsequent payload is a single write encoded as:
+	//
+	//	<uvarint version><uvarint len(storeKey)><storeKey><uvarint len(key)><key><deleted><value>
+	StorageHistorySnapshotFormat uint32 = 1
+
+	// storageHistoryBatchSize defines the number of writes that are buffered
+	// before being applied during restoration.
+	storageHistoryBatchSize = 100000
+)
+
+var _ ExtensionSnapshotter = (*StorageHistorySnapshotter)(nil)
+
+// StorageHistorySnapshotter is an ExtensionSnapshotter that streams a window of
+// recent state storage (SS) versions, s.t. a node restored from a snapshot is
+// able to serve historical queries within that window right away.
+//
+// For a snapshot at height H and a window of W versions, the history covers the
+// versions [H-W+1, H]. It is streamed as the entire state at version H-W+1,
+// followed by every write, including deletions, applied to the keys in the
+// versions (H-W+1, H]. Note, the window is shrunk to the earliest version that
+// is not pruned in SS, if any.
+//
+// The snapshotter must be registered under the same store keys on both the
+// nodes taking and restoring snapshots.
+type StorageHistorySnapshotter struct {
+	db        store.VersionedDatabase
+	storeKeys []string
+	window    uint64
+	batchSize int
+}
+
+// NewStorageHistorySnapshotter returns a reference to a new StorageHistorySnapshotter
+// streaming the given number of recent versions of the given store keys.
+func NewStorageHistorySnapshotter(db store.VersionedDatabase, storeKeys []string, window uint64) *StorageHistorySnapshotter {
+	storeKeys = slices.Clone(storeKeys)
+	slices.Sort(storeKeys)
+
+	return &StorageHistorySnapshotter{
+		db:        db,
+		storeKeys: storeKeys,
+		window:    window,
+		batchSize: storageHistoryBatchSize,
+	}
+}
+
+// SnapshotName implements ExtensionSnapshotter.
+func (s *StorageHistorySnapshotter) SnapshotName() string {
+	return StorageHistorySnapshotName
+}
+
+// SnapshotFormat implements ExtensionSnapshotter.
+func (s *StorageHistorySnapshotter) SnapshotFormat() uint32 {
+	return StorageHistorySnapshotFormat
+}
+
+// SupportedFormats implements ExtensionSnapshotter.
+func (s *StorageHistorySnapshotter) SupportedFormats() []uint32 {
+	return []uint32{StorageHistorySnapshotFormat}
+}
+
+// SnapshotExtension implements ExtensionSnapshotter.
+func (s *StorageHistorySnapshotter) SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error {
+	// the state at the snapshot height is restored by the storage snapshotter, so
+	// there is no history to stream for a window of a single version
+	if s.window <= 1 || height <= 1 {
+		return payloadWriter(encodeStorageHistoryHeader(height, height))
+	}
+
+	startVersion := uint64(1)
+	if height > s.window {
+		startVersion = height - s.window + 1
+	}
+
+	// ensure the start version is not pruned
+	for _, storeKey := range s.storeKeys {
+		itr, err := s.db.Iterator(storeKey, startVersion, nil, nil)
+		if err != nil {
+			var errPruned store.ErrVersionPruned
+			if !errors.As(err, &errPruned) {
+				return err
+			}
+
+			startVersion = errPruned.EarliestVersion
+			break
+		}
+
+		itr.Close()
+	}
+
+	if startVersion >= height {
+		return payloadWriter(encodeStorageHistoryHeader(height, height))
+	}
+
+	if err := payloadWriter(encodeStorageHistoryHeader(startVersion, height)); err != nil {
+		return err
+	}
+
+	// stream the state at the start version
+	for _, storeKey := range s.storeKeys {
+		itr, err := s.db.Iterator(storeKey, startVersion, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		for ; itr.Valid(); itr.Next() {
+			if err := payloadWriter(encodeStorageHistoryWrite(startVersion, storeKey, itr.Key(), itr.Value(), false)); err != nil {
+				itr.Close()
+				return err
+			}
+		}
+
+		err = itr.Error()
+		itr.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	// stream the writes applied after the start version
+	for _, storeKey := range s.storeKeys {
+		itr, err := s.db.DiffIterator(storeKey, startVersion+1, height, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		for ; itr.Valid(); itr.Next() {
+			if err := payloadWriter(encodeStorageHistoryWrite(itr.Version(), storeKey, itr.Key(), itr.Value(), itr.Deleted())); err != nil {
+				itr.Close()
+				return err
+			}
+		}
+
+		err = itr.Error()
+		itr.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RestoreExtension implements ExtensionSnapshotter. The writes are buffered
+// and applied in bounded batches, each batch being applied in version order.
+// Since the writes to a key are streamed in ascending version order, every key
+// is restored in version order across batches. Finally, the latest version is
+// reset to the snapshot height.
+func (s *StorageHistorySnapshotter) RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error {
+	if format != StorageHistorySnapshotFormat {
+		return errorsmod.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+
+	payload, err := payloadReader()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return err
+	}
+
+	startVersion, endVersion, err := decodeStorageHistoryHeader(payload)
+	if err != nil {
+		return err
+	}
+	if endVersion != height || startVersion > endVersion {
+		return fmt.Errorf("invalid SS history window [%d, %d] for snapshot height %d", startVersion, endVersion, height)
+	}
+
+	var (
+		changesets = make(map[uint64]*store.Changeset)
+		size       int
+	)
+
+	// flush applies the buffered writes in version order
+	flush := func() error {
+		versions := make([]uint64, 0, len(changesets))
+		for version := range changesets {
+			versions = append(versions, version)
+		}
+		slices.Sort(versions)
+
+		for _, version := range versions {
+			if err := s.db.ApplyChangeset(version, changesets[version]); err != nil {
+				return err
+			}
+		}
+
+		changesets = make(map[uint64]*store.Changeset)
+		size = 0
+		return nil
+	}
+
+	for {
+		payload, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		version, storeKey, pair, err := decodeStorageHistoryWrite(payload)
+		if err != nil {
+			return err
+		}
+		if version < startVersion || version > endVersion {
+			return fmt.Errorf("SS history version %d out of window [%d, %d]", version, startVersion, endVersion)
+		}
+
+		cs, ok := changesets[version]
+		if !ok {
+			cs = store.NewChangeset(make(map[string]store.KVPairs))
+			changesets[version] = cs
+		}
+
+		cs.AddKVPair(storeKey, pair)
+		size++
+
+		if size >= s.batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return s.db.SetLatestVersion(height)
+}
+
+func encodeStorageHistoryHeader(startVersion, endVersion uint64) []byte {
+	bz := binary.AppendUvarint(nil, startVersion)
+	return binary.AppendUvarint(bz, endVersion)
+}
+
+func decodeStorageHistoryHeader(bz []byte) (startVersion, endVersion uint64, err error) {
+	startVersion, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return 0, 0, errors.New("invalid SS history header")
+	}
+
+	endVersion, m := binary.Uvarint(bz[n:])
+	if m <= 0 || n+m != len(bz) {
+		return 0, 0, errors.New("invalid SS history header")
+	}
+
+	return startVersion, endVersion, nil
+}
+
+func encodeStorageHistoryWrite(version uint64, storeKey string, key, value []byte, deleted bool) []byte {
+	bz := binary.AppendUvarint(nil, version)
+	bz = binary.AppendUvarint(bz, uint64(len(storeKey)))
+	bz = append(bz, storeKey...)
+	bz = binary.AppendUvarint(bz, uint64(len(key)))
+	bz = append(bz, key...)
+
+	if deleted {
+		return append(bz, 1)
+	}
+
+	bz = append(bz, 0)
+	return append(bz, value...)
+}
+
+func decodeStorageHistoryWrite(bz []byte) (version uint64, storeKey string, pair store.KVPair, err error) {
+	errInvalid := errors.New("invalid SS history write")
+
+	version, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return 0, "", store.KVPair{}, errInvalid
+	}
+	bz = bz[n:]
+
+	storeKeyLen, n := binary.Uvarint(bz)
+	if n <= 0 || uint64(len(bz)-n) < storeKeyLen {
+		return 0, "", store.KVPair{}, errInvalid
+	}
+	storeKey = string(bz[n : n+int(storeKeyLen)])
+	bz = bz[n+int(storeKeyLen):]
+
+	keyLen, n := binary.Uvarint(bz)
+	if n <= 0 || uint64(len(bz)-n) <= keyLen {
+		return 0, "", store.KVPair{}, errInvalid
+	}
+	pair.Key = bz[n : n+int(keyLen)]
+	bz = bz[n+int(keyLen):]
+
+	switch bz[0] {
+	case 0:
+		pair.Value = bz[1:]
+
+	case 1:
+		if len(bz) != 1 {
+			return 0, "", store.KVPair{}, errInvalid
+		}
+
+	default:
+		return 0, "", store.KVPair{}, errInvalid
+	}
+
+	return version, storeKey, pair, nil
+}
@@ -0,0 +1,143 @@
+package snapshots_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+var storageBackends = map[string]func(dataDir string) (*storage.StorageStore, error){
+	"pebbledb": func(dataDir string) (*storage.StorageStore, error) {
+		db, err := pebbledb.New(dataDir)
+		return storage.NewStorageStore(db), err
+	},
+	"sqlite": func(dataDir string) (*storage.StorageStore, error) {
+		db, err := sqlite.New(dataDir)
+		return storage.NewStorageStore(db), err
+	},
+}
+
+// sizeRecordingDatabase records the size of the largest changeset applied to
+// the wrapped database.
+type sizeRecordingDatabase struct {
+	store.VersionedDatabase
+	maxSize int
+}
+
+func (db *sizeRecordingDatabase) ApplyChangeset(version uint64, cs *store.Changeset) error {
+	db.maxSize = max(db.maxSize, cs.Size())
+	return db.VersionedDatabase.ApplyChangeset(version, cs)
+}
+
+func TestStorageHistorySnapshotter(t *testing.T) {
+	const (
+		height   = uint64(10)
+		window   = uint64(4)
+		numKeys  = 10
+		storeKey = "store1"
+	)
+
+	for name, newStorage := range storageBackends {
+		for _, batchSize := range []int{0, 1, 3} {
+			t.Run(fmt.Sprintf("%s/batch size %d", name, batchSize), func(t *testing.T) {
+				source, err := newStorage(t.TempDir())
+				require.NoError(t, err)
+				defer source.Close()
+
+				// every version updates a few keys and deletes another one
+				for v := uint64(1); v <= height; v++ {
+					cs := store.NewChangeset(map[string]store.KVPairs{})
+					for i := v % 3; i < numKeys; i += 3 {
+						cs.Add(storeKey, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d-%03d", i, v)))
+					}
+					if v > 1 {
+						cs.Add(storeKey, []byte(fmt.Sprintf("key%03d", (v+1)%numKeys)), nil)
+					}
+
+					require.NoError(t, source.ApplyChangeset(v, cs))
+				}
+
+				// take the snapshot extension
+				var payloads [][]byte
+				snapshotter := snapshots.NewStorageHistorySnapshotter(source, []string{storeKey}, window)
+				require.NoError(t, snapshotter.SnapshotExtension(height, func(payload []byte) error {
+					payloads = append(payloads, payload)
+					return nil
+				}))
+
+				target, err := newStorage(t.TempDir())
+				require.NoError(t, err)
+				defer target.Close()
+
+				// restore the state at the snapshot height, as the storage snapshotter does
+				chStorage := make(chan *store.KVPair, numKeys)
+				itr, err := source.Iterator(storeKey, height, nil, nil)
+				require.NoError(t, err)
+				for ; itr.Valid(); itr.Next() {
+					chStorage <- &store.KVPair{StoreKey: storeKey, Key: itr.Key(), Value: itr.Value()}
+				}
+				itr.Close()
+				close(chStorage)
+				require.NoError(t, target.Restore(height, chStorage))
+
+				// restore the snapshot extension
+				recorder := &sizeRecordingDatabase{VersionedDatabase: target}
+				restorer := snapshots.NewStorageHistorySnapshotter(recorder, []string{storeKey}, window)
+				if batchSize > 0 {
+					restorer.SetBatchSize(batchSize)
+				}
+				require.NoError(t, restorer.RestoreExtension(height, snapshots.StorageHistorySnapshotFormat, func() ([]byte, error) {
+					if len(payloads) == 0 {
+						return nil, io.EOF
+					}
+
+					payload := payloads[0]
+					payloads = payloads[1:]
+					return payload, nil
+				}))
+
+				if batchSize > 0 {
+					require.LessOrEqual(t, recorder.maxSize, batchSize)
+				}
+
+				latestVersion, err := target.GetLatestVersion()
+				require.NoError(t, err)
+				require.Equal(t, height, latestVersion)
+
+				// all versions within the window are queryable
+				for v := height - window + 1; v <= height; v++ {
+					for i := 0; i < numKeys; i++ {
+						key := []byte(fmt.Sprintf("key%03d", i))
+
+						expected, err := source.Get(storeKey, v, key)
+						require.NoError(t, err)
+
+						actual, err := target.Get(storeKey, v, key)
+						require.NoError(t, err)
+						require.Equal(t, expected, actual, "version %d, key %s", v, key)
+					}
+				}
+
+				// versions before the window are not restored
+				value, err := target.Get(storeKey, height-window, []byte("key000"))
+				require.NoError(t, err)
+				require.Nil(t, value)
+			})
+		}
+	}
+}
+
+func TestStorageHistorySnapshotter_UnknownFormat(t *testing.T) {
+	snapshotter := snapshots.NewStorageHistorySnapshotter(nil, nil, 10)
+	require.Error(t, snapshotter.RestoreExtension(10, snapshots.StorageHistorySnapshotFormat+1, func() ([]byte, error) {
+		return nil, io.EOF
+	}))
+}
@@ -19,6 +19,9 @@ type Database interface {
 	Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error)
 	ReverseIterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error)
 
+	DiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error)
+	ReverseDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error)
+
 	Prune(version uint64) error
 
 	io.Closer
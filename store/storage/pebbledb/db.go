@@ -24,9 +24,15 @@ const (
 	latestVersionKey = "s/_latest"       // NB: latestVersionKey key must be lexically smaller than StorePrefixTpl
 	pruneHeightKey   = "s/_prune_height" // NB: pruneHeightKey key must be lexically smaller than StorePrefixTpl
 	tombstoneVal     = "TOMBSTONE"
+
+	// storePrefixLowerBound defines the lower bound of all store prefixed keys
+	storePrefixLowerBound = "s/k:"
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database        = (*Database)(nil)
+	_ store.IncrementalPruner = (*Database)(nil)
+)
 
 type Database struct {
 	storage *pebble.DB
@@ -194,10 +200,38 @@ func (db *Database) Get(storeKey string, targetVersion uint64, key []byte) ([]by
 //
 // See: https://github.com/cockroachdb/cockroach/blob/33623e3ee420174a4fd3226d1284b03f0e3caaac/pkg/storage/mvcc.go#L3182
 func (db *Database) Prune(version uint64) error {
-	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
-	if err != nil {
+	if _, err := db.prune(version, []byte(storePrefixLowerBound), 0); err != nil {
 		return err
 	}
+
+	return db.setPruneHeight(version)
+}
+
+// PruneStep implements storage.IncrementalPruner. The cursor reflects the key to
+// resume pruning from.
+func (db *Database) PruneStep(version uint64, cursor []byte, limit int) ([]byte, error) {
+	if cursor == nil {
+		if err := db.setPruneHeight(version); err != nil {
+			return nil, err
+		}
+
+		cursor = []byte(storePrefixLowerBound)
+	}
+
+	return db.prune(version, cursor, limit)
+}
+
+// prune prunes all versions up to and including the provided version of the
+// keys starting from the given key. If limit is positive, pruning stops at the
+// first key encountered once at least limit entries are processed, and that key
+// is returned MVCC encoded with a zero version, s.t. it sorts before all of its
+// versions when used as the lower bound of the next step. Otherwise, or if all
+// keys are processed, nil is returned.
+func (db *Database) prune(version uint64, start []byte, limit int) ([]byte, error) {
+	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: start})
+	if err != nil {
+		return nil, err
+	}
 	defer itr.Close()
 
 	batch := db.storage.NewBatch()
@@ -205,6 +239,8 @@ func (db *Database) Prune(version uint64) error {
 
 	var (
 		batchCounter                              int
+		entryCounter                              int
+		next                                      []byte
 		prevKey, prevKeyPrefixed, prevPrefixedVal []byte
 		prevKeyVersion                            uint64
 	)
@@ -214,12 +250,12 @@ func (db *Database) Prune(version uint64) error {
 
 		keyBz, verBz, ok := SplitMVCCKey(prefixedKey)
 		if !ok {
-			return fmt.Errorf("invalid PebbleDB MVCC key: %s", prefixedKey)
+			return nil, fmt.Errorf("invalid PebbleDB MVCC key: %s", prefixedKey)
 		}
 
 		keyVersion, err := decodeUint64Ascending(verBz)
 		if err != nil {
-			return fmt.Errorf("failed to decode key version: %w", err)
+			return nil, fmt.Errorf("failed to decode key version: %w", err)
 		}
 
 		// seek to next key if we are at a version which is higher than prune height
@@ -233,13 +269,13 @@ func (db *Database) Prune(version uint64) error {
 		// been tombstoned and its version is <= to the prune height.
 		if prevKeyVersion <= version && (bytes.Equal(prevKey, keyBz) || valTombstoned(prevPrefixedVal)) {
 			if err := batch.Delete(prevKeyPrefixed, nil); err != nil {
-				return err
+				return nil, err
 			}
 
 			batchCounter++
 			if batchCounter >= PruneCommitBatchSize {
 				if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
-					return err
+					return nil, err
 				}
 
 				batchCounter = 0
@@ -247,6 +283,13 @@ func (db *Database) Prune(version uint64) error {
 			}
 		}
 
+		// stop at the first entry of a key once the limit is reached
+		entryCounter++
+		if limit > 0 && entryCounter > limit && !bytes.Equal(prevKey, keyBz) {
+			next = MVCCEncode(keyBz, 0)
+			break
+		}
+
 		prevKey = keyBz
 		prevKeyVersion = keyVersion
 		prevKeyPrefixed = prefixedKey
@@ -255,14 +298,18 @@ func (db *Database) Prune(version uint64) error {
 		itr.Next()
 	}
 
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+
 	// commit any leftover delete ops in batch
 	if batchCounter > 0 {
 		if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return db.setPruneHeight(version)
+	return next, nil
 }
 
 func (db *Database) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
@@ -313,6 +360,42 @@ func (db *Database) ReverseIterator(storeKey string, version uint64, start, end
 	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, true), nil
 }
 
+func (db *Database) DiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	return db.newDiffIterator(storeKey, startVersion, endVersion, start, end, false)
+}
+
+func (db *Database) ReverseDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	return db.newDiffIterator(storeKey, startVersion, endVersion, start, end, true)
+}
+
+func (db *Database) newDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte, reverse bool) (store.DiffIterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, store.ErrKeyEmpty
+	}
+
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, store.ErrStartAfterEnd
+	}
+
+	if startVersion > endVersion {
+		return nil, store.ErrStartVersionAfterEndVersion
+	}
+
+	if startVersion < db.earliestVersion {
+		return nil, store.ErrVersionPruned{EarliestVersion: db.earliestVersion}
+	}
+
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prependStoreKey(storeKey, start), 0),
+		UpperBound: diffIteratorUpperBound(storeKey, end),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PebbleDB iterator: %w", err)
+	}
+
+	return newPebbleDBDiffIterator(itr, storePrefix(storeKey), start, end, startVersion, endVersion, reverse), nil
+}
+
 func storePrefix(storeKey string) []byte {
 	return []byte(fmt.Sprintf(StorePrefixTpl, storeKey))
 }
@@ -0,0 +1,232 @@
+package pebbledb
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/cockroachdb/pebble"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage/util"
+)
+
+var _ store.DiffIterator = (*diffIterator)(nil)
+
+// diffIterator implements the store.DiffIterator interface. It wraps a PebbleDB
+// iterator and walks every MVCC entry, i.e. every set and delete, for the keys
+// in the provided domain whose version falls within [startVersion, endVersion].
+// Since MVCC keys are ordered by key and then by ascending version, a forward
+// diffIterator yields entries in ascending (key, version) order, and a reverse
+// diffIterator yields entries in descending (key, version) order.
+type diffIterator struct {
+	source                   *pebble.Iterator
+	prefix, start, end       []byte
+	startVersion, endVersion uint64
+	valid                    bool
+	reverse                  bool
+}
+
+func newPebbleDBDiffIterator(src *pebble.Iterator, prefix, start, end []byte, startVersion, endVersion uint64, reverse bool) *diffIterator {
+	itr := &diffIterator{
+		source:       src,
+		prefix:       prefix,
+		start:        start,
+		end:          end,
+		startVersion: startVersion,
+		endVersion:   endVersion,
+		reverse:      reverse,
+	}
+
+	// move the underlying PebbleDB iterator to the first key and then to the first
+	// entry within the version window
+	if reverse {
+		itr.valid = src.Last()
+	} else {
+		itr.valid = src.First()
+	}
+
+	itr.valid = itr.valid && itr.seekWithinVersions()
+
+	return itr
+}
+
+// Domain returns the domain of the iterator. The caller must not modify the
+// return values.
+func (itr *diffIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *diffIterator) Key() []byte {
+	itr.assertIsValid()
+
+	key, _, ok := SplitMVCCKey(itr.source.Key())
+	if !ok {
+		// XXX: This should not happen as that would indicate we have a malformed
+		// MVCC key.
+		panic(fmt.Sprintf("invalid PebbleDB MVCC key: %s", itr.source.Key()))
+	}
+
+	keyCopy := slices.Clone(key)
+	return keyCopy[len(itr.prefix):]
+}
+
+func (itr *diffIterator) Value() []byte {
+	itr.assertIsValid()
+
+	if itr.Deleted() {
+		return nil
+	}
+
+	val, _, ok := SplitMVCCKey(itr.source.Value())
+	if !ok {
+		// XXX: This should not happen as that would indicate we have a malformed
+		// MVCC value.
+		panic(fmt.Sprintf("invalid PebbleDB MVCC value: %s", itr.source.Key()))
+	}
+
+	return slices.Clone(val)
+}
+
+func (itr *diffIterator) Version() uint64 {
+	itr.assertIsValid()
+
+	_, vBz, ok := SplitMVCCKey(itr.source.Key())
+	if !ok {
+		// XXX: This should not happen as that would indicate we have a malformed
+		// MVCC key.
+		panic(fmt.Sprintf("invalid PebbleDB MVCC key: %s", itr.source.Key()))
+	}
+
+	version, err := decodeMVCCVersion(vBz)
+	if err != nil {
+		panic(fmt.Errorf("failed to decode key version: %w", err))
+	}
+
+	return version
+}
+
+// Deleted returns true if the current entry is a tombstone. Note, a PebbleDB
+// batch writes deletes as a new MVCC entry whose tombstone equals the version of
+// the entry, so any non-empty tombstone suffix denotes a deletion.
+func (itr *diffIterator) Deleted() bool {
+	itr.assertIsValid()
+	return valTombstoned(itr.source.Value())
+}
+
+func (itr *diffIterator) Next() bool {
+	if !itr.valid {
+		return false
+	}
+
+	if itr.reverse {
+		itr.valid = itr.source.Prev()
+	} else {
+		itr.valid = itr.source.Next()
+	}
+
+	itr.valid = itr.valid && itr.seekWithinVersions()
+	return itr.valid
+}
+
+func (itr *diffIterator) Valid() bool {
+	// once invalid, forever invalid
+	if !itr.valid || !itr.source.Valid() {
+		itr.valid = false
+		return itr.valid
+	}
+
+	// if source has error, consider it invalid
+	if err := itr.source.Error(); err != nil {
+		itr.valid = false
+		return itr.valid
+	}
+
+	return true
+}
+
+func (itr *diffIterator) Error() error {
+	return itr.source.Error()
+}
+
+func (itr *diffIterator) Close() {
+	_ = itr.source.Close()
+	itr.source = nil
+	itr.valid = false
+}
+
+func (itr *diffIterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
+
+// seekWithinVersions moves the cursor, in the direction of the iterator, until
+// it points at an entry whose version is within [startVersion, endVersion]. It
+// skips over entire keys using seeks where possible. It returns <false> if no
+// such entry exists.
+func (itr *diffIterator) seekWithinVersions() bool {
+	valid := itr.source.Valid()
+	for valid {
+		key, vBz, ok := SplitMVCCKey(itr.source.Key())
+		if !ok {
+			// XXX: This should not happen as that would indicate we have a malformed
+			// MVCC key.
+			return false
+		}
+		if !bytes.HasPrefix(key, itr.prefix) {
+			// the key must have itr.prefix as the prefix
+			return false
+		}
+
+		version, err := decodeMVCCVersion(vBz)
+		if err != nil {
+			return false
+		}
+
+		switch {
+		case version >= itr.startVersion && version <= itr.endVersion:
+			return true
+
+		case itr.reverse && version > itr.endVersion:
+			// seek to the latest version of the key that is <= endVersion, or to
+			// the previous key
+			valid = itr.source.SeekLT(MVCCEncode(key, itr.endVersion+1))
+
+		case itr.reverse:
+			// all remaining versions of the key are < startVersion
+			valid = itr.source.SeekLT(MVCCEncode(key, 0))
+
+		case version < itr.startVersion:
+			// seek to the earliest version of the key that is >= startVersion, or
+			// to the next key
+			valid = itr.source.SeekGE(MVCCEncode(key, itr.startVersion))
+
+		default:
+			// all remaining versions of the key are > endVersion
+			valid = itr.source.NextPrefix()
+		}
+	}
+
+	return false
+}
+
+// decodeMVCCVersion decodes the version suffix of an MVCC key, where an empty
+// suffix denotes version zero.
+func decodeMVCCVersion(vBz []byte) (uint64, error) {
+	if len(vBz) == 0 {
+		return 0, nil
+	}
+
+	return decodeUint64Ascending(vBz)
+}
+
+// diffIteratorUpperBound returns the exclusive PebbleDB upper bound of a diff
+// iteration over the given store and end key.
+func diffIteratorUpperBound(storeKey string, end []byte) []byte {
+	if end != nil {
+		return MVCCEncode(prependStoreKey(storeKey, end), 0)
+	}
+
+	return MVCCEncode(util.CopyIncr(storePrefix(storeKey)), 0)
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math"
 
 	"github.com/linxGnu/grocksdb"
 	"golang.org/x/exp/slices"
@@ -21,10 +22,14 @@ const (
 
 	StorePrefixTpl   = "s/k:%s/"
 	latestVersionKey = "s/latest"
+
+	// storePrefixLowerBound defines the lower bound of all store prefixed keys
+	storePrefixLowerBound = "s/k:"
 )
 
 var (
-	_ storage.Database = (*Database)(nil)
+	_ storage.Database        = (*Database)(nil)
+	_ store.IncrementalPruner = (*Database)(nil)
 
 	defaultWriteOpts = grocksdb.NewDefaultWriteOptions()
 	defaultReadOpts  = grocksdb.NewDefaultReadOptions()
@@ -168,6 +173,46 @@ func (db *Database) Prune(version uint64) error {
 	return nil
 }
 
+// PruneStep implements store.IncrementalPruner. The first step raises
+// full_history_ts_low as Prune does, and every step compacts the range of roughly
+// limit keys starting from the cursor, s.t. the space of the pruned versions is
+// reclaimed in bounded steps instead of waiting for future compactions. The
+// cursor reflects the key to resume compacting from.
+func (db *Database) PruneStep(version uint64, cursor []byte, limit int) ([]byte, error) {
+	if cursor == nil {
+		if err := db.Prune(version); err != nil {
+			return nil, err
+		}
+
+		cursor = []byte(storePrefixLowerBound)
+	}
+
+	itr := db.storage.NewIteratorCF(newTSReadOptions(math.MaxUint64), db.cfHandle)
+	defer itr.Close()
+
+	var (
+		next  []byte
+		count int
+	)
+	for itr.Seek(cursor); itr.Valid(); itr.Next() {
+		if count >= max(limit, 1) {
+			next = slices.Clone(readOnlySlice(itr.Key()))
+			break
+		}
+
+		count++
+	}
+
+	if err := itr.Err(); err != nil {
+		return nil, err
+	}
+
+	// a nil limit compacts up to the last key
+	db.storage.CompactRangeCF(db.cfHandle, grocksdb.Range{Start: cursor, Limit: next})
+
+	return next, nil
+}
+
 func (db *Database) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, store.ErrKeyEmpty
@@ -200,6 +245,44 @@ func (db *Database) ReverseIterator(storeKey string, version uint64, start, end
 	return newRocksDBIterator(itr, prefix, start, end, true), nil
 }
 
+func (db *Database) DiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	return db.newDiffIterator(storeKey, startVersion, endVersion, start, end, false)
+}
+
+func (db *Database) ReverseDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	return db.newDiffIterator(storeKey, startVersion, endVersion, start, end, true)
+}
+
+func (db *Database) newDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte, reverse bool) (store.DiffIterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, store.ErrKeyEmpty
+	}
+
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, store.ErrStartAfterEnd
+	}
+
+	if startVersion > endVersion {
+		return nil, store.ErrStartVersionAfterEndVersion
+	}
+
+	if startVersion < db.tsLow {
+		return nil, store.ErrVersionPruned{EarliestVersion: db.tsLow}
+	}
+
+	prefix := storePrefix(storeKey)
+	start, end = util.IterateWithPrefix(prefix, start, end)
+
+	var startTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(startTS[:], startVersion)
+
+	readOpts := newTSReadOptions(endVersion)
+	readOpts.SetIterStartTimestamp(startTS[:])
+
+	itr := db.storage.NewIteratorCF(readOpts, db.cfHandle)
+	return newRocksDBDiffIterator(itr, prefix, start, end, reverse), nil
+}
+
 // newTSReadOptions returns ReadOptions used in the RocksDB column family read.
 func newTSReadOptions(version uint64) *grocksdb.ReadOptions {
 	var ts [TimestampSize]byte
@@ -0,0 +1,250 @@
+//go:build rocksdb
+// +build rocksdb
+
+package rocksdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+
+	"github.com/linxGnu/grocksdb"
+
+	"cosmossdk.io/store/v2"
+)
+
+const (
+	// internalKeyFooterSize defines the size of the RocksDB internal key footer,
+	// i.e. the packed sequence number and value type, that is appended to keys
+	// when iterating with iter_start_ts set.
+	internalKeyFooterSize = 8
+
+	// RocksDB internal value types, see: rocksdb/db/dbformat.h
+	valueTypeDeletion              = 0x0
+	valueTypeSingleDeletion        = 0x7
+	valueTypeDeletionWithTimestamp = 0x14
+)
+
+var _ store.DiffIterator = (*diffIterator)(nil)
+
+// diffEntry defines a single versioned write of a key.
+type diffEntry struct {
+	key, value []byte
+	version    uint64
+	deleted    bool
+}
+
+// diffIterator implements the store.DiffIterator interface. It wraps a RocksDB
+// iterator created with iter_start_ts set, s.t. all versions of a key within the
+// timestamp window, including tombstones, are returned with their internal keys.
+// Since RocksDB orders the versions of a key from newest to oldest, all entries
+// of the current key are buffered and yielded in the order of the iterator.
+type diffIterator struct {
+	source             *grocksdb.Iterator
+	prefix, start, end []byte
+	reverse            bool
+	entries            []diffEntry
+	invalid            bool
+}
+
+func newRocksDBDiffIterator(source *grocksdb.Iterator, prefix, start, end []byte, reverse bool) *diffIterator {
+	if reverse {
+		if end == nil {
+			source.SeekToLast()
+		} else {
+			source.Seek(end)
+
+			if source.Valid() {
+				eoaKey, _, _ := splitInternalKey(readOnlySlice(source.Key())) // end or after key
+				if bytes.Compare(end, eoaKey) <= 0 {
+					source.Prev()
+				}
+			} else {
+				source.SeekToLast()
+			}
+		}
+	} else {
+		if start == nil {
+			source.SeekToFirst()
+		} else {
+			source.Seek(start)
+		}
+	}
+
+	itr := &diffIterator{
+		source:  source,
+		prefix:  prefix,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+
+	itr.invalid = !itr.loadEntries()
+	return itr
+}
+
+// Domain returns the domain of the iterator. The caller must not modify the
+// return values.
+func (itr *diffIterator) Domain() ([]byte, []byte) {
+	start := itr.start
+	if start != nil {
+		start = start[len(itr.prefix):]
+		if len(start) == 0 {
+			start = nil
+		}
+	}
+
+	end := itr.end
+	if end != nil {
+		end = end[len(itr.prefix):]
+		if len(end) == 0 {
+			end = nil
+		}
+	}
+
+	return start, end
+}
+
+func (itr *diffIterator) Valid() bool {
+	// once invalid, forever invalid
+	if itr.invalid {
+		return false
+	}
+
+	// if source has error, consider it invalid
+	if err := itr.source.Err(); err != nil {
+		itr.invalid = true
+		return false
+	}
+
+	return len(itr.entries) > 0
+}
+
+func (itr *diffIterator) Key() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.entries[0].key)
+}
+
+func (itr *diffIterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.entries[0].value)
+}
+
+func (itr *diffIterator) Version() uint64 {
+	itr.assertIsValid()
+	return itr.entries[0].version
+}
+
+func (itr *diffIterator) Deleted() bool {
+	itr.assertIsValid()
+	return itr.entries[0].deleted
+}
+
+func (itr *diffIterator) Next() bool {
+	if itr.invalid {
+		return false
+	}
+
+	itr.entries = itr.entries[1:]
+	if len(itr.entries) == 0 {
+		itr.invalid = !itr.loadEntries()
+	}
+
+	return itr.Valid()
+}
+
+func (itr *diffIterator) Error() error {
+	return itr.source.Err()
+}
+
+func (itr *diffIterator) Close() {
+	itr.source.Close()
+	itr.source = nil
+	itr.entries = nil
+	itr.invalid = true
+}
+
+func (itr *diffIterator) assertIsValid() {
+	if itr.invalid {
+		panic("iterator is invalid")
+	}
+}
+
+// loadEntries buffers all versions of the key the source cursor points at and
+// moves the cursor to the next key in the direction of the iterator. The entries
+// are ordered by ascending version for forward iterators and by descending
+// version for reverse iterators. It returns <false> if the source is exhausted.
+func (itr *diffIterator) loadEntries() bool {
+	var entries []diffEntry
+	for itr.sourceValid() {
+		key, ts, valueType := splitInternalKey(readOnlySlice(itr.source.Key()))
+		if len(entries) > 0 && !bytes.Equal(key, entries[0].key) {
+			break
+		}
+
+		entry := diffEntry{
+			key:     slices.Clone(key),
+			version: binary.LittleEndian.Uint64(ts),
+			deleted: isDeletion(valueType),
+		}
+		if !entry.deleted {
+			entry.value = copyAndFreeSlice(itr.source.Value())
+		}
+
+		entries = append(entries, entry)
+
+		if itr.reverse {
+			itr.source.Prev()
+		} else {
+			itr.source.Next()
+		}
+	}
+
+	// A forward RocksDB iterator yields the versions of a key from newest to
+	// oldest and a reverse iterator from oldest to newest, so in both cases the
+	// buffered entries are reversed.
+	slices.Reverse(entries)
+
+	for i := range entries {
+		entries[i].key = entries[i].key[len(itr.prefix):]
+	}
+
+	itr.entries = entries
+	return len(entries) > 0
+}
+
+// sourceValid returns true if the source cursor points at a key within the
+// domain of the iterator.
+func (itr *diffIterator) sourceValid() bool {
+	if !itr.source.Valid() || itr.source.Err() != nil {
+		return false
+	}
+
+	key, _, _ := splitInternalKey(readOnlySlice(itr.source.Key()))
+	if !bytes.HasPrefix(key, itr.prefix) {
+		return false
+	}
+
+	if itr.reverse {
+		return itr.start == nil || bytes.Compare(key, itr.start) >= 0
+	}
+
+	return itr.end == nil || bytes.Compare(itr.end, key) > 0
+}
+
+// splitInternalKey splits a RocksDB internal key, as returned by an iterator
+// with iter_start_ts set, into the user key, timestamp and value type.
+func splitInternalKey(internalKey []byte) (key, ts []byte, valueType byte) {
+	n := len(internalKey) - internalKeyFooterSize
+	return internalKey[:n-TimestampSize], internalKey[n-TimestampSize : n], internalKey[n]
+}
+
+func isDeletion(valueType byte) bool {
+	switch valueType {
+	case valueTypeDeletion, valueTypeSingleDeletion, valueTypeDeletionWithTimestamp:
+		return true
+
+	default:
+		return false
+	}
+}
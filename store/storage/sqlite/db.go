@@ -3,6 +3,7 @@ package sqlite
 import (
 	"bytes"
 	"database/sql"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -32,6 +33,24 @@ const (
     VALUES(?, ?, ?, ?)
   ON CONFLICT(store_key, key, version) DO UPDATE SET
     value = ?;
+	`
+	pruneStmt = `
+	DELETE FROM state_storage
+	WHERE version < (
+		SELECT max(version) FROM state_storage t2 WHERE
+		t2.store_key = state_storage.store_key AND
+		t2.key = state_storage.key AND
+		t2.version <= ?
+	) AND store_key != ?;
+	`
+	pruneRangeStmt = `
+	DELETE FROM state_storage
+	WHERE id > ? AND id <= ? AND version < (
+		SELECT max(version) FROM state_storage t2 WHERE
+		t2.store_key = state_storage.store_key AND
+		t2.key = state_storage.key AND
+		t2.version <= ?
+	) AND store_key != ?;
 	`
 	delStmt = `
 	UPDATE state_storage SET tombstone = ?
@@ -41,7 +60,10 @@ const (
 	`
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database        = (*Database)(nil)
+	_ store.IncrementalPruner = (*Database)(nil)
+)
 
 type Database struct {
 	storage *sql.DB
@@ -185,15 +207,6 @@ func (db *Database) Prune(version uint64) error {
 		return fmt.Errorf("failed to create SQL transaction: %w", err)
 	}
 
-	pruneStmt := `DELETE FROM state_storage
-	WHERE version < (
-		SELECT max(version) FROM state_storage t2 WHERE
-		t2.store_key = state_storage.store_key AND
-		t2.key = state_storage.key AND
-		t2.version <= ?
-	) AND store_key != ?;
-	`
-
 	_, err = tx.Exec(pruneStmt, version, reservedStoreKey)
 	if err != nil {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
@@ -214,6 +227,45 @@ func (db *Database) Prune(version uint64) error {
 	return nil
 }
 
+// PruneStep implements store.IncrementalPruner. Rows are pruned in ranges of
+// limit row IDs, where the cursor reflects the last row ID of the previous range.
+func (db *Database) PruneStep(version uint64, cursor []byte, limit int) ([]byte, error) {
+	var startID uint64
+	if cursor == nil {
+		// set the prune height so we can return <nil> for queries below this height
+		_, err := db.storage.Exec(reservedUpsertStmt, reservedStoreKey, keyPruneHeight, version, 0, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to exec SQL statement: %w", err)
+		}
+
+		db.earliestVersion = version + 1
+	} else {
+		if len(cursor) != 8 {
+			return nil, fmt.Errorf("invalid prune cursor: %X", cursor)
+		}
+
+		startID = binary.BigEndian.Uint64(cursor)
+	}
+
+	var maxID sql.NullInt64
+	if err := db.storage.QueryRow("SELECT max(id) FROM state_storage").Scan(&maxID); err != nil {
+		return nil, fmt.Errorf("failed to query max row ID: %w", err)
+	}
+
+	endID := startID + uint64(max(limit, 1))
+
+	_, err := db.storage.Exec(pruneRangeStmt, startID, endID, version, reservedStoreKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	if !maxID.Valid || endID >= uint64(maxID.Int64) {
+		return nil, nil
+	}
+
+	return binary.BigEndian.AppendUint64(nil, endID), nil
+}
+
 func (db *Database) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, store.ErrKeyEmpty
@@ -238,6 +290,42 @@ func (db *Database) ReverseIterator(storeKey string, version uint64, start, end
 	return newIterator(db, storeKey, version, start, end, true)
 }
 
+func (db *Database) DiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	if err := db.validateDiffDomain(startVersion, endVersion, start, end); err != nil {
+		return nil, err
+	}
+
+	return newDiffIterator(db, storeKey, startVersion, endVersion, start, end, false)
+}
+
+func (db *Database) ReverseDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	if err := db.validateDiffDomain(startVersion, endVersion, start, end); err != nil {
+		return nil, err
+	}
+
+	return newDiffIterator(db, storeKey, startVersion, endVersion, start, end, true)
+}
+
+func (db *Database) validateDiffDomain(startVersion, endVersion uint64, start, end []byte) error {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return store.ErrKeyEmpty
+	}
+
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return store.ErrStartAfterEnd
+	}
+
+	if startVersion > endVersion {
+		return store.ErrStartVersionAfterEndVersion
+	}
+
+	if startVersion < db.earliestVersion {
+		return store.ErrVersionPruned{EarliestVersion: db.earliestVersion}
+	}
+
+	return nil
+}
+
 func (db *Database) PrintRowsDebug() {
 	stmt, err := db.storage.Prepare("SELECT store_key, key, value, version, tombstone FROM state_storage")
 	if err != nil {
@@ -433,6 +433,165 @@ func (s *StorageTestSuite) TestDatabase_IteratorNoDomain() {
 	s.Require().NoError(itr.Error())
 }
 
+func (s *StorageTestSuite) TestDatabase_DiffIterator() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	s.applyDiffChangesets(db)
+
+	testCases := map[string]struct {
+		startVersion, endVersion uint64
+		start, end               []byte
+		reverse                  bool
+		expected                 []string
+	}{
+		"full history": {
+			startVersion: 1,
+			endVersion:   4,
+			expected: []string{
+				"key001/1/val001-1", "key001/3/<deleted>", "key001/4/val001-4",
+				"key002/1/val002-1", "key002/2/val002-2",
+				"key003/1/val003-1", "key003/4/<deleted>",
+			},
+		},
+		"version window": {
+			startVersion: 2,
+			endVersion:   3,
+			expected:     []string{"key001/3/<deleted>", "key002/2/val002-2"},
+		},
+		"version window and domain": {
+			startVersion: 1,
+			endVersion:   4,
+			start:        []byte("key002"),
+			end:          []byte("key003"),
+			expected:     []string{"key002/1/val002-1", "key002/2/val002-2"},
+		},
+		"single version": {
+			startVersion: 4,
+			endVersion:   4,
+			expected:     []string{"key001/4/val001-4", "key003/4/<deleted>"},
+		},
+		"version window after latest version": {
+			startVersion: 5,
+			endVersion:   10,
+			expected:     nil,
+		},
+		"reverse full history": {
+			startVersion: 1,
+			endVersion:   4,
+			reverse:      true,
+			expected: []string{
+				"key003/4/<deleted>", "key003/1/val003-1",
+				"key002/2/val002-2", "key002/1/val002-1",
+				"key001/4/val001-4", "key001/3/<deleted>", "key001/1/val001-1",
+			},
+		},
+		"reverse version window and domain": {
+			startVersion: 3,
+			endVersion:   4,
+			start:        []byte("key001"),
+			end:          []byte("key003"),
+			reverse:      true,
+			expected:     []string{"key001/4/val001-4", "key001/3/<deleted>"},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			var (
+				itr store.DiffIterator
+				err error
+			)
+			if tc.reverse {
+				itr, err = db.ReverseDiffIterator(storeKey1, tc.startVersion, tc.endVersion, tc.start, tc.end)
+			} else {
+				itr, err = db.DiffIterator(storeKey1, tc.startVersion, tc.endVersion, tc.start, tc.end)
+			}
+			s.Require().NoError(err)
+
+			defer itr.Close()
+
+			var actual []string
+			for ; itr.Valid(); itr.Next() {
+				val := string(itr.Value())
+				if itr.Deleted() {
+					s.Require().Nil(itr.Value())
+					val = "<deleted>"
+				}
+
+				actual = append(actual, fmt.Sprintf("%s/%d/%s", itr.Key(), itr.Version(), val))
+			}
+			s.Require().NoError(itr.Error())
+			s.Require().Equal(tc.expected, actual)
+
+			start, end := itr.Domain()
+			s.Require().Equal(tc.start, start)
+			s.Require().Equal(tc.end, end)
+		})
+	}
+}
+
+func (s *StorageTestSuite) TestDatabase_DiffIteratorInvalidDomain() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	s.applyDiffChangesets(db)
+
+	itr, err := db.DiffIterator(storeKey1, 1, 4, []byte{}, []byte{})
+	s.Require().ErrorIs(err, store.ErrKeyEmpty)
+	s.Require().Nil(itr)
+
+	itr, err = db.DiffIterator(storeKey1, 1, 4, []byte("key003"), []byte("key001"))
+	s.Require().ErrorIs(err, store.ErrStartAfterEnd)
+	s.Require().Nil(itr)
+
+	itr, err = db.ReverseDiffIterator(storeKey1, 4, 1, nil, nil)
+	s.Require().ErrorIs(err, store.ErrStartVersionAfterEndVersion)
+	s.Require().Nil(itr)
+}
+
+// applyDiffChangesets writes the following history to storeKey1, along with
+// unrelated writes to another store key:
+//
+//	v1: set key001, key002, key003
+//	v2: set key002
+//	v3: delete key001
+//	v4: set key001, delete key003
+func (s *StorageTestSuite) applyDiffChangesets(db store.VersionedDatabase) {
+	s.Require().NoError(db.ApplyChangeset(1, store.NewChangeset(
+		map[string]store.KVPairs{
+			storeKey1: {
+				{Key: []byte("key001"), Value: []byte("val001-1")},
+				{Key: []byte("key002"), Value: []byte("val002-1")},
+				{Key: []byte("key003"), Value: []byte("val003-1")},
+			},
+			"store2": {{Key: []byte("key001"), Value: []byte("val001-1")}},
+		},
+	)))
+	s.Require().NoError(db.ApplyChangeset(2, store.NewChangeset(
+		map[string]store.KVPairs{
+			storeKey1: {{Key: []byte("key002"), Value: []byte("val002-2")}},
+			"store2":  {{Key: []byte("key002"), Value: []byte("val002-2")}},
+		},
+	)))
+	s.Require().NoError(db.ApplyChangeset(3, store.NewChangeset(
+		map[string]store.KVPairs{
+			storeKey1: {{Key: []byte("key001")}},
+		},
+	)))
+	s.Require().NoError(db.ApplyChangeset(4, store.NewChangeset(
+		map[string]store.KVPairs{
+			storeKey1: {
+				{Key: []byte("key001"), Value: []byte("val001-4")},
+				{Key: []byte("key003")},
+			},
+			"store2": {{Key: []byte("key001")}},
+		},
+	)))
+}
+
 func (s *StorageTestSuite) TestDatabase_Prune() {
 	if slices.Contains(s.SkipTests, s.T().Name()) {
 		s.T().SkipNow()
@@ -498,6 +657,135 @@ func (s *StorageTestSuite) TestDatabase_Prune() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_PruneStep() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	pruner, ok := db.(store.IncrementalPruner)
+	s.Require().True(ok)
+
+	// for versions 1-50, set 10 keys and delete key009 at version 20
+	for v := uint64(1); v <= 50; v++ {
+		cs := store.NewChangeset(map[string]store.KVPairs{storeKey1: {}})
+		for i := 0; i < 10; i++ {
+			if i == 9 && v >= 20 {
+				if v == 20 {
+					cs.AddKVPair(storeKey1, store.KVPair{Key: []byte("key009")})
+				}
+				continue
+			}
+
+			key := fmt.Sprintf("key%03d", i)
+			val := fmt.Sprintf("val%03d-%03d", i, v)
+
+			cs.AddKVPair(storeKey1, store.KVPair{Key: []byte(key), Value: []byte(val)})
+		}
+
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+	}
+
+	// the first step marks the versions as pruned
+	cursor, err := pruner.PruneStep(25, nil, 7)
+	s.Require().NoError(err)
+
+	bz, err := db.Get(storeKey1, 25, []byte("key000"))
+	s.Require().Error(err)
+	s.Require().Nil(bz)
+
+	// prune the remaining entries in small steps, interleaved with writes
+	for v := uint64(51); cursor != nil; v++ {
+		cs := store.NewChangeset(map[string]store.KVPairs{storeKey1: {}})
+		cs.AddKVPair(storeKey1, store.KVPair{Key: []byte("key000"), Value: []byte(fmt.Sprintf("val000-%03d", v))})
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+
+		cursor, err = pruner.PruneStep(25, cursor, 7)
+		s.Require().NoError(err)
+	}
+
+	// ensure all keys are present after version 25
+	for v := uint64(26); v <= 50; v++ {
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key%03d", i)
+
+			bz, err := db.Get(storeKey1, v, []byte(key))
+			s.Require().NoError(err)
+
+			if i == 9 {
+				s.Require().Nil(bz)
+			} else {
+				s.Require().Equal([]byte(fmt.Sprintf("val%03d-%03d", i, v)), bz)
+			}
+		}
+	}
+
+	itr, err := db.Iterator(storeKey1, 26, nil, nil)
+	s.Require().NoError(err)
+	defer itr.Close()
+
+	var count int
+	for ; itr.Valid(); itr.Next() {
+		count++
+	}
+	s.Require().Equal(9, count)
+}
+
+func (s *StorageTestSuite) TestDatabase_PruneStep_BinaryKeys() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	pruner, ok := db.(store.IncrementalPruner)
+	s.Require().True(ok)
+
+	// keys whose last byte is small, s.t. it is not mistaken for the length of
+	// a version
+	keys := make([][]byte, 20)
+	for i := range keys {
+		keys[i] = []byte{'k', byte(i / 4), byte(i % 4)}
+	}
+
+	for v := uint64(1); v <= 3; v++ {
+		cs := store.NewChangeset(map[string]store.KVPairs{storeKey1: {}})
+		for i, key := range keys {
+			cs.AddKVPair(storeKey1, store.KVPair{Key: key, Value: []byte(fmt.Sprintf("val%03d-%03d", i, v))})
+		}
+
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+	}
+
+	cursor, err := pruner.PruneStep(2, nil, 3)
+	s.Require().NoError(err)
+
+	// every step makes progress, s.t. pruning completes
+	for steps := 0; cursor != nil; steps++ {
+		s.Require().Less(steps, len(keys)*3)
+
+		prev := cursor
+		cursor, err = pruner.PruneStep(2, cursor, 3)
+		s.Require().NoError(err)
+		s.Require().NotEqual(prev, cursor)
+	}
+
+	for i, key := range keys {
+		bz, err := db.Get(storeKey1, 3, key)
+		s.Require().NoError(err)
+		s.Require().Equal([]byte(fmt.Sprintf("val%03d-%03d", i, 3)), bz)
+
+		bz, err = db.Get(storeKey1, 1, key)
+		s.Require().Error(err)
+		s.Require().Nil(bz)
+	}
+}
+
 func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	if slices.Contains(s.SkipTests, s.T().Name()) {
 		s.T().SkipNow()
@@ -534,6 +822,21 @@ func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 
 	defer itr.Close()
 
+	// ensure diffs starting at a pruned version are rejected
+	_, err = db.DiffIterator(storeKey1, 50, 200, nil, nil)
+	s.Require().Error(err)
+
+	diffItr, err := db.DiffIterator(storeKey1, 51, 200, nil, nil)
+	s.Require().NoError(err)
+
+	var versions []uint64
+	for ; diffItr.Valid(); diffItr.Next() {
+		versions = append(versions, diffItr.Version())
+	}
+	s.Require().NoError(diffItr.Error())
+	s.Require().Equal([]uint64{100, 200}, versions)
+	diffItr.Close()
+
 	// ensure the value previously at version 1 is still there for queries greater than 50
 	bz, err = db.Get(storeKey1, 51, key)
 	s.Require().NoError(err)
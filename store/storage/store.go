@@ -14,6 +14,7 @@ const (
 
 var (
 	_ store.VersionedDatabase      = (*StorageStore)(nil)
+	_ store.IncrementalPruner      = (*StorageStore)(nil)
 	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
 )
 
@@ -83,11 +84,33 @@ func (ss *StorageStore) ReverseIterator(storeKey string, version uint64, start,
 	return ss.db.ReverseIterator(storeKey, version, start, end)
 }
 
+// DiffIterator returns an iterator over all writes to the specified domain within
+// the given version window.
+func (ss *StorageStore) DiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	return ss.db.DiffIterator(storeKey, startVersion, endVersion, start, end)
+}
+
+// ReverseDiffIterator returns an iterator over all writes to the specified domain
+// within the given version window in reverse.
+func (ss *StorageStore) ReverseDiffIterator(storeKey string, startVersion, endVersion uint64, start, end []byte) (store.DiffIterator, error) {
+	return ss.db.ReverseDiffIterator(storeKey, startVersion, endVersion, start, end)
+}
+
 // Prune prunes the store up to the given version.
 func (ss *StorageStore) Prune(version uint64) error {
 	return ss.db.Prune(version)
 }
 
+// PruneStep prunes the store up to the given version incrementally if the
+// underlying database supports it, otherwise it prunes the store at once.
+func (ss *StorageStore) PruneStep(version uint64, cursor []byte, limit int) ([]byte, error) {
+	if pruner, ok := ss.db.(store.IncrementalPruner); ok {
+		return pruner.PruneStep(version, cursor, limit)
+	}
+
+	return nil, ss.db.Prune(version)
+}
+
 // Restore restores the store from the given channel.
 func (ss *StorageStore) Restore(version uint64, chStorage <-chan *store.KVPair) error {
 	latestVersion, err := ss.db.GetLatestVersion()
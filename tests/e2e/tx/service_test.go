@@ -17,6 +17,7 @@ import (
 	authtest "cosmossdk.io/x/auth/client/testutil"
 	"cosmossdk.io/x/auth/migrations/legacytx"
 	authtx "cosmossdk.io/x/auth/tx"
+	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -201,6 +202,35 @@ func (s *E2ETestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s *E2ETestSuite) TestSimulateTx_GRPCStateDiff() {
+	val := s.network.GetValidators()[0]
+	txBytes, err := val.GetClientCtx().TxConfig.TxEncoder()(s.mkTxBuilder().GetTx())
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	s.Require().Nil(res.StateDiff)
+
+	res, err = s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes, StateDiff: true})
+	s.Require().NoError(err)
+	s.Require().Equal(10, len(res.GetResult().GetEvents())) // See TestSimulateTx_GRPC for the 10 events.
+
+	// the tx sends coins to its sender, so the fee is the only balance change
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	s.Require().ElementsMatch([]*tx.BalanceDelta{
+		{Address: val.GetAddress().String(), Denom: s.cfg.BondDenom, Amount: math.NewInt(-10)},
+		{Address: feeCollector, Denom: s.cfg.BondDenom, Amount: math.NewInt(10)},
+	}, res.StateDiff.BalanceDeltas)
+
+	// the balances and the sequence of the sender are written
+	storeKeys := make(map[string]bool)
+	for _, write := range res.StateDiff.Writes {
+		storeKeys[write.StoreKey] = true
+	}
+	s.Require().True(storeKeys[banktypes.StoreKey])
+	s.Require().True(storeKeys[authtypes.StoreKey])
+}
+
 func (s *E2ETestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.GetValidators()[0]
 	txBuilder := s.mkTxBuilder()
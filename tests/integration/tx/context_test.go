@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -57,6 +58,13 @@ func TestDefineCustomGetSigners(t *testing.T) {
 	require.Equal(t, [][]byte{[]byte("bar")}, signers)
 
 	// Reset and provider no CustomGetSigners. Consequently, validation will fail and depinject will return an error
+	// On error, depinject dumps the container to the working directory, which
+	// must not be the source tree.
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	t.Cleanup(func() { require.NoError(t, os.Chdir(wd)) })
+
 	_, err = simtestutil.SetupAtGenesis(
 		depinject.Configs(
 			configurator.NewAppConfig(
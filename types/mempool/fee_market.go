@@ -0,0 +1,467 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/huandu/skiplist"
+
+	"cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*FeeMarketMempool)(nil)
+	_ Iterator = (*feeMarketIterator)(nil)
+)
+
+type (
+	// FeeMarketMempoolConfig defines the configuration used to configure the
+	// FeeMarketMempool.
+	FeeMarketMempoolConfig struct {
+		// TxPriority defines the transaction priority and comparator. The priority
+		// is expected to reflect the fee paid by the transaction, e.g. its gas price.
+		TxPriority TxPriority[int64]
+
+		// MaxTx sets the maximum number of transactions allowed in the mempool with
+		// the semantics:
+		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
+		// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
+		//   and will evict the lowest priority transactions to make room for
+		//   transactions with a higher priority.
+		// - if MaxTx < 0, `Insert` is a no-op.
+		MaxTx int
+
+		// MaxTxPerSender sets the maximum number of transactions a single sender
+		// can have in the mempool. If MaxTxPerSender == 0, there is no cap.
+		MaxTxPerSender int
+
+		// MinReplacementBump is the minimum percentage by which the priority of a
+		// transaction must exceed the priority of the transaction with the same
+		// sender and nonce it replaces, e.g. 10 requires a 10% higher priority.
+		MinReplacementBump uint64
+
+		// TTL is the duration a transaction is kept in the mempool before it
+		// expires. If TTL == 0, transactions never expire.
+		TTL time.Duration
+
+		// Clock returns the current time, used to expire transactions. It defaults
+		// to time.Now.
+		Clock func() time.Time
+	}
+
+	// FeeMarketMempool is a mempool implementation meant for public chains, which
+	// orders txs by priority, and sender-nonce (sequence number) like the
+	// PriorityNonceMempool, and additionally:
+	//
+	// - evicts the lowest priority txs when it is full, instead of rejecting
+	//   txs with a higher priority;
+	// - replaces a tx with the same sender and nonce only if its priority is
+	//   bumped by at least MinReplacementBump percent (replace-by-fee);
+	// - limits the number of txs per sender;
+	// - expires txs which have been in the mempool for longer than TTL.
+	//
+	// Evicting or expiring a tx also removes the txs of the same sender with a
+	// higher nonce, which could not be included in a block anymore.
+	FeeMarketMempool struct {
+		mtx           sync.Mutex
+		priorityIndex *skiplist.SkipList
+		expiryIndex   *skiplist.SkipList
+		senderIndices map[string]*skiplist.SkipList
+		cfg           FeeMarketMempoolConfig
+	}
+
+	// feeMarketTx is a tx stored in the FeeMarketMempool.
+	feeMarketTx struct {
+		tx       sdk.Tx
+		sender   string
+		nonce    uint64
+		priority int64
+		expiry   time.Time
+	}
+
+	// feeMarketKey is the key of a tx in the priority index.
+	feeMarketKey struct {
+		priority int64
+		sender   string
+		nonce    uint64
+	}
+
+	// expiryKey is the key of a tx in the expiry index.
+	expiryKey struct {
+		expiry int64
+		sender string
+		nonce  uint64
+	}
+)
+
+// DefaultFeeMarketMempoolConfig returns the default FeeMarketMempool
+// configuration, using ctx.Priority as the transaction priority, requiring a
+// 10% priority bump for replacements and expiring txs after an hour.
+func DefaultFeeMarketMempoolConfig() FeeMarketMempoolConfig {
+	return FeeMarketMempoolConfig{
+		TxPriority:         NewDefaultTxPriority(),
+		MaxTx:              5000,
+		MaxTxPerSender:     64,
+		MinReplacementBump: 10,
+		TTL:                time.Hour,
+	}
+}
+
+// NewFeeMarketMempool returns a new FeeMarketMempool with the given configuration.
+func NewFeeMarketMempool(cfg FeeMarketMempoolConfig) *FeeMarketMempool {
+	if cfg.Clock == nil {
+		cfg.Clock = time.Now
+	}
+
+	return &FeeMarketMempool{
+		priorityIndex: skiplist.New(skiplist.GreaterThanFunc(func(a, b any) int {
+			keyA, keyB := a.(feeMarketKey), b.(feeMarketKey)
+			if res := cfg.TxPriority.Compare(keyA.priority, keyB.priority); res != 0 {
+				return res
+			}
+			if res := skiplist.String.Compare(keyA.sender, keyB.sender); res != 0 {
+				return res
+			}
+			return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+		})),
+		expiryIndex: skiplist.New(skiplist.GreaterThanFunc(func(a, b any) int {
+			keyA, keyB := a.(expiryKey), b.(expiryKey)
+			if res := skiplist.Int64.Compare(keyA.expiry, keyB.expiry); res != 0 {
+				return res
+			}
+			if res := skiplist.String.Compare(keyA.sender, keyB.sender); res != 0 {
+				return res
+			}
+			return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+		})),
+		senderIndices: make(map[string]*skiplist.SkipList),
+		cfg:           cfg,
+	}
+}
+
+// DefaultFeeMarketMempool returns a FeeMarketMempool with the default configuration.
+func DefaultFeeMarketMempool() *FeeMarketMempool {
+	return NewFeeMarketMempool(DefaultFeeMarketMempoolConfig())
+}
+
+// Insert attempts to insert a Tx into the mempool in O(log n) time, returning
+// an error if unsuccessful. Sender and nonce are derived from the transaction's
+// first signature.
+//
+// A tx with the same sender and nonce as an existing tx replaces it only if its
+// priority is bumped by at least MinReplacementBump percent. When the mempool
+// is full, the lowest priority tx is evicted if the inserted tx has a higher
+// priority, otherwise ErrMempoolTxMaxCapacity is returned.
+func (mp *FeeMarketMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	sender, nonce, err := feeMarketSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
+	now := mp.cfg.Clock()
+	mp.removeExpired(now)
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if ok {
+		if elem := senderIndex.Get(nonce); elem != nil {
+			old := elem.Value.(*feeMarketTx)
+			if !mp.canReplace(old.priority, priority) {
+				return fmt.Errorf(
+					"%w: oldPriority: %d, newPriority: %d, min bump: %d%%",
+					ErrTxReplacementUnderpriced, old.priority, priority, mp.cfg.MinReplacementBump,
+				)
+			}
+
+			mp.remove(old)
+			mp.insert(&feeMarketTx{tx: tx, sender: sender, nonce: nonce, priority: priority, expiry: mp.expiry(now)})
+			return nil
+		}
+
+		if mp.cfg.MaxTxPerSender > 0 && senderIndex.Len() >= mp.cfg.MaxTxPerSender {
+			return fmt.Errorf("%w: sender %s has %d txs", ErrSenderTxLimit, sender, senderIndex.Len())
+		}
+	}
+
+	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+		lowest := mp.priorityIndex.Front().Value.(*feeMarketTx)
+		// evicting a tx of the same sender with a lower nonce would leave the
+		// inserted tx with a nonce gap.
+		if mp.cfg.TxPriority.Compare(priority, lowest.priority) <= 0 ||
+			(lowest.sender == sender && lowest.nonce < nonce) {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		mp.removeFrom(lowest)
+	}
+
+	mp.insert(&feeMarketTx{tx: tx, sender: sender, nonce: nonce, priority: priority, expiry: mp.expiry(now)})
+	return nil
+}
+
+// Select returns a set of transactions from the mempool, ordered by priority
+// and sender-nonce: the next tx is always the highest priority tx among the
+// lowest nonce txs of each sender. Expired txs are removed before selecting.
+// The passed in list of transactions are ignored.
+//
+// The returned iterator works on a snapshot of the mempool, so it is safe to
+// remove transactions from the mempool while iterating.
+func (mp *FeeMarketMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.removeExpired(mp.cfg.Clock())
+	if mp.priorityIndex.Len() == 0 {
+		return nil
+	}
+
+	iterator := &feeMarketIterator{txPriority: mp.cfg.TxPriority}
+	for _, senderIndex := range mp.senderIndices {
+		txs := make([]*feeMarketTx, 0, senderIndex.Len())
+		for elem := senderIndex.Front(); elem != nil; elem = elem.Next() {
+			txs = append(txs, elem.Value.(*feeMarketTx))
+		}
+		iterator.senders = append(iterator.senders, txs)
+	}
+	heap.Init(iterator)
+
+	return iterator
+}
+
+// CountTx returns the number of transactions in the mempool, including the
+// expired ones which have not been removed yet.
+func (mp *FeeMarketMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.priorityIndex.Len()
+}
+
+// Remove removes a transaction from the mempool in O(log n) time, returning an
+// error if unsuccessful. Unlike evictions, removing a tx does not remove the txs
+// of the same sender with a higher nonce, as the tx is usually removed because
+// it has been included in a block.
+func (mp *FeeMarketMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	sender, nonce, err := feeMarketSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	elem := senderIndex.Get(nonce)
+	if elem == nil {
+		return ErrTxNotFound
+	}
+
+	mp.remove(elem.Value.(*feeMarketTx))
+	return nil
+}
+
+// RemoveExpired removes the transactions which have been in the mempool for
+// longer than TTL, returning the number of removed transactions.
+func (mp *FeeMarketMempool) RemoveExpired() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.removeExpired(mp.cfg.Clock())
+}
+
+// NextSenderTx returns the next transaction for a given sender by nonce order,
+// i.e. the next valid transaction for the sender. If no such transaction exists,
+// nil will be returned.
+func (mp *FeeMarketMempool) NextSenderTx(sender string) sdk.Tx {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return nil
+	}
+
+	return senderIndex.Front().Value.(*feeMarketTx).tx
+}
+
+// canReplace returns true if newPriority is bumped by at least
+// MinReplacementBump percent of |oldPriority| over oldPriority. The bump is
+// applied in the direction of the higher priorities of the TxPriority
+// comparator, s.t. comparators which invert the numeric order are supported.
+func (mp *FeeMarketMempool) canReplace(oldPriority, newPriority int64) bool {
+	// ceil(|old| * bump / 100), computed with big ints to not overflow
+	bump := new(big.Int).Abs(big.NewInt(oldPriority))
+	bump.Mul(bump, new(big.Int).SetUint64(mp.cfg.MinReplacementBump))
+	bump.Add(bump, big.NewInt(99))
+	bump.Quo(bump, big.NewInt(100))
+
+	// the required priority is the bumped priority ordered higher by the comparator
+	up := clampInt64(new(big.Int).Add(big.NewInt(oldPriority), bump))
+	down := clampInt64(new(big.Int).Sub(big.NewInt(oldPriority), bump))
+	required := up
+	if mp.cfg.TxPriority.Compare(down, up) > 0 {
+		required = down
+	}
+
+	return mp.cfg.TxPriority.Compare(newPriority, required) >= 0
+}
+
+// clampInt64 returns x clamped to the range of int64.
+func clampInt64(x *big.Int) int64 {
+	switch {
+	case x.IsInt64():
+		return x.Int64()
+	case x.Sign() > 0:
+		return math.MaxInt64
+	default:
+		return math.MinInt64
+	}
+}
+
+func (mp *FeeMarketMempool) expiry(now time.Time) time.Time {
+	if mp.cfg.TTL <= 0 {
+		return time.Time{}
+	}
+	return now.Add(mp.cfg.TTL)
+}
+
+func (mp *FeeMarketMempool) insert(mtx *feeMarketTx) {
+	senderIndex, ok := mp.senderIndices[mtx.sender]
+	if !ok {
+		senderIndex = skiplist.New(skiplist.Uint64)
+		mp.senderIndices[mtx.sender] = senderIndex
+	}
+
+	senderIndex.Set(mtx.nonce, mtx)
+	mp.priorityIndex.Set(feeMarketKey{priority: mtx.priority, sender: mtx.sender, nonce: mtx.nonce}, mtx)
+	if !mtx.expiry.IsZero() {
+		mp.expiryIndex.Set(expiryKey{expiry: mtx.expiry.UnixNano(), sender: mtx.sender, nonce: mtx.nonce}, mtx)
+	}
+}
+
+func (mp *FeeMarketMempool) remove(mtx *feeMarketTx) {
+	senderIndex := mp.senderIndices[mtx.sender]
+	senderIndex.Remove(mtx.nonce)
+	if senderIndex.Len() == 0 {
+		delete(mp.senderIndices, mtx.sender)
+	}
+
+	mp.priorityIndex.Remove(feeMarketKey{priority: mtx.priority, sender: mtx.sender, nonce: mtx.nonce})
+	if !mtx.expiry.IsZero() {
+		mp.expiryIndex.Remove(expiryKey{expiry: mtx.expiry.UnixNano(), sender: mtx.sender, nonce: mtx.nonce})
+	}
+}
+
+// removeFrom removes the given tx and the txs of the same sender with a higher
+// nonce, returning the number of removed txs.
+func (mp *FeeMarketMempool) removeFrom(mtx *feeMarketTx) int {
+	senderIndex := mp.senderIndices[mtx.sender]
+
+	var removed []*feeMarketTx
+	for elem := senderIndex.Find(mtx.nonce); elem != nil; elem = elem.Next() {
+		removed = append(removed, elem.Value.(*feeMarketTx))
+	}
+
+	for _, r := range removed {
+		mp.remove(r)
+	}
+
+	return len(removed)
+}
+
+// removeExpired removes the txs expired at the given time, returning the number
+// of removed txs.
+func (mp *FeeMarketMempool) removeExpired(now time.Time) int {
+	count := 0
+	for {
+		front := mp.expiryIndex.Front()
+		if front == nil {
+			return count
+		}
+
+		mtx := front.Value.(*feeMarketTx)
+		if mtx.expiry.After(now) {
+			return count
+		}
+
+		count += mp.removeFrom(mtx)
+	}
+}
+
+// feeMarketSenderNonce returns the sender and nonce of a tx, derived from its
+// first signature.
+func feeMarketSenderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+	if len(sigs) == 0 {
+		return "", 0, fmt.Errorf("tx must have at least one signer")
+	}
+
+	sig := sigs[0]
+	nonce, err := txNonce(tx, sig)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return sdk.AccAddress(sig.PubKey.Address()).String(), nonce, nil
+}
+
+// feeMarketIterator is the iterator returned by FeeMarketMempool.Select. It is a
+// max heap of the nonce ordered txs of each sender, ordered by the priority of
+// their lowest nonce tx.
+type feeMarketIterator struct {
+	txPriority TxPriority[int64]
+	senders    [][]*feeMarketTx
+}
+
+func (i *feeMarketIterator) Len() int { return len(i.senders) }
+
+func (i *feeMarketIterator) Less(a, b int) bool {
+	txA, txB := i.senders[a][0], i.senders[b][0]
+	if res := i.txPriority.Compare(txA.priority, txB.priority); res != 0 {
+		return res > 0
+	}
+	return txA.sender < txB.sender
+}
+
+func (i *feeMarketIterator) Swap(a, b int) { i.senders[a], i.senders[b] = i.senders[b], i.senders[a] }
+
+func (i *feeMarketIterator) Push(x any) { i.senders = append(i.senders, x.([]*feeMarketTx)) }
+
+func (i *feeMarketIterator) Pop() any {
+	last := i.senders[len(i.senders)-1]
+	i.senders = i.senders[:len(i.senders)-1]
+	return last
+}
+
+func (i *feeMarketIterator) Next() Iterator {
+	if len(i.senders[0]) == 1 {
+		heap.Pop(i)
+	} else {
+		i.senders[0] = i.senders[0][1:]
+		heap.Fix(i, 0)
+	}
+
+	if len(i.senders) == 0 {
+		return nil
+	}
+	return i
+}
+
+func (i *feeMarketIterator) Tx() sdk.Tx {
+	return i.senders[0][0].tx
+}
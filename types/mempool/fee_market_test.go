@@ -0,0 +1,215 @@
+package mempool_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/huandu/skiplist"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func newTestFeeMarketMempool(maxTx, maxTxPerSender int, ttl time.Duration, now *time.Time) *mempool.FeeMarketMempool {
+	return mempool.NewFeeMarketMempool(mempool.FeeMarketMempoolConfig{
+		TxPriority:         mempool.NewDefaultTxPriority(),
+		MaxTx:              maxTx,
+		MaxTxPerSender:     maxTxPerSender,
+		MinReplacementBump: 10,
+		TTL:                ttl,
+		Clock:              func() time.Time { return *now },
+	})
+}
+
+func insertTestTxs(t *testing.T, mp mempool.Mempool, txs ...testTx) {
+	t.Helper()
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+}
+
+func TestFeeMarketMempool_Order(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+	now := time.Now()
+	mp := newTestFeeMarketMempool(0, 0, 0, &now)
+
+	txs := []testTx{
+		{id: 0, priority: 21, nonce: 4, address: sa},
+		{id: 1, priority: 8, nonce: 3, address: sa},
+		{id: 2, priority: 6, nonce: 2, address: sa},
+		{id: 3, priority: 15, nonce: 1, address: sb},
+		{id: 4, priority: 20, nonce: 1, address: sa},
+		{id: 5, priority: 7, nonce: 1, address: sc},
+	}
+	insertTestTxs(t, mp, txs...)
+	require.Equal(t, 6, mp.CountTx())
+
+	orderedTxs := fetchTxs(mp.Select(sdk.Context{}, nil), 1000)
+	var ids []int
+	for _, tx := range orderedTxs {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{4, 3, 5, 2, 1, 0}, ids)
+	require.NoError(t, validateOrder(orderedTxs))
+
+	// removing while iterating is safe
+	for iter := mp.Select(sdk.Context{}, nil); iter != nil; iter = iter.Next() {
+		require.NoError(t, mp.Remove(iter.Tx()))
+	}
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(sdk.Context{}, nil))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+}
+
+func TestFeeMarketMempool_Eviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	now := time.Now()
+	mp := newTestFeeMarketMempool(3, 0, 0, &now)
+
+	insertTestTxs(t, mp,
+		testTx{priority: 10, nonce: 1, address: sa},
+		testTx{priority: 20, nonce: 2, address: sa},
+		testTx{priority: 15, nonce: 1, address: sb},
+	)
+
+	// a tx with a lower or equal priority than the lowest one is rejected
+	tx := testTx{priority: 10, nonce: 1, address: sc}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrMempoolTxMaxCapacity)
+
+	// a tx which would be left with a nonce gap is rejected
+	tx = testTx{priority: 30, nonce: 3, address: sa}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+
+	// the lowest priority tx is evicted, together with the higher nonce txs
+	// of the same sender
+	tx = testTx{priority: 11, nonce: 1, address: sc}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.Equal(t, 2, mp.CountTx())
+	require.Nil(t, mp.NextSenderTx(sa.String()))
+	require.Equal(t, tx, mp.NextSenderTx(sc.String()))
+
+	// disabled
+	mp = newTestFeeMarketMempool(-1, 0, 0, &now)
+	insertTestTxs(t, mp, tx)
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestFeeMarketMempool_Replacement(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	now := time.Now()
+	mp := newTestFeeMarketMempool(2, 0, 0, &now)
+
+	txs := []testTx{
+		{priority: 100, nonce: 1, address: sa},
+		{priority: 90, nonce: 1, address: sa},  // priority is lower
+		{priority: 109, nonce: 1, address: sa}, // priority is not bumped by 10%
+		{priority: 110, nonce: 1, address: sa}, // priority is bumped by 10%
+	}
+	insertTestTxs(t, mp, txs[0], testTx{priority: 1, nonce: 1, address: sb})
+
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[1].priority), txs[1]), mempool.ErrTxReplacementUnderpriced)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[2].priority), txs[2]), mempool.ErrTxReplacementUnderpriced)
+	require.Equal(t, txs[0], mp.NextSenderTx(sa.String()))
+
+	// replacing a tx in a full mempool does not evict any tx
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[3].priority), txs[3]))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, txs[3], mp.NextSenderTx(sa.String()))
+
+	iter := mp.Select(ctx, nil)
+	require.Equal(t, txs[3], iter.Tx())
+}
+
+func TestFeeMarketMempool_ReplacementInvertedPriority(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	sa := accounts[0].Address
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	now := time.Now()
+
+	// lower numbers are higher priorities
+	txPriority := mempool.NewDefaultTxPriority()
+	txPriority.Compare = func(a, b int64) int { return -skiplist.Int64.Compare(a, b) }
+	txPriority.MinValue = math.MaxInt64
+	mp := mempool.NewFeeMarketMempool(mempool.FeeMarketMempoolConfig{
+		TxPriority:         txPriority,
+		MinReplacementBump: 10,
+		Clock:              func() time.Time { return now },
+	})
+
+	txs := []testTx{
+		{priority: 100, nonce: 1, address: sa},
+		{priority: 110, nonce: 1, address: sa}, // priority is lower
+		{priority: 91, nonce: 1, address: sa},  // priority is not bumped by 10%
+		{priority: 90, nonce: 1, address: sa},  // priority is bumped by 10%
+	}
+	insertTestTxs(t, mp, txs[0])
+
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[1].priority), txs[1]), mempool.ErrTxReplacementUnderpriced)
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[2].priority), txs[2]), mempool.ErrTxReplacementUnderpriced)
+	require.Equal(t, txs[0], mp.NextSenderTx(sa.String()))
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[3].priority), txs[3]))
+	require.Equal(t, txs[3], mp.NextSenderTx(sa.String()))
+}
+
+func TestFeeMarketMempool_SenderLimit(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	now := time.Now()
+	mp := newTestFeeMarketMempool(0, 2, 0, &now)
+
+	insertTestTxs(t, mp,
+		testTx{priority: 10, nonce: 1, address: sa},
+		testTx{priority: 10, nonce: 2, address: sa},
+		testTx{priority: 10, nonce: 1, address: sb},
+	)
+
+	tx := testTx{priority: 100, nonce: 3, address: sa}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrSenderTxLimit)
+
+	// replacements are not limited
+	tx = testTx{priority: 100, nonce: 2, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestFeeMarketMempool_Expiry(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	now := time.Now()
+	mp := newTestFeeMarketMempool(0, 0, time.Minute, &now)
+
+	insertTestTxs(t, mp, testTx{priority: 10, nonce: 1, address: sa})
+	now = now.Add(30 * time.Second)
+	insertTestTxs(t, mp,
+		testTx{priority: 10, nonce: 2, address: sa},
+		testTx{priority: 10, nonce: 1, address: sb},
+	)
+
+	require.Equal(t, 0, mp.RemoveExpired())
+	require.Equal(t, 3, mp.CountTx())
+
+	// the first tx expires, together with the higher nonce txs of the sender
+	now = now.Add(30 * time.Second)
+	require.Equal(t, 2, mp.RemoveExpired())
+	require.Equal(t, 1, mp.CountTx())
+
+	// expired txs are not selected
+	now = now.Add(30 * time.Second)
+	require.Nil(t, mp.Select(sdk.Context{}, nil))
+	require.Equal(t, 0, mp.CountTx())
+}
@@ -0,0 +1,168 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// ErrNoLaneMatch is returned when a tx does not match any lane of a LaneMempool.
+var ErrNoLaneMatch = errors.New("tx does not match any lane")
+
+// Lane defines a partition of the block space, with its own mempool, which holds
+// the transactions matching the lane.
+type Lane struct {
+	// Name is the unique name of the lane, e.g. "oracle".
+	Name string
+
+	// Mempool is the mempool of the lane.
+	Mempool Mempool
+
+	// Match returns true if the tx belongs to the lane. A tx belongs to the first
+	// lane it matches, and a lane with a nil Match matches every tx, which makes
+	// it suitable as the last, default, lane. Match must be deterministic, as it
+	// is used to verify the proposals in ProcessProposal.
+	Match func(ctx context.Context, tx sdk.Tx) bool
+
+	// MaxBlockSpace is the maximum share of the block bytes and gas that the
+	// transactions of the lane can use, in the range (0, 1].
+	MaxBlockSpace math.LegacyDec
+}
+
+// LaneMempool is a mempool which partitions the transactions in lanes. Each
+// lane has its own mempool and maximum share of the block space, which allows
+// to guarantee the inclusion of priority transactions, e.g. oracle or governance
+// transactions, regardless of the other transactions in the mempool.
+//
+// Selecting from the LaneMempool returns the transactions of each lane, in lane
+// order. The block space limits of the lanes are enforced by the
+// baseapp.LaneProposalHandler.
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool returns a new LaneMempool with the given lanes, in priority
+// order. It returns an error if the lane names are not unique or if their max
+// block space is not in the range (0, 1].
+func NewLaneMempool(lanes ...Lane) (*LaneMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if names[lane.Name] {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = true
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+		if lane.MaxBlockSpace.IsNil() || !lane.MaxBlockSpace.IsPositive() || lane.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("lane %s max block space must be in the range (0, 1], got %v", lane.Name, lane.MaxBlockSpace)
+		}
+	}
+
+	return &LaneMempool{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool, in priority order.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the lane the tx belongs to, or ErrNoLaneMatch
+// if the tx does not match any lane.
+func (mp *LaneMempool) LaneIndex(ctx context.Context, tx sdk.Tx) (int, error) {
+	for i, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(ctx, tx) {
+			return i, nil
+		}
+	}
+
+	return 0, ErrNoLaneMatch
+}
+
+// Insert inserts the tx in the mempool of the lane it belongs to.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i, err := mp.LaneIndex(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of every lane, in lane order.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iterator := &laneIterator{ctx: ctx, txs: txs, lanes: mp.lanes, lane: -1}
+	return iterator.nextLane()
+}
+
+// CountTx returns the number of transactions in the mempools of all the lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the tx from the mempool of the lane which holds it.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	for _, lane := range mp.lanes {
+		err := lane.Mempool.Remove(tx)
+		if !errors.Is(err, ErrTxNotFound) {
+			return err
+		}
+	}
+
+	return ErrTxNotFound
+}
+
+// laneIterator iterates over the mempools of the lanes, in lane order.
+type laneIterator struct {
+	ctx   context.Context
+	txs   [][]byte
+	lanes []Lane
+	lane  int
+	iter  Iterator
+}
+
+func (i *laneIterator) nextLane() Iterator {
+	for i.lane++; i.lane < len(i.lanes); i.lane++ {
+		i.iter = i.lanes[i.lane].Mempool.Select(i.ctx, i.txs)
+		if i.iter != nil {
+			return i
+		}
+	}
+
+	return nil
+}
+
+func (i *laneIterator) Next() Iterator {
+	i.iter = i.iter.Next()
+	if i.iter == nil {
+		return i.nextLane()
+	}
+
+	return i
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
@@ -0,0 +1,87 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestNewLaneMempool(t *testing.T) {
+	half := math.LegacyNewDecWithPrec(5, 1)
+
+	_, err := mempool.NewLaneMempool()
+	require.Error(t, err)
+
+	_, err = mempool.NewLaneMempool(
+		mempool.Lane{Name: "a", Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: half},
+		mempool.Lane{Name: "a", Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: half},
+	)
+	require.ErrorContains(t, err, "duplicate lane a")
+
+	_, err = mempool.NewLaneMempool(mempool.Lane{Name: "a", MaxBlockSpace: half})
+	require.ErrorContains(t, err, "no mempool")
+
+	for _, space := range []math.LegacyDec{{}, math.LegacyZeroDec(), math.LegacyNewDec(2)} {
+		_, err = mempool.NewLaneMempool(mempool.Lane{Name: "a", Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: space})
+		require.ErrorContains(t, err, "max block space")
+	}
+}
+
+func TestLaneMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	oracle := mempool.Lane{
+		Name:    "oracle",
+		Mempool: mempool.DefaultPriorityMempool(),
+		Match: func(_ context.Context, tx sdk.Tx) bool {
+			return tx.(testTx).address.Equals(sa)
+		},
+		MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1),
+	}
+	mp, err := mempool.NewLaneMempool(oracle, mempool.Lane{
+		Name:          "default",
+		Mempool:       mempool.DefaultPriorityMempool(),
+		MaxBlockSpace: math.LegacyOneDec(),
+	})
+	require.NoError(t, err)
+	require.Len(t, mp.Lanes(), 2)
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 1, address: sb},
+		{id: 1, nonce: 1, address: sa},
+		{id: 2, priority: 20, nonce: 2, address: sb},
+		{id: 3, nonce: 2, address: sa},
+	}
+	insertTestTxs(t, mp, txs...)
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, oracle.Mempool.CountTx())
+
+	laneIdx, err := mp.LaneIndex(context.Background(), txs[1])
+	require.NoError(t, err)
+	require.Equal(t, 0, laneIdx)
+
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(context.Background(), nil), 1000) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{1, 3, 0, 2}, ids)
+
+	require.NoError(t, mp.Remove(txs[3]))
+	require.NoError(t, mp.Remove(txs[0]))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.Equal(t, 2, mp.CountTx())
+
+	// without a default lane, txs which do not match any lane are rejected
+	mp, err = mempool.NewLaneMempool(oracle)
+	require.NoError(t, err)
+	require.ErrorIs(t, mp.Insert(context.Background(), txs[0]), mempool.ErrNoLaneMatch)
+}
@@ -3,8 +3,10 @@ package mempool
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 type Mempool interface {
@@ -38,6 +40,24 @@ type Iterator interface {
 }
 
 var (
-	ErrTxNotFound           = errors.New("tx not found in mempool")
-	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrTxNotFound               = errors.New("tx not found in mempool")
+	ErrMempoolTxMaxCapacity     = errors.New("pool reached max tx capacity")
+	ErrSenderTxLimit            = errors.New("sender reached max tx limit")
+	ErrTxReplacementUnderpriced = errors.New("tx replacement underpriced")
 )
+
+// txNonce returns the nonce of a tx in the mempool: the sequence of its first
+// signature, or the timeout timestamp in nanoseconds of an unordered tx, whose
+// sequence is not checked.
+func txNonce(tx sdk.Tx, sig signing.SignatureV2) (uint64, error) {
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		timeout := unorderedTx.GetTimeoutTimestamp().UnixNano()
+		if timeout < 0 {
+			return 0, fmt.Errorf("invalid timeout timestamp of unordered tx: %d", timeout)
+		}
+
+		return uint64(timeout), nil
+	}
+
+	return sig.Sequence, nil
+}
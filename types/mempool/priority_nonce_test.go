@@ -804,3 +804,38 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+type unorderedTx struct {
+	testTx
+	timeout time.Time
+}
+
+func (tx unorderedTx) GetUnordered() bool { return true }
+
+func (tx unorderedTx) GetTimeoutTimestamp() time.Time { return tx.timeout }
+
+func TestPriorityNonceMempool_UnorderedTx(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	sa := accounts[0].Address
+	now := time.Unix(1_000_000, 0).UTC()
+
+	mp := mempool.DefaultPriorityMempool()
+
+	// unordered txs of a sender share its sequence, and are ordered by timeout
+	tx1 := unorderedTx{testTx{id: 1, priority: 10, nonce: 0, address: sa}, now.Add(2 * time.Minute)}
+	tx2 := unorderedTx{testTx{id: 2, priority: 10, nonce: 0, address: sa}, now.Add(time.Minute)}
+	require.NoError(t, mp.Insert(ctx, tx1))
+	require.NoError(t, mp.Insert(ctx, tx2))
+	require.Equal(t, 2, mp.CountTx())
+
+	var ids []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		ids = append(ids, iter.Tx().(unorderedTx).id)
+	}
+	require.Equal(t, []int{2, 1}, ids)
+
+	require.NoError(t, mp.Remove(tx1))
+	require.NoError(t, mp.Remove(tx2))
+	require.Equal(t, 0, mp.CountTx())
+}
@@ -72,6 +72,14 @@ const (
 	// SIGN_MODE_EIP_191_LEGACY_JSON, and more.
 	// Each new EIP191 sign mode should be accompanied by an associated ADR.
 	SignMode_SIGN_MODE_EIP_191 SignMode = 191 // Deprecated: Do not use.
+	// SIGN_MODE_EIP_712 specifies the sign mode for EIP-712 signing on the Cosmos
+	// SDK, which lets Ethereum wallets sign txs as human-readable typed
+	// structured data. Ref: https://eips.ethereum.org/EIPS/eip-712
+	//
+	// Signatures are verified against eth_secp256k1 keys. The sign mode is
+	// implemented by the x/tx/signing/eip712 package, and is not enabled by
+	// default.
+	SignMode_SIGN_MODE_EIP_712 SignMode = 712
 )
 
 var SignMode_name = map[int32]string{
@@ -81,6 +89,7 @@ var SignMode_name = map[int32]string{
 	3:   "SIGN_MODE_DIRECT_AUX",
 	127: "SIGN_MODE_LEGACY_AMINO_JSON",
 	191: "SIGN_MODE_EIP_191",
+	712: "SIGN_MODE_EIP_712",
 }
 
 var SignMode_value = map[string]int32{
@@ -90,6 +99,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_DIRECT_AUX":        3,
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 	"SIGN_MODE_EIP_191":           191,
+	"SIGN_MODE_EIP_712":           712,
 }
 
 func (x SignMode) String() string {
@@ -427,43 +437,44 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xb3, 0x49, 0x5a, 0xa5, 0x53, 0x84, 0xcc, 0x92, 0x4a, 0xa9, 0x41, 0xa6, 0x2a, 0x07,
-	0x2a, 0xa4, 0xae, 0x95, 0xf6, 0x80, 0xca, 0xcd, 0x4d, 0x4c, 0x6a, 0xda, 0xa4, 0xc5, 0x4e, 0xa5,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0xf9, 0x53, 0xa5, 0x53, 0x84, 0xcc, 0x92, 0xa2, 0xd4, 0xa0, 0x10, 0x95, 0x03,
+	0x15, 0x52, 0xd7, 0x4a, 0x7a, 0xa8, 0xca, 0x2d, 0x4d, 0x4c, 0x1a, 0xda, 0xa4, 0xc5, 0x4e, 0xa5,
 	0xc2, 0xc5, 0xb2, 0x9d, 0xad, 0xb1, 0x1a, 0x7b, 0x8d, 0x77, 0x8d, 0xea, 0x13, 0xaf, 0xc0, 0x6b,
-	0xf4, 0x29, 0x38, 0x70, 0xe1, 0xd8, 0x23, 0x47, 0xd4, 0x3e, 0x03, 0x77, 0x54, 0x3b, 0x4e, 0x02,
-	0x2a, 0x42, 0xf4, 0x64, 0xcd, 0xcc, 0xbf, 0xdf, 0xfc, 0xab, 0x99, 0x35, 0x3c, 0xf3, 0x18, 0x0f,
-	0x19, 0x57, 0xc5, 0xb9, 0xca, 0x03, 0x3f, 0x0a, 0x22, 0x5f, 0xfd, 0xd8, 0x76, 0xa9, 0x70, 0xda,
-	0x65, 0x4c, 0xe2, 0x84, 0x09, 0x86, 0x57, 0x0b, 0x21, 0x11, 0xe7, 0xa4, 0x2c, 0x4c, 0x84, 0xf2,
-	0xe6, 0x84, 0xe1, 0x25, 0x59, 0x2c, 0x98, 0x1a, 0xa6, 0x63, 0x11, 0xf0, 0x60, 0x06, 0x2a, 0x13,
-	0x05, 0x49, 0x5e, 0xf5, 0x19, 0xf3, 0xc7, 0x54, 0xcd, 0x23, 0x37, 0x3d, 0x55, 0x9d, 0x28, 0x2b,
-	0x4a, 0xeb, 0xa7, 0xd0, 0xb4, 0x02, 0x3f, 0x72, 0x44, 0x9a, 0xd0, 0x2e, 0xe5, 0x5e, 0x12, 0xc4,
-	0x82, 0x25, 0x1c, 0x0f, 0x00, 0x78, 0x99, 0xe7, 0x2d, 0xb4, 0x56, 0xdb, 0x58, 0xde, 0x22, 0xe4,
-	0xaf, 0x8e, 0xc8, 0x2d, 0x10, 0x73, 0x8e, 0xb0, 0xfe, 0xb3, 0x0e, 0x0f, 0x6f, 0xd1, 0xe0, 0x6d,
-	0x80, 0x38, 0x75, 0xc7, 0x81, 0x67, 0x9f, 0xd1, 0xac, 0x85, 0xd6, 0xd0, 0xc6, 0xf2, 0x56, 0x93,
-	0x14, 0x7e, 0x49, 0xe9, 0x97, 0x68, 0x51, 0x66, 0x2e, 0x15, 0xba, 0x7d, 0x9a, 0xe1, 0x1e, 0xd4,
-	0x47, 0x8e, 0x70, 0x5a, 0xd5, 0x5c, 0xbe, 0xfd, 0x7f, 0xb6, 0x48, 0xd7, 0x11, 0x8e, 0x99, 0x03,
-	0xb0, 0x0c, 0x0d, 0x4e, 0x3f, 0xa4, 0x34, 0xf2, 0x68, 0xab, 0xb6, 0x86, 0x36, 0xea, 0xe6, 0x34,
-	0x96, 0xbf, 0xd6, 0xa0, 0x7e, 0x23, 0xc5, 0x43, 0x58, 0xe4, 0x41, 0xe4, 0x8f, 0xe9, 0xc4, 0xde,
-	0xcb, 0x3b, 0xf4, 0x23, 0x56, 0x4e, 0xd8, 0xab, 0x98, 0x13, 0x16, 0x7e, 0x03, 0x0b, 0xf9, 0x94,
-	0x26, 0x97, 0xd8, 0xb9, 0x0b, 0xb4, 0x7f, 0x03, 0xd8, 0xab, 0x98, 0x05, 0x49, 0xb6, 0x61, 0xb1,
-	0x68, 0x83, 0x5f, 0x40, 0x3d, 0x64, 0xa3, 0xc2, 0xf0, 0xfd, 0xad, 0xa7, 0xff, 0x60, 0xf7, 0xd9,
-	0x88, 0x9a, 0xf9, 0x01, 0xfc, 0x18, 0x96, 0xa6, 0x43, 0xcb, 0x9d, 0xdd, 0x33, 0x67, 0x09, 0xf9,
-	0x02, 0xc1, 0x42, 0xde, 0x13, 0xef, 0x43, 0xc3, 0x0d, 0x84, 0x93, 0x24, 0x4e, 0x39, 0x34, 0xb5,
-	0x6c, 0x52, 0xec, 0x24, 0x99, 0xae, 0x60, 0xd9, 0xa9, 0xc3, 0xc2, 0xd8, 0xf1, 0xc4, 0x6e, 0x20,
-	0xb4, 0x9b, 0x63, 0xe6, 0x14, 0x80, 0xad, 0xdf, 0x76, 0xad, 0x9a, 0xef, 0xda, 0x9d, 0x86, 0x3a,
-	0x87, 0xd9, 0x5d, 0x80, 0x1a, 0x4f, 0xc3, 0xe7, 0x17, 0x08, 0x1a, 0xe5, 0x1d, 0xf1, 0x2a, 0xac,
-	0x58, 0x46, 0x6f, 0x60, 0xf7, 0x0f, 0xbb, 0xba, 0x7d, 0x3c, 0xb0, 0x8e, 0xf4, 0x8e, 0xf1, 0xca,
-	0xd0, 0xbb, 0x52, 0x05, 0x37, 0x41, 0x9a, 0x95, 0xba, 0x86, 0xa9, 0x77, 0x86, 0x12, 0xc2, 0x2b,
-	0xf0, 0x60, 0x96, 0x1d, 0xea, 0x27, 0xc3, 0x63, 0xed, 0x40, 0xaa, 0xe2, 0x16, 0x34, 0xff, 0x14,
-	0xdb, 0xda, 0xf1, 0x89, 0x54, 0xc3, 0x4f, 0xe0, 0xd1, 0xac, 0x72, 0xa0, 0xf7, 0xb4, 0xce, 0x5b,
-	0x5b, 0xeb, 0x1b, 0x83, 0x43, 0xfb, 0xb5, 0x75, 0x38, 0x90, 0x3e, 0x61, 0x79, 0x9e, 0xa8, 0x1b,
-	0x47, 0x76, 0x7b, 0xa7, 0x2d, 0x7d, 0x41, 0x72, 0xb5, 0x81, 0x76, 0x7b, 0xdf, 0xae, 0x14, 0x74,
-	0x79, 0xa5, 0xa0, 0x1f, 0x57, 0x0a, 0xfa, 0x7c, 0xad, 0x54, 0x2e, 0xaf, 0x95, 0xca, 0xf7, 0x6b,
-	0xa5, 0xf2, 0x6e, 0xd3, 0x0f, 0xc4, 0xfb, 0xd4, 0x25, 0x1e, 0x0b, 0xd5, 0xf2, 0xe9, 0xe7, 0x9f,
-	0x4d, 0x3e, 0x3a, 0x53, 0x45, 0x16, 0xd3, 0xf9, 0xff, 0x89, 0xbb, 0x98, 0x3f, 0x9c, 0xed, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xe2, 0x52, 0x45, 0x6b, 0x04, 0x00, 0x00,
+	0xf0, 0x14, 0x08, 0x71, 0xe9, 0xb1, 0x47, 0x8e, 0xa8, 0x7d, 0x06, 0xee, 0xa8, 0x76, 0x9c, 0x84,
+	0xaa, 0x08, 0x91, 0x93, 0x35, 0x33, 0xdf, 0xfe, 0xe6, 0x5b, 0xcd, 0x78, 0xe1, 0xb9, 0xcd, 0xb8,
+	0xc7, 0xb8, 0x22, 0xce, 0x15, 0xee, 0x3a, 0xbe, 0xeb, 0x3b, 0xca, 0xc7, 0x86, 0x45, 0x85, 0xd9,
+	0xc8, 0x62, 0x12, 0x84, 0x4c, 0x30, 0xbc, 0x96, 0x0a, 0x89, 0x38, 0x27, 0x59, 0x61, 0x22, 0x94,
+	0x37, 0x27, 0x0c, 0x3b, 0x8c, 0x03, 0xc1, 0x14, 0x2f, 0x1a, 0x0b, 0x97, 0xbb, 0x33, 0x50, 0x96,
+	0x48, 0x49, 0xf2, 0x9a, 0xc3, 0x98, 0x33, 0xa6, 0x4a, 0x12, 0x59, 0xd1, 0xa9, 0x62, 0xfa, 0x71,
+	0x5a, 0x5a, 0x3f, 0x85, 0x8a, 0xee, 0x3a, 0xbe, 0x29, 0xa2, 0x90, 0x76, 0x28, 0xb7, 0x43, 0x37,
+	0x10, 0x2c, 0xe4, 0x78, 0x00, 0xc0, 0xb3, 0x3c, 0xaf, 0xa2, 0x7a, 0x61, 0x63, 0xa5, 0x49, 0xc8,
+	0x5f, 0x1d, 0x91, 0x3b, 0x20, 0xda, 0x1c, 0x61, 0xfd, 0x57, 0x11, 0x1e, 0xde, 0xa1, 0xc1, 0x5b,
+	0x00, 0x41, 0x64, 0x8d, 0x5d, 0xdb, 0x38, 0xa3, 0x71, 0x15, 0xd5, 0xd1, 0xc6, 0x4a, 0xb3, 0x42,
+	0x52, 0xbf, 0x24, 0xf3, 0x4b, 0x5a, 0x7e, 0xac, 0x2d, 0xa7, 0xba, 0x7d, 0x1a, 0xe3, 0x2e, 0x14,
+	0x47, 0xa6, 0x30, 0xab, 0xf9, 0x44, 0xbe, 0xf5, 0x7f, 0xb6, 0x48, 0xc7, 0x14, 0xa6, 0x96, 0x00,
+	0xb0, 0x0c, 0x65, 0x4e, 0x3f, 0x44, 0xd4, 0xb7, 0x69, 0xb5, 0x50, 0x47, 0x1b, 0x45, 0x6d, 0x1a,
+	0xcb, 0xdf, 0x0b, 0x50, 0xbc, 0x91, 0xe2, 0x21, 0x2c, 0x71, 0xd7, 0x77, 0xc6, 0x74, 0x62, 0xef,
+	0xe5, 0x02, 0xfd, 0x88, 0x9e, 0x10, 0xf6, 0x72, 0xda, 0x84, 0x85, 0xdf, 0x40, 0x29, 0x99, 0xd2,
+	0xe4, 0x12, 0x3b, 0x8b, 0x40, 0xfb, 0x37, 0x80, 0xbd, 0x9c, 0x96, 0x92, 0x64, 0x03, 0x96, 0xd2,
+	0x36, 0x78, 0x1b, 0x8a, 0x1e, 0x1b, 0xa5, 0x86, 0xef, 0x37, 0x9f, 0xfd, 0x83, 0xdd, 0x67, 0x23,
+	0xaa, 0x25, 0x07, 0xf0, 0x13, 0x58, 0x9e, 0x0e, 0x2d, 0x71, 0x76, 0x4f, 0x9b, 0x25, 0xe4, 0x2f,
+	0x08, 0x4a, 0x49, 0x4f, 0xbc, 0x0f, 0x65, 0xcb, 0x15, 0x66, 0x18, 0x9a, 0xd9, 0xd0, 0x94, 0xac,
+	0x49, 0xba, 0x93, 0x64, 0xba, 0x82, 0x59, 0xa7, 0x36, 0xf3, 0x02, 0xd3, 0x16, 0xbb, 0xae, 0x68,
+	0xdd, 0x1c, 0xd3, 0xa6, 0x00, 0xac, 0xff, 0xb1, 0x6b, 0xf9, 0x7a, 0x61, 0xd1, 0xa1, 0xce, 0x61,
+	0x76, 0x4b, 0x50, 0xe0, 0x91, 0xf7, 0xe2, 0x1b, 0x82, 0x72, 0x76, 0x47, 0xbc, 0x06, 0xab, 0x7a,
+	0xaf, 0x3b, 0x30, 0xfa, 0x87, 0x1d, 0xd5, 0x38, 0x1e, 0xe8, 0x47, 0x6a, 0xbb, 0xf7, 0xaa, 0xa7,
+	0x76, 0xa4, 0x1c, 0xae, 0x80, 0x34, 0x2b, 0x75, 0x7a, 0x9a, 0xda, 0x1e, 0x4a, 0x08, 0xaf, 0xc2,
+	0x83, 0x59, 0x76, 0xa8, 0x9e, 0x0c, 0x8f, 0x5b, 0x07, 0x52, 0x1e, 0x57, 0xa1, 0x72, 0x5b, 0x6c,
+	0xb4, 0x8e, 0x4f, 0xa4, 0x02, 0x7e, 0x0a, 0x8f, 0x67, 0x95, 0x03, 0xb5, 0xdb, 0x6a, 0xbf, 0x35,
+	0x5a, 0xfd, 0xde, 0xe0, 0xd0, 0x78, 0xad, 0x1f, 0x0e, 0xa4, 0x4f, 0x58, 0x9e, 0x27, 0xaa, 0xbd,
+	0x23, 0xa3, 0xb1, 0xd3, 0x90, 0xbe, 0x22, 0x39, 0x5f, 0x46, 0xf8, 0xd1, 0xed, 0xda, 0x76, 0xa3,
+	0x29, 0x5d, 0x94, 0x76, 0xbb, 0x17, 0x57, 0x35, 0x74, 0x79, 0x55, 0x43, 0x3f, 0xaf, 0x6a, 0xe8,
+	0xf3, 0x75, 0x2d, 0x77, 0x79, 0x5d, 0xcb, 0xfd, 0xb8, 0xae, 0xe5, 0xde, 0x6d, 0x3a, 0xae, 0x78,
+	0x1f, 0x59, 0xc4, 0x66, 0x9e, 0x92, 0x3d, 0x09, 0xc9, 0x67, 0x93, 0x8f, 0xce, 0x14, 0x11, 0x07,
+	0x74, 0xfe, 0x9d, 0xb1, 0x96, 0x92, 0x1f, 0x6a, 0xeb, 0xf7, 0x00, 0x43, 0x2a, 0xd6, 0xef, 0x83,
+	0x04, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
@@ -14,15 +14,19 @@ import (
 	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -169,6 +173,60 @@ func (m *TxRaw) GetSignatures() [][]byte {
 	return nil
 }
 
+// TxBundle is an ordered bundle of transactions, possibly from different
+// signers, which are executed atomically: the state changes of the messages of
+// the transactions are only committed if all of them succeed. It is broadcast
+// and stored in Tendermint like a TxRaw, and the hash
+// `sha256(serialize(tx: TxBundle))` is its "txhash".
+//
+// Since: cosmos-sdk 0.51
+type TxBundle struct {
+	// txs is the list of the protobuf serializations of the TxRaw of the
+	// transactions of the bundle, in execution order. Each of them must set the
+	// commitment of the bundle in the bundle_commitment field of its TxBody.
+	Txs [][]byte `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *TxBundle) Reset()         { *m = TxBundle{} }
+func (m *TxBundle) String() string { return proto.CompactTextString(m) }
+func (*TxBundle) ProtoMessage()    {}
+func (*TxBundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{2}
+}
+func (m *TxBundle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxBundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxBundle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxBundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxBundle.Merge(m, src)
+}
+func (m *TxBundle) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxBundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxBundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxBundle proto.InternalMessageInfo
+
+func (m *TxBundle) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 // SignDoc is the type used for generating sign bytes for SIGN_MODE_DIRECT.
 type SignDoc struct {
 	// body_bytes is protobuf serialization of a TxBody that matches the
@@ -189,7 +247,7 @@ func (m *SignDoc) Reset()         { *m = SignDoc{} }
 func (m *SignDoc) String() string { return proto.CompactTextString(m) }
 func (*SignDoc) ProtoMessage()    {}
 func (*SignDoc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{2}
+	return fileDescriptor_96d1575ffde80842, []int{3}
 }
 func (m *SignDoc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +330,7 @@ func (m *SignDocDirectAux) Reset()         { *m = SignDocDirectAux{} }
 func (m *SignDocDirectAux) String() string { return proto.CompactTextString(m) }
 func (*SignDocDirectAux) ProtoMessage()    {}
 func (*SignDocDirectAux) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{3}
+	return fileDescriptor_96d1575ffde80842, []int{4}
 }
 func (m *SignDocDirectAux) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,6 +419,36 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// unordered, when set to true, indicates that the transaction is executed
+	// unordered: the sequences of its signers are neither checked nor
+	// incremented, so that transactions of a signer can be submitted
+	// concurrently. Replay protection instead relies on timeout_timestamp, which
+	// must be set: the chain keeps the hash of the transaction until its timeout,
+	// and rejects any transaction with a known hash.
+	//
+	// Unordered transactions must be signed with SIGN_MODE_DIRECT or
+	// SIGN_MODE_DIRECT_AUX.
+	//
+	// Since: cosmos-sdk 0.51
+	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	// timeout_timestamp is the block time after which this transaction will not
+	// be processed by the chain.
+	//
+	// Transactions with a timeout_timestamp must be signed with SIGN_MODE_DIRECT
+	// or SIGN_MODE_DIRECT_AUX.
+	//
+	// Since: cosmos-sdk 0.51
+	TimeoutTimestamp *time.Time `protobuf:"bytes,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp,omitempty"`
+	// bundle_commitment binds the transaction to the TxBundle it is part of. It
+	// is the SHA-256 hash of the messages of all the transactions of the bundle,
+	// in execution order. Transactions that set it can only be executed as part
+	// of a bundle with this commitment, and transactions of a bundle must set it.
+	//
+	// Bundled transactions must be signed with SIGN_MODE_DIRECT or
+	// SIGN_MODE_DIRECT_AUX.
+	//
+	// Since: cosmos-sdk 0.51
+	BundleCommitment []byte `protobuf:"bytes,6,opt,name=bundle_commitment,json=bundleCommitment,proto3" json:"bundle_commitment,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -375,7 +463,7 @@ func (m *TxBody) Reset()         { *m = TxBody{} }
 func (m *TxBody) String() string { return proto.CompactTextString(m) }
 func (*TxBody) ProtoMessage()    {}
 func (*TxBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{4}
+	return fileDescriptor_96d1575ffde80842, []int{5}
 }
 func (m *TxBody) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,6 +513,27 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
+func (m *TxBody) GetTimeoutTimestamp() *time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return nil
+}
+
+func (m *TxBody) GetBundleCommitment() []byte {
+	if m != nil {
+		return m.BundleCommitment
+	}
+	return nil
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -465,7 +574,7 @@ func (m *AuthInfo) Reset()         { *m = AuthInfo{} }
 func (m *AuthInfo) String() string { return proto.CompactTextString(m) }
 func (*AuthInfo) ProtoMessage()    {}
 func (*AuthInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{5}
+	return fileDescriptor_96d1575ffde80842, []int{6}
 }
 func (m *AuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +645,7 @@ func (m *SignerInfo) Reset()         { *m = SignerInfo{} }
 func (m *SignerInfo) String() string { return proto.CompactTextString(m) }
 func (*SignerInfo) ProtoMessage()    {}
 func (*SignerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{6}
+	return fileDescriptor_96d1575ffde80842, []int{7}
 }
 func (m *SignerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,7 +701,6 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -602,7 +710,7 @@ func (m *ModeInfo) Reset()         { *m = ModeInfo{} }
 func (m *ModeInfo) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo) ProtoMessage()    {}
 func (*ModeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{7}
+	return fileDescriptor_96d1575ffde80842, []int{8}
 }
 func (m *ModeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +796,7 @@ func (m *ModeInfo_Single) Reset()         { *m = ModeInfo_Single{} }
 func (m *ModeInfo_Single) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo_Single) ProtoMessage()    {}
 func (*ModeInfo_Single) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{7, 0}
+	return fileDescriptor_96d1575ffde80842, []int{8, 0}
 }
 func (m *ModeInfo_Single) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +845,7 @@ func (m *ModeInfo_Multi) Reset()         { *m = ModeInfo_Multi{} }
 func (m *ModeInfo_Multi) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo_Multi) ProtoMessage()    {}
 func (*ModeInfo_Multi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{7, 1}
+	return fileDescriptor_96d1575ffde80842, []int{8, 1}
 }
 func (m *ModeInfo_Multi) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +911,7 @@ func (m *Fee) Reset()         { *m = Fee{} }
 func (m *Fee) String() string { return proto.CompactTextString(m) }
 func (*Fee) ProtoMessage()    {}
 func (*Fee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{8}
+	return fileDescriptor_96d1575ffde80842, []int{9}
 }
 func (m *Fee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,7 +984,7 @@ func (m *Tip) Reset()         { *m = Tip{} }
 func (m *Tip) String() string { return proto.CompactTextString(m) }
 func (*Tip) ProtoMessage()    {}
 func (*Tip) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{9}
+	return fileDescriptor_96d1575ffde80842, []int{10}
 }
 func (m *Tip) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,7 +1052,7 @@ func (m *AuxSignerData) Reset()         { *m = AuxSignerData{} }
 func (m *AuxSignerData) String() string { return proto.CompactTextString(m) }
 func (*AuxSignerData) ProtoMessage()    {}
 func (*AuxSignerData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{10}
+	return fileDescriptor_96d1575ffde80842, []int{11}
 }
 func (m *AuxSignerData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,6 +1112,7 @@ func (m *AuxSignerData) GetSig() []byte {
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
+	proto.RegisterType((*TxBundle)(nil), "cosmos.tx.v1beta1.TxBundle")
 	proto.RegisterType((*SignDoc)(nil), "cosmos.tx.v1beta1.SignDoc")
 	proto.RegisterType((*SignDocDirectAux)(nil), "cosmos.tx.v1beta1.SignDocDirectAux")
 	proto.RegisterType((*TxBody)(nil), "cosmos.tx.v1beta1.TxBody")
@@ -1020,74 +1129,81 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1059 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x14, 0x21, 0xc7, 0x51, 0xdd, 0xe0, 0xaa,
-	0x60, 0x55, 0x64, 0xb7, 0x4d, 0x0f, 0x94, 0x0a, 0x01, 0x76, 0x43, 0x94, 0xaa, 0x14, 0xa4, 0x4d,
-	0x4e, 0xbd, 0xac, 0xc6, 0xeb, 0xc9, 0x7a, 0x54, 0xef, 0xcc, 0xb2, 0x33, 0x0b, 0xde, 0x23, 0x3f,
-	0x00, 0xa9, 0x42, 0x42, 0x48, 0x9c, 0x39, 0x20, 0x4e, 0x3d, 0x20, 0x7e, 0x43, 0x4f, 0xa8, 0xe2,
-	0xc4, 0x09, 0xaa, 0xe4, 0xd0, 0x3b, 0x7f, 0x00, 0x34, 0xb3, 0xb3, 0x9b, 0xb4, 0xa4, 0x4e, 0x11,
-	0x48, 0x5c, 0xec, 0x99, 0xb7, 0xdf, 0x7b, 0xf3, 0xbd, 0x37, 0xdf, 0xbc, 0x07, 0x9d, 0x80, 0x8b,
-	0x88, 0x0b, 0x57, 0xce, 0xdc, 0xcf, 0xae, 0x8f, 0x88, 0xc4, 0xd7, 0x5d, 0x39, 0x73, 0xe2, 0x84,
-	0x4b, 0x8e, 0x56, 0xf2, 0x6f, 0x8e, 0x9c, 0x39, 0xe6, 0x5b, 0x67, 0x05, 0x47, 0x94, 0x71, 0x57,
-	0xff, 0xe6, 0xa8, 0xce, 0x6a, 0xc8, 0x43, 0xae, 0x97, 0xae, 0x5a, 0x19, 0xeb, 0xa6, 0x89, 0x1b,
-	0x24, 0x59, 0x2c, 0xb9, 0x1b, 0xa5, 0x53, 0x49, 0x05, 0x0d, 0xcb, 0x43, 0x0a, 0x83, 0x81, 0x77,
-	0x0d, 0x7c, 0x84, 0x05, 0x29, 0x31, 0x01, 0xa7, 0xcc, 0x7c, 0x7f, 0xf3, 0x98, 0xa6, 0xa0, 0x21,
-	0xa3, 0xec, 0x38, 0x92, 0xd9, 0x1b, 0xe0, 0x5a, 0xc8, 0x79, 0x38, 0x25, 0xae, 0xde, 0x8d, 0xd2,
-	0x03, 0x17, 0xb3, 0xac, 0xf8, 0x94, 0xc7, 0xf0, 0x73, 0xae, 0x26, 0x37, 0xbd, 0xe9, 0x7d, 0x69,
-	0x41, 0x75, 0x7f, 0x86, 0x36, 0xa1, 0x36, 0xe2, 0xe3, 0xac, 0x6d, 0x6d, 0x58, 0xfd, 0x73, 0x5b,
-	0x6b, 0xce, 0xdf, 0xf2, 0x77, 0xf6, 0x67, 0x43, 0x3e, 0xce, 0x3c, 0x0d, 0x43, 0x37, 0xa1, 0x85,
-	0x53, 0x39, 0xf1, 0x29, 0x3b, 0xe0, 0xed, 0xaa, 0xf6, 0x59, 0x3f, 0xc5, 0x67, 0x90, 0xca, 0xc9,
-	0x1d, 0x76, 0xc0, 0xbd, 0x26, 0x36, 0x2b, 0xd4, 0x05, 0x50, 0xb4, 0xb1, 0x4c, 0x13, 0x22, 0xda,
-	0xf6, 0x86, 0xdd, 0x5f, 0xf4, 0x4e, 0x58, 0x7a, 0x0c, 0xea, 0xfb, 0x33, 0x0f, 0x7f, 0x8e, 0x2e,
-	0x02, 0xa8, 0xa3, 0xfc, 0x51, 0x26, 0x89, 0xd0, 0xbc, 0x16, 0xbd, 0x96, 0xb2, 0x0c, 0x95, 0x01,
-	0xbd, 0x01, 0x17, 0x4a, 0x06, 0x06, 0x53, 0xd5, 0x98, 0xa5, 0xe2, 0xa8, 0x1c, 0x77, 0xd6, 0x79,
-	0x5f, 0x59, 0xb0, 0xb0, 0x47, 0x43, 0xb6, 0xcd, 0x83, 0xff, 0xea, 0xc8, 0x35, 0x68, 0x06, 0x13,
-	0x4c, 0x99, 0x4f, 0xc7, 0x6d, 0x7b, 0xc3, 0xea, 0xb7, 0xbc, 0x05, 0xbd, 0xbf, 0x33, 0x46, 0x57,
-	0xe0, 0x3c, 0x0e, 0x02, 0x9e, 0x32, 0xe9, 0xb3, 0x34, 0x1a, 0x91, 0xa4, 0x5d, 0xdb, 0xb0, 0xfa,
-	0x35, 0x6f, 0xc9, 0x58, 0x3f, 0xd6, 0xc6, 0xde, 0x1f, 0x16, 0x2c, 0x1b, 0x52, 0xdb, 0x34, 0x21,
-	0x81, 0x1c, 0xa4, 0xb3, 0xb3, 0xd8, 0xdd, 0x00, 0x88, 0xd3, 0xd1, 0x94, 0x06, 0xfe, 0x03, 0x92,
-	0x99, 0x3b, 0x59, 0x75, 0x72, 0x4d, 0x38, 0x85, 0x26, 0x9c, 0x01, 0xcb, 0xbc, 0x56, 0x8e, 0xbb,
-	0x4b, 0xb2, 0x7f, 0x4f, 0x15, 0x75, 0xa0, 0x29, 0xc8, 0xa7, 0x29, 0x61, 0x01, 0x69, 0xd7, 0x35,
-	0xa0, 0xdc, 0xa3, 0xb7, 0xc0, 0x96, 0x34, 0x6e, 0x37, 0x34, 0x97, 0xd7, 0x4e, 0xd3, 0x14, 0x8d,
-	0x87, 0xd5, 0xb6, 0xe5, 0x29, 0x58, 0xef, 0xeb, 0x2a, 0x34, 0x72, 0x91, 0xa1, 0x6b, 0xd0, 0x8c,
-	0x88, 0x10, 0x38, 0xd4, 0x89, 0xda, 0x2f, 0xcd, 0xa4, 0x44, 0x21, 0x04, 0xb5, 0x88, 0x44, 0xb9,
-	0x16, 0x5b, 0x9e, 0x5e, 0xab, 0x0c, 0x24, 0x8d, 0x08, 0x4f, 0xa5, 0x3f, 0x21, 0x34, 0x9c, 0x48,
-	0x9d, 0x62, 0xcd, 0x5b, 0x32, 0xd6, 0x5d, 0x6d, 0x44, 0x43, 0x58, 0x21, 0x33, 0x49, 0x98, 0xa0,
-	0x9c, 0xf9, 0x3c, 0x96, 0x94, 0x33, 0xd1, 0xfe, 0x73, 0x61, 0xce, 0xb1, 0xcb, 0x25, 0xfe, 0x93,
-	0x1c, 0x8e, 0xee, 0x43, 0x97, 0x71, 0xe6, 0x07, 0x09, 0x95, 0x34, 0xc0, 0x53, 0xff, 0x94, 0x80,
-	0x17, 0xe6, 0x04, 0x5c, 0x67, 0x9c, 0xdd, 0x36, 0xbe, 0x1f, 0xbe, 0x10, 0xbb, 0xf7, 0x9d, 0x05,
-	0xcd, 0xe2, 0x21, 0xa1, 0x0f, 0x60, 0x51, 0x89, 0x97, 0x24, 0x5a, 0x85, 0x45, 0x75, 0x2e, 0x9e,
-	0x52, 0xdb, 0x3d, 0x0d, 0xd3, 0xaf, 0xef, 0x9c, 0x28, 0xd7, 0x02, 0xf5, 0xc1, 0x3e, 0x20, 0xc4,
-	0x08, 0xe4, 0xb4, 0x4b, 0xd9, 0x21, 0xc4, 0x53, 0x90, 0xe2, 0xfa, 0xec, 0x57, 0xbb, 0xbe, 0x6f,
-	0x2c, 0x80, 0xe3, 0x33, 0x5f, 0x90, 0xa3, 0xf5, 0x6a, 0x72, 0xbc, 0x09, 0xad, 0x88, 0x8f, 0xc9,
-	0x59, 0x6d, 0xe5, 0x1e, 0x1f, 0x93, 0xbc, 0xad, 0x44, 0x66, 0xf5, 0x9c, 0x0c, 0xed, 0xe7, 0x65,
-	0xd8, 0x7b, 0x5a, 0x85, 0x66, 0xe1, 0x82, 0xde, 0x85, 0x86, 0xa0, 0x2c, 0x9c, 0x12, 0xc3, 0xa9,
-	0x37, 0x27, 0xbe, 0xb3, 0xa7, 0x91, 0xbb, 0x15, 0xcf, 0xf8, 0xa0, 0x77, 0xa0, 0xae, 0xdb, 0xb7,
-	0x21, 0xf7, 0xfa, 0x3c, 0xe7, 0x7b, 0x0a, 0xb8, 0x5b, 0xf1, 0x72, 0x8f, 0xce, 0x00, 0x1a, 0x79,
-	0x38, 0xf4, 0x36, 0xd4, 0x14, 0x6f, 0x4d, 0xe0, 0xfc, 0xd6, 0xe5, 0x13, 0x31, 0x8a, 0x86, 0x7e,
-	0xf2, 0x0e, 0x55, 0x3c, 0x4f, 0x3b, 0x74, 0x1e, 0x5a, 0x50, 0xd7, 0x51, 0xd1, 0x5d, 0x68, 0x8e,
-	0xa8, 0xc4, 0x49, 0x82, 0x8b, 0xda, 0xba, 0x45, 0x98, 0x7c, 0xec, 0x38, 0xe5, 0x94, 0x29, 0x62,
-	0xdd, 0xe6, 0x51, 0x8c, 0x03, 0x39, 0xa4, 0x72, 0xa0, 0xdc, 0xbc, 0x32, 0x00, 0xba, 0x05, 0x50,
-	0x56, 0x5d, 0xb5, 0x34, 0xfb, 0xac, 0xb2, 0xb7, 0x8a, 0xb2, 0x8b, 0x61, 0x1d, 0x6c, 0x91, 0x46,
-	0xbd, 0x2f, 0xaa, 0x60, 0xef, 0x10, 0x82, 0x32, 0x68, 0xe0, 0x48, 0x75, 0x07, 0x23, 0xcc, 0x72,
-	0x90, 0xa8, 0xe9, 0x76, 0x82, 0x0a, 0x65, 0xc3, 0x9d, 0xc7, 0xbf, 0x5d, 0xaa, 0xfc, 0xf0, 0xfb,
-	0xa5, 0x7e, 0x48, 0xe5, 0x24, 0x1d, 0x39, 0x01, 0x8f, 0xdc, 0x62, 0x72, 0xea, 0xbf, 0x4d, 0x31,
-	0x7e, 0xe0, 0xca, 0x2c, 0x26, 0x42, 0x3b, 0x88, 0x6f, 0x9f, 0x3d, 0xba, 0xba, 0x38, 0x25, 0x21,
-	0x0e, 0x32, 0x5f, 0xcd, 0x47, 0xf1, 0xfd, 0xb3, 0x47, 0x57, 0x2d, 0xcf, 0x1c, 0x88, 0xd6, 0xa1,
-	0x15, 0x62, 0xe1, 0x4f, 0x69, 0x44, 0xa5, 0xbe, 0x9e, 0x9a, 0xd7, 0x0c, 0xb1, 0xf8, 0x48, 0xed,
-	0x91, 0x03, 0xf5, 0x18, 0x67, 0x24, 0xc9, 0x9b, 0xdc, 0xb0, 0xfd, 0xcb, 0x8f, 0x9b, 0xab, 0x86,
-	0xd9, 0x60, 0x3c, 0x4e, 0x88, 0x10, 0x7b, 0x32, 0xa1, 0x2c, 0xf4, 0x72, 0x18, 0xda, 0x82, 0x85,
-	0x30, 0xc1, 0x4c, 0x9a, 0xae, 0x37, 0xcf, 0xa3, 0x00, 0xf6, 0x7e, 0xb2, 0xc0, 0xde, 0xa7, 0xf1,
-	0xff, 0x59, 0x83, 0x6b, 0xd0, 0x90, 0x34, 0x8e, 0x49, 0x92, 0xf7, 0xc1, 0x39, 0xac, 0x0d, 0xee,
-	0x56, 0xb5, 0x6d, 0xf5, 0x7e, 0xb6, 0x60, 0x69, 0x90, 0xce, 0xf2, 0xc7, 0xbb, 0x8d, 0x25, 0x56,
-	0xe9, 0xe3, 0x1c, 0xae, 0xd5, 0x35, 0x37, 0x7d, 0x03, 0x44, 0xef, 0x41, 0x53, 0xc9, 0xd7, 0x1f,
-	0xf3, 0xc0, 0xbc, 0x8e, 0xcb, 0x2f, 0xe9, 0x4a, 0x27, 0xa7, 0x9a, 0xb7, 0x20, 0xcc, 0xf0, 0x2d,
-	0x5e, 0x85, 0xfd, 0x0f, 0x5f, 0x05, 0x5a, 0x06, 0x5b, 0xd0, 0x50, 0xdf, 0xd3, 0xa2, 0xa7, 0x96,
-	0xc3, 0xf7, 0x1f, 0x1f, 0x76, 0xad, 0x27, 0x87, 0x5d, 0xeb, 0xe9, 0x61, 0xd7, 0x7a, 0x78, 0xd4,
-	0xad, 0x3c, 0x39, 0xea, 0x56, 0x7e, 0x3d, 0xea, 0x56, 0xee, 0x5f, 0x39, 0xbb, 0xd0, 0xae, 0x9c,
-	0x8d, 0x1a, 0xba, 0x41, 0xdd, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x24, 0x52, 0x64, 0xe6, 0x23,
-	0x0a, 0x00, 0x00,
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xd7, 0xce, 0xc6, 0xfb, 0x9a, 0xb4, 0x9b, 0x51, 0x85, 0xdc, 0x2d, 0xdd, 0x84, 0xad,
+	0x0a, 0x51, 0x21, 0xde, 0x36, 0x3d, 0x50, 0x2a, 0x04, 0xec, 0xb6, 0x54, 0xfd, 0x43, 0x41, 0x72,
+	0x72, 0xea, 0xc5, 0x9a, 0xb5, 0x27, 0xde, 0x51, 0xd7, 0x33, 0xc6, 0x33, 0x86, 0xdd, 0x23, 0x1f,
+	0x00, 0xa9, 0xe2, 0x82, 0xc4, 0x99, 0x03, 0xe2, 0x42, 0x0f, 0x88, 0xcf, 0xd0, 0x13, 0xaa, 0x38,
+	0x71, 0xa2, 0x55, 0x7b, 0xe8, 0x9d, 0x2f, 0x00, 0x9a, 0xf1, 0xd8, 0x49, 0xdb, 0x34, 0x5b, 0x04,
+	0x12, 0x97, 0xdd, 0x37, 0x6f, 0x7e, 0xef, 0xcd, 0xef, 0xbd, 0x79, 0xef, 0x8d, 0xa1, 0x13, 0x71,
+	0x91, 0x72, 0xd1, 0x97, 0xd3, 0xfe, 0x17, 0xe7, 0x47, 0x44, 0xe2, 0xf3, 0x7d, 0x39, 0xf5, 0xb3,
+	0x9c, 0x4b, 0x8e, 0x56, 0xcb, 0x3d, 0x5f, 0x4e, 0x7d, 0xb3, 0xd7, 0x59, 0xc5, 0x29, 0x65, 0xbc,
+	0xaf, 0x7f, 0x4b, 0x54, 0xe7, 0x78, 0xc2, 0x13, 0xae, 0xc5, 0xbe, 0x92, 0x8c, 0x76, 0xd3, 0xf8,
+	0x8d, 0xf2, 0x59, 0x26, 0x79, 0x3f, 0x2d, 0x26, 0x92, 0x0a, 0x9a, 0xd4, 0x87, 0x54, 0x0a, 0x03,
+	0xef, 0x1a, 0xf8, 0x08, 0x0b, 0x52, 0x63, 0x22, 0x4e, 0x99, 0xd9, 0x7f, 0x6b, 0x8f, 0xa6, 0xa0,
+	0x09, 0xa3, 0x6c, 0xcf, 0x93, 0x59, 0x1b, 0xe0, 0x89, 0x84, 0xf3, 0x64, 0x42, 0xfa, 0x7a, 0x35,
+	0x2a, 0x76, 0xfb, 0x98, 0xcd, 0xcc, 0xd6, 0xda, 0xf3, 0x5b, 0x92, 0xa6, 0x44, 0x48, 0x9c, 0x66,
+	0x95, 0x6d, 0x79, 0x48, 0x58, 0x06, 0x63, 0x82, 0xd7, 0x8b, 0xde, 0xd7, 0x16, 0x34, 0x76, 0xa6,
+	0x68, 0x13, 0x9c, 0x11, 0x8f, 0x67, 0x9e, 0xb5, 0x6e, 0x6d, 0x1c, 0xd9, 0x3a, 0xe1, 0xbf, 0x90,
+	0x20, 0x7f, 0x67, 0x3a, 0xe4, 0xf1, 0x2c, 0xd0, 0x30, 0x74, 0x11, 0x5a, 0xb8, 0x90, 0xe3, 0x90,
+	0xb2, 0x5d, 0xee, 0x35, 0xb4, 0xcd, 0xc9, 0x03, 0x6c, 0x06, 0x85, 0x1c, 0x5f, 0x67, 0xbb, 0x3c,
+	0x70, 0xb1, 0x91, 0x50, 0x17, 0x40, 0xc5, 0x85, 0x65, 0x91, 0x13, 0xe1, 0xd9, 0xeb, 0xf6, 0xc6,
+	0x72, 0xb0, 0x4f, 0xd3, 0x63, 0xb0, 0xb8, 0x33, 0x0d, 0xf0, 0x97, 0xe8, 0x14, 0x80, 0x3a, 0x2a,
+	0x1c, 0xcd, 0x24, 0x11, 0x9a, 0xd7, 0x72, 0xd0, 0x52, 0x9a, 0xa1, 0x52, 0xa0, 0x37, 0xe1, 0x58,
+	0xcd, 0xc0, 0x60, 0x1a, 0x1a, 0xb3, 0x52, 0x1d, 0x55, 0xe2, 0xe6, 0x9d, 0xe7, 0x83, 0xbb, 0x33,
+	0x1d, 0x16, 0x2c, 0x9e, 0x10, 0xd4, 0x06, 0x5b, 0x4e, 0x85, 0xe7, 0x68, 0x90, 0x12, 0x6f, 0x38,
+	0xae, 0xd5, 0x6e, 0xdc, 0x70, 0xdc, 0x46, 0xdb, 0xbe, 0xe1, 0xb8, 0x76, 0xdb, 0xe9, 0x7d, 0x63,
+	0xc1, 0xd2, 0x36, 0x4d, 0xd8, 0x15, 0x1e, 0xfd, 0x57, 0x14, 0x4f, 0x80, 0x1b, 0x8d, 0x31, 0x65,
+	0x21, 0x8d, 0x3d, 0x7b, 0xdd, 0xda, 0x68, 0x05, 0x4b, 0x7a, 0x7d, 0x3d, 0x46, 0x67, 0xe0, 0x28,
+	0x8e, 0x22, 0x5e, 0x30, 0x19, 0xb2, 0x22, 0x1d, 0x91, 0xdc, 0x73, 0xd6, 0xad, 0x0d, 0x27, 0x58,
+	0x31, 0xda, 0x4f, 0xb5, 0xb2, 0xf7, 0xa7, 0x05, 0x6d, 0x43, 0xea, 0x0a, 0xcd, 0x49, 0x24, 0x07,
+	0xc5, 0x74, 0x1e, 0xbb, 0x0b, 0x00, 0x59, 0x31, 0x9a, 0xd0, 0x28, 0xbc, 0x43, 0x66, 0xe6, 0x0e,
+	0x8f, 0xfb, 0x65, 0x25, 0xf9, 0x55, 0x25, 0xf9, 0x03, 0x36, 0x0b, 0x5a, 0x25, 0xee, 0x26, 0x99,
+	0xfd, 0x7b, 0xaa, 0xa8, 0x03, 0xae, 0x20, 0x9f, 0x17, 0x84, 0x45, 0xc4, 0x5b, 0xd4, 0x80, 0x7a,
+	0x8d, 0xde, 0x01, 0x5b, 0xd2, 0xcc, 0x6b, 0x6a, 0x2e, 0xaf, 0x1d, 0x54, 0x83, 0x34, 0x1b, 0x36,
+	0x3c, 0x2b, 0x50, 0xb0, 0xde, 0x4f, 0x36, 0x34, 0xcb, 0xa2, 0x44, 0xe7, 0xc0, 0x4d, 0x89, 0x10,
+	0x38, 0xd1, 0x81, 0xda, 0x2f, 0x8d, 0xa4, 0x46, 0x21, 0x04, 0x4e, 0x4a, 0xd2, 0xb2, 0x76, 0x5b,
+	0x81, 0x96, 0x55, 0x04, 0xaa, 0x71, 0x78, 0x21, 0xc3, 0x31, 0xa1, 0xc9, 0x58, 0xea, 0x10, 0x9d,
+	0x60, 0xc5, 0x68, 0xaf, 0x69, 0x25, 0x7a, 0x1d, 0x5a, 0x05, 0xe3, 0x79, 0x4c, 0x72, 0x12, 0xeb,
+	0x18, 0xdd, 0x60, 0x4f, 0x81, 0x6e, 0xc1, 0x6a, 0xe5, 0xa4, 0xee, 0x42, 0x1d, 0xe8, 0x91, 0xad,
+	0xce, 0x0b, 0x9c, 0x76, 0x2a, 0xc4, 0xd0, 0xb9, 0xfb, 0x70, 0xcd, 0x0a, 0xda, 0xc6, 0xb4, 0xd6,
+	0xa3, 0xb7, 0x61, 0x75, 0xa4, 0x8b, 0x33, 0x8c, 0x78, 0x9a, 0x52, 0x99, 0x12, 0x26, 0x75, 0x82,
+	0x96, 0x83, 0x76, 0xb9, 0x71, 0xb9, 0xd6, 0xa3, 0x21, 0xac, 0x92, 0xa9, 0x24, 0x4c, 0x50, 0xce,
+	0x42, 0x9e, 0x49, 0xca, 0x99, 0xf0, 0xfe, 0x5a, 0x3a, 0x24, 0x21, 0xed, 0x1a, 0xff, 0x59, 0x09,
+	0x47, 0xb7, 0xa1, 0xcb, 0x38, 0x0b, 0xa3, 0x9c, 0x4a, 0x1a, 0xe1, 0x49, 0x78, 0x80, 0xc3, 0x63,
+	0x87, 0x38, 0x3c, 0xc9, 0x38, 0xbb, 0x6c, 0x6c, 0x3f, 0x7e, 0xce, 0x77, 0xef, 0x7b, 0x0b, 0xdc,
+	0x6a, 0x24, 0xa0, 0x8f, 0x60, 0x59, 0xb5, 0x21, 0xc9, 0x75, 0x7f, 0x54, 0xf7, 0x76, 0xea, 0x80,
+	0x5b, 0xdf, 0xd6, 0x30, 0x3d, 0x47, 0x8e, 0x88, 0x5a, 0x16, 0x68, 0x03, 0xec, 0x5d, 0x42, 0xbc,
+	0xc6, 0x4b, 0xcb, 0xe5, 0x2a, 0x21, 0x81, 0x82, 0x54, 0x85, 0x65, 0xbf, 0x5a, 0x61, 0x7d, 0x6b,
+	0x01, 0xec, 0x9d, 0xf9, 0x5c, 0xa3, 0x58, 0xaf, 0xd6, 0x28, 0x17, 0xa1, 0x95, 0xf2, 0x98, 0xcc,
+	0x1b, 0x90, 0xb7, 0x78, 0x4c, 0xca, 0x01, 0x99, 0x1a, 0xe9, 0x99, 0x06, 0xb1, 0x9f, 0x6d, 0x90,
+	0xde, 0xa3, 0x06, 0xb8, 0x95, 0x09, 0x7a, 0x1f, 0x9a, 0x82, 0xb2, 0x64, 0x42, 0x0c, 0xa7, 0xde,
+	0x21, 0xfe, 0xfd, 0x6d, 0x8d, 0xbc, 0xb6, 0x10, 0x18, 0x1b, 0xf4, 0x1e, 0x2c, 0xea, 0x97, 0xca,
+	0x90, 0x7b, 0xe3, 0x30, 0xe3, 0x5b, 0x0a, 0x78, 0x6d, 0x21, 0x28, 0x2d, 0x3a, 0x03, 0x68, 0x96,
+	0xee, 0xd0, 0xbb, 0xe0, 0x28, 0xde, 0x9a, 0xc0, 0xd1, 0xad, 0xd3, 0xfb, 0x7c, 0x54, 0x6f, 0xd7,
+	0xfe, 0x3b, 0x54, 0xfe, 0x02, 0x6d, 0xd0, 0xb9, 0x6b, 0xc1, 0xa2, 0xf6, 0x8a, 0x6e, 0x82, 0x3b,
+	0xa2, 0x12, 0xe7, 0x39, 0xae, 0x72, 0xdb, 0xaf, 0xdc, 0x94, 0x2f, 0xac, 0x5f, 0x3f, 0xa8, 0x95,
+	0xaf, 0xcb, 0x3c, 0xcd, 0x70, 0x24, 0x87, 0x54, 0x0e, 0x94, 0x59, 0x50, 0x3b, 0x40, 0x97, 0x00,
+	0xea, 0xac, 0xab, 0x61, 0x6b, 0xcf, 0x4b, 0x7b, 0xab, 0x4a, 0xbb, 0x18, 0x2e, 0x82, 0x2d, 0x8a,
+	0xb4, 0xf7, 0x55, 0x03, 0xec, 0xab, 0x84, 0xa0, 0x19, 0x34, 0x71, 0xaa, 0xe6, 0x96, 0x29, 0xcc,
+	0xfa, 0x49, 0x54, 0x0f, 0xf9, 0x3e, 0x2a, 0x94, 0x0d, 0xaf, 0xde, 0xff, 0x63, 0x6d, 0xe1, 0xc7,
+	0x87, 0x6b, 0x1b, 0x09, 0x95, 0xe3, 0x62, 0xe4, 0x47, 0x3c, 0xed, 0x57, 0x1f, 0x09, 0xfa, 0x6f,
+	0x53, 0xc4, 0x77, 0xfa, 0x72, 0x96, 0x11, 0xa1, 0x0d, 0xc4, 0x77, 0x4f, 0xef, 0x9d, 0x5d, 0x9e,
+	0x90, 0x04, 0x47, 0xb3, 0x50, 0x7d, 0x0a, 0x88, 0x1f, 0x9e, 0xde, 0x3b, 0x6b, 0x05, 0xe6, 0x40,
+	0x74, 0x12, 0x5a, 0x09, 0x16, 0xe1, 0x84, 0xa6, 0x54, 0xea, 0xeb, 0x71, 0x02, 0x37, 0xc1, 0xe2,
+	0x13, 0xb5, 0x46, 0x3e, 0x2c, 0x66, 0x78, 0x46, 0xf2, 0x72, 0xfc, 0x0e, 0xbd, 0xdf, 0x7e, 0xde,
+	0x3c, 0x6e, 0x98, 0x0d, 0xe2, 0x38, 0x27, 0x42, 0x6c, 0xcb, 0x9c, 0xb2, 0x24, 0x28, 0x61, 0x68,
+	0x0b, 0x96, 0x92, 0x1c, 0x33, 0x69, 0xe6, 0xf1, 0x61, 0x16, 0x15, 0xb0, 0xf7, 0x8b, 0x05, 0xf6,
+	0x0e, 0xcd, 0xfe, 0xcf, 0x1c, 0x9c, 0x83, 0xa6, 0xa4, 0x59, 0x46, 0x72, 0xaf, 0x31, 0x87, 0xb5,
+	0xc1, 0x5d, 0x6a, 0x78, 0x56, 0xef, 0x57, 0x0b, 0x56, 0x06, 0xc5, 0xb4, 0x6c, 0xde, 0x2b, 0x58,
+	0x62, 0x15, 0x3e, 0x2e, 0xe1, 0x9e, 0x35, 0xc7, 0x51, 0x05, 0x44, 0x1f, 0x80, 0xab, 0xca, 0x37,
+	0x8c, 0x79, 0x64, 0xba, 0xe3, 0xf4, 0x4b, 0xa6, 0xd2, 0xfe, 0xf7, 0x36, 0x58, 0x12, 0xa5, 0xa6,
+	0xee, 0x0a, 0xfb, 0x1f, 0x76, 0x85, 0xfa, 0xfe, 0x10, 0x34, 0xd1, 0xf7, 0xb4, 0x1c, 0x28, 0x71,
+	0xf8, 0xe1, 0xfd, 0xc7, 0x5d, 0xeb, 0xc1, 0xe3, 0xae, 0xf5, 0xe8, 0x71, 0xd7, 0xba, 0xfb, 0xa4,
+	0xbb, 0xf0, 0xe0, 0x49, 0x77, 0xe1, 0xf7, 0x27, 0xdd, 0x85, 0xdb, 0x67, 0xe6, 0x27, 0xba, 0x2f,
+	0xa7, 0xa3, 0xa6, 0x1e, 0x50, 0x17, 0xfe, 0x1e, 0x00, 0xcb, 0x96, 0x67, 0xa0, 0x0e, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1192,6 +1308,38 @@ func (m *TxRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SignDoc) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1364,6 +1512,33 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if len(m.BundleCommitment) > 0 {
+		i -= len(m.BundleCommitment)
+		copy(dAtA[i:], m.BundleCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BundleCommitment)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TimeoutTimestamp != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimeoutTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1868,6 +2043,21 @@ func (m *TxRaw) Size() (n int) {
 	return n
 }
 
+func (m *TxBundle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SignDoc) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1942,6 +2132,17 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
+	if m.TimeoutTimestamp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BundleCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2446,6 +2647,88 @@ func (m *TxRaw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxBundle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxBundle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxBundle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SignDoc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2955,6 +3238,96 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutTimestamp == nil {
+				m.TimeoutTimestamp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleCommitment = append(m.BundleCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.BundleCommitment == nil {
+				m.BundleCommitment = []byte{}
+			}
+			iNdEx = postIndex
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
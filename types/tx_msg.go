@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	fmt "fmt"
 	strings "strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	protov2 "google.golang.org/protobuf/proto"
@@ -79,6 +80,44 @@ type (
 		GetTimeoutHeight() uint64
 	}
 
+	// TxWithTimeoutTimestamp extends the Tx interface by allowing a transaction
+	// to set a timestamp timeout.
+	TxWithTimeoutTimestamp interface {
+		Tx
+
+		GetTimeoutTimestamp() time.Time
+	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// executed unordered: the sequences of its signers are neither checked nor
+	// incremented. An unordered transaction must set a timestamp timeout.
+	TxWithUnordered interface {
+		TxWithTimeoutTimestamp
+
+		GetUnordered() bool
+	}
+
+	// TxWithBundleCommitment extends the Tx interface by allowing a transaction
+	// to commit to the bundle it is part of, so that it can only be executed as
+	// part of this bundle.
+	TxWithBundleCommitment interface {
+		Tx
+
+		GetBundleCommitment() []byte
+	}
+
+	// BundleTx defines the interface of a bundle of txs, possibly from different
+	// signers, which are executed in order and atomically. Its messages are the
+	// messages of its txs, in order.
+	BundleTx interface {
+		Tx
+
+		// GetTxs returns the txs of the bundle, in execution order.
+		GetTxs() []Tx
+		// GetTxsBytes returns the encoded txs of the bundle, in execution order.
+		GetTxsBytes() [][]byte
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
@@ -10,6 +10,7 @@ import (
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/accountstd"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
 	"cosmossdk.io/x/accounts/internal/implementation"
 )
 
@@ -76,6 +77,12 @@ func (t TestAccount) RegisterExecuteHandlers(builder *implementation.ExecuteBuil
 	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *types.UInt64Value) (*types.Empty, error) {
 		return &types.Empty{}, t.Counter.Set(ctx, req.Value)
 	})
+
+	// migration testing, the sequence of the legacy account is stored in the counter
+	// and the init message is echoed back.
+	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *migrationv1.MsgMigrate) (*migrationv1.MsgMigrateResponse, error) {
+		return &migrationv1.MsgMigrateResponse{InitResponse: req.InitMessage}, t.Counter.Set(ctx, req.Sequence)
+	})
 }
 
 func (t TestAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder) {
@@ -6,8 +6,11 @@ import (
 	"context"
 	"fmt"
 
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/branch"
 	"cosmossdk.io/x/accounts/internal/implementation"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
@@ -62,6 +65,12 @@ func AddAccount[A Interface](name string, constructor func(deps Dependencies) (A
 	}
 }
 
+// Funds returns the funds transferred to the account by the sender of the
+// initialisation request.
+func Funds(ctx context.Context) sdk.Coins {
+	return implementation.Funds(ctx)
+}
+
 // Whoami returns the address of the account being invoked.
 func Whoami(ctx context.Context) []byte {
 	return implementation.Whoami(ctx)
@@ -77,6 +86,12 @@ func SenderIsAccountsModule(ctx context.Context) bool {
 	return bytes.Equal(Sender(ctx), accountsModuleAddress)
 }
 
+// CollValue returns a collections value codec for a protobuf message, to be
+// used in the state of an account.
+func CollValue[T any, PT implementation.ProtoMsgG[T]]() collcodec.ValueCodec[T] {
+	return implementation.CollValue[T, PT]()
+}
+
 // ExecModule can be used to execute a message towards a module.
 func ExecModule[Resp any, RespProto implementation.ProtoMsgG[Resp], Req any, ReqProto implementation.ProtoMsgG[Req]](ctx context.Context, msg ReqProto) (RespProto, error) {
 	return implementation.ExecModule[Resp, RespProto, Req, ReqProto](ctx, msg)
@@ -101,6 +116,14 @@ func PackAny(msg implementation.ProtoMsg) (*implementation.Any, error) {
 	return implementation.PackAny(msg)
 }
 
+// Branch executes fn in a branch of the state, committing the state changes of
+// fn, to the account and to the modules whose messages it executes, only if it
+// returns no error. The branch service is the one of the Dependencies of the
+// account.
+func Branch(ctx context.Context, branchService branch.Service, fn func(ctx context.Context) error) error {
+	return implementation.Branch(ctx, branchService, fn)
+}
+
 // ExecModuleAnys can be used to execute a list of messages towards a module
 // when those messages are packed in Any messages. The function returns a list
 // of responses packed in Any messages.
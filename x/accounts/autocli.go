@@ -0,0 +1,80 @@
+package accounts
+
+import (
+	"fmt"
+
+	accountsv1 "cosmossdk.io/api/cosmos/accounts/v1"
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: accountsv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AccountQuery",
+					Skip:      true, // the query command decodes the request from its account schema
+				},
+				{
+					RpcMethod:      "Schema",
+					Use:            "schema [account-type]",
+					Short:          "Query the names of the messages of the handlers of an account type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account_type"}},
+				},
+				{
+					RpcMethod:      "AccountType",
+					Use:            "account-type [address]",
+					Short:          "Query the account type of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "AccountSchema",
+					Use:            "account-schema [account-type]",
+					Short:          "Query the schema of an account type, with the descriptors of its messages",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account_type"}},
+				},
+				{
+					RpcMethod: "SimulateUserOperation",
+					Use:       "simulate-user-operation [bundler] [user-operation]",
+					Short:     "Simulate a UserOperation, returning the gas used by each of its steps and the responses of its messages",
+					Long:      "Simulate a UserOperation, returning the gas used by its authentication, its bundler payment and its execution, and the responses of its messages. The UserOperation is given in JSON, or as the path of a JSON file. Its gas limits are capped by the simulation gas limit of the node, which is also used for the gas limits which are not set.",
+					Example:   fmt.Sprintf("%s query accounts simulate-user-operation [bundler-address] user_operation.json", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "bundler"},
+						{ProtoField: "user_operation"},
+					},
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: accountsv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Init",
+					Skip:      true, // the init command decodes the message from its account schema
+				},
+				{
+					// the subcommands of the account types are built from the AccountSchema query.
+					RpcMethod: "Execute",
+					Example:   fmt.Sprintf("%s tx accounts execute multisig vote [multisig-address] --proposal-id 1 --vote yes --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "ExecuteBundle",
+					Use:       "bundle [user-operation]...",
+					Short:     "Execute a bundle of UserOperations, the sender being the bundler",
+					Long:      "Execute a bundle of UserOperations, the sender being the bundler. Each UserOperation is given in JSON, or as the path of a JSON file. The UserOperations are executed in isolation: a failing UserOperation does not fail the bundle, its error is reported in its response.",
+					Example:   fmt.Sprintf("%s tx accounts bundle user_operation_1.json user_operation_2.json --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operations", Varargs: true},
+					},
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+	}
+}
@@ -15,8 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// FlagFunds is the flag of the funds sent to an account with its initialization.
+const FlagFunds = "funds"
+
 func TxCmd(name string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                name,
@@ -24,7 +28,7 @@ func TxCmd(name string) *cobra.Command {
 		RunE:               client.ValidateCmd,
 		DisableFlagParsing: true,
 	}
-	cmd.AddCommand(GetTxInitCmd(), GetExecuteCmd())
+	cmd.AddCommand(GetTxInitCmd())
 	return cmd
 }
 
@@ -66,49 +70,25 @@ func GetTxInitCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			msg := v1.MsgInit{
-				Sender:      sender.String(),
-				AccountType: args[0],
-				Message:     msgBytes,
-			}
-
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
-		},
-	}
-	flags.AddTxFlagsToCmd(cmd)
-	return cmd
-}
-
-func GetExecuteCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "execute [account-address] [execute-msg-type-url] [json-message]",
-		Short: "Execute state transition to account",
-		Args:  cobra.ExactArgs(3),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
+			fundsStr, err := cmd.Flags().GetString(FlagFunds)
 			if err != nil {
 				return err
 			}
-			sender := clientCtx.GetFromAddress()
-
-			schema, err := getSchemaForAccount(clientCtx, args[0])
+			funds, err := sdk.ParseCoinsNormalized(fundsStr)
 			if err != nil {
-				return err
+				return fmt.Errorf("invalid funds: %w", err)
 			}
-
-			msgBytes, err := handlerMsgBytes(schema.ExecuteHandlers, args[1], args[2])
-			if err != nil {
-				return err
-			}
-			msg := v1.MsgExecute{
-				Sender:  sender.String(),
-				Target:  args[0],
-				Message: msgBytes,
+			msg := v1.MsgInit{
+				Sender:      sender.String(),
+				AccountType: args[0],
+				Message:     msgBytes,
+				Funds:       funds,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().String(FlagFunds, "", "Coins to send to the account with its initialization")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
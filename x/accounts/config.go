@@ -0,0 +1,21 @@
+package accounts
+
+// DefaultSimulationGasLimit is the default gas limit of each step of a
+// simulated UserOperation.
+const DefaultSimulationGasLimit uint64 = 10_000_000
+
+// Config is the configuration of the x/accounts keeper.
+type Config struct {
+	// SimulationGasLimit is the maximum gas limit of each step of a UserOperation
+	// simulated with the SimulateUserOperation query: its authentication, its
+	// bundler payment and its execution. The gas limits of the UserOperation
+	// which are not set, or which exceed it, are set to it.
+	SimulationGasLimit uint64
+}
+
+// DefaultConfig returns the default config for x/accounts.
+func DefaultConfig() Config {
+	return Config{
+		SimulationGasLimit: DefaultSimulationGasLimit,
+	}
+}
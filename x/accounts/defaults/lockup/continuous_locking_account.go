@@ -0,0 +1,113 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ accountstd.Interface = (*ContinuousLockingAccount)(nil)
+
+// NewContinuousLockingAccount creates a new continuous locking account.
+func NewContinuousLockingAccount(d accountstd.Dependencies) (*ContinuousLockingAccount, error) {
+	baseLockup, err := newBaseLockup(d)
+	if err != nil {
+		return nil, err
+	}
+	return &ContinuousLockingAccount{BaseLockup: baseLockup}, nil
+}
+
+// ContinuousLockingAccount implements a lockup account whose funds unlock
+// linearly between its start time and its end time.
+type ContinuousLockingAccount struct {
+	*BaseLockup
+}
+
+// Init initializes the owner and the lockup times of the account. The funds
+// sent with the account creation are locked.
+func (cla ContinuousLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (*lockuptypes.MsgInitLockupAccountResponse, error) {
+	startTime, err := cla.init(ctx, msg.Owner, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if msg.EndTime <= startTime {
+		return nil, fmt.Errorf("%w: end time must be after the start time", ErrInvalidLockup)
+	}
+	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+}
+
+// GetLockedCoins returns the amount of the original locking still locked at
+// the given time.
+func (cla ContinuousLockingAccount) GetLockedCoins(ctx context.Context, now time.Time) (sdk.Coins, error) {
+	originalLocking, err := cla.coins(ctx, cla.OriginalLocking)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := cla.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case now.Unix() <= startTime:
+		return originalLocking, nil
+	case now.Unix() >= endTime:
+		return sdk.NewCoins(), nil
+	}
+
+	// the unlocked amount is proportional to the time elapsed since the start time
+	elapsed := math.NewInt(now.Unix() - startTime)
+	duration := math.NewInt(endTime - startTime)
+	lockedCoins := sdk.NewCoins()
+	for _, coin := range originalLocking {
+		unlocked := coin.Amount.Mul(elapsed).Quo(duration)
+		lockedCoins = lockedCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(unlocked)))
+	}
+	return lockedCoins, nil
+}
+
+func (cla ContinuousLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return cla.BaseLockup.Delegate(ctx, msg, cla.GetLockedCoins)
+}
+
+func (cla ContinuousLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return cla.BaseLockup.SendCoins(ctx, msg, cla.GetLockedCoins)
+}
+
+func (cla ContinuousLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (*lockuptypes.MsgWithdrawResponse, error) {
+	return cla.BaseLockup.WithdrawUnlockedCoins(ctx, msg, cla.GetLockedCoins)
+}
+
+func (cla ContinuousLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	return cla.QueryLockupAccountBaseInfo(ctx, cla.GetLockedCoins)
+}
+
+func (cla ContinuousLockingAccount) QuerySpendableAmount(ctx context.Context, _ *lockuptypes.QuerySpendableAmountRequest) (*lockuptypes.QuerySpendableAmountResponse, error) {
+	return cla.QuerySpendableTokens(ctx, cla.GetLockedCoins)
+}
+
+func (cla ContinuousLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, cla.Init)
+}
+
+func (cla ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, cla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, cla.Undelegate)
+	accountstd.RegisterExecuteHandler(builder, cla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, cla.WithdrawUnlockedCoins)
+}
+
+func (cla ContinuousLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, cla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, cla.QuerySpendableAmount)
+}
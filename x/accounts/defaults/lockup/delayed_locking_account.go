@@ -0,0 +1,91 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ accountstd.Interface = (*DelayedLockingAccount)(nil)
+
+// NewDelayedLockingAccount creates a new delayed locking account.
+func NewDelayedLockingAccount(d accountstd.Dependencies) (*DelayedLockingAccount, error) {
+	baseLockup, err := newBaseLockup(d)
+	if err != nil {
+		return nil, err
+	}
+	return &DelayedLockingAccount{BaseLockup: baseLockup}, nil
+}
+
+// DelayedLockingAccount implements a lockup account whose funds all unlock at
+// its end time.
+type DelayedLockingAccount struct {
+	*BaseLockup
+}
+
+// Init initializes the owner and the lockup times of the account. The funds
+// sent with the account creation are locked.
+func (dla DelayedLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (*lockuptypes.MsgInitLockupAccountResponse, error) {
+	startTime, err := dla.init(ctx, msg.Owner, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if msg.EndTime <= startTime {
+		return nil, fmt.Errorf("%w: end time must be after the start time", ErrInvalidLockup)
+	}
+	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+}
+
+// GetLockedCoins returns the amount of the original locking still locked at
+// the given time.
+func (dla DelayedLockingAccount) GetLockedCoins(ctx context.Context, now time.Time) (sdk.Coins, error) {
+	endTime, err := dla.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if now.Unix() >= endTime {
+		return sdk.NewCoins(), nil
+	}
+	return dla.coins(ctx, dla.OriginalLocking)
+}
+
+func (dla DelayedLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return dla.BaseLockup.Delegate(ctx, msg, dla.GetLockedCoins)
+}
+
+func (dla DelayedLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return dla.BaseLockup.SendCoins(ctx, msg, dla.GetLockedCoins)
+}
+
+func (dla DelayedLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (*lockuptypes.MsgWithdrawResponse, error) {
+	return dla.BaseLockup.WithdrawUnlockedCoins(ctx, msg, dla.GetLockedCoins)
+}
+
+func (dla DelayedLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	return dla.QueryLockupAccountBaseInfo(ctx, dla.GetLockedCoins)
+}
+
+func (dla DelayedLockingAccount) QuerySpendableAmount(ctx context.Context, _ *lockuptypes.QuerySpendableAmountRequest) (*lockuptypes.QuerySpendableAmountResponse, error) {
+	return dla.QuerySpendableTokens(ctx, dla.GetLockedCoins)
+}
+
+func (dla DelayedLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, dla.Init)
+}
+
+func (dla DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, dla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, dla.Undelegate)
+	accountstd.RegisterExecuteHandler(builder, dla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, dla.WithdrawUnlockedCoins)
+}
+
+func (dla DelayedLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, dla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, dla.QuerySpendableAmount)
+}
@@ -0,0 +1,482 @@
+package lockup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	OwnerPrefix            = collections.NewPrefix(0)
+	OriginalLockingPrefix  = collections.NewPrefix(1)
+	DelegatedFreePrefix    = collections.NewPrefix(2)
+	DelegatedLockingPrefix = collections.NewPrefix(3)
+	StartTimePrefix        = collections.NewPrefix(4)
+	EndTimePrefix          = collections.NewPrefix(5)
+	LockingPeriodsPrefix   = collections.NewPrefix(6)
+)
+
+var (
+	// ErrUnauthorized is returned when the sender of a message is not the owner of the account.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrInsufficientFunds is returned when the unlocked funds of the account do not cover an amount.
+	ErrInsufficientFunds = errors.New("insufficient unlocked funds")
+	// ErrInvalidLockup is returned when the lockup parameters of an account are invalid.
+	ErrInvalidLockup = errors.New("invalid lockup")
+)
+
+// getLockedCoinsFunc returns the amount of the original locking still locked
+// at the given time.
+type getLockedCoinsFunc = func(ctx context.Context, now time.Time) (sdk.Coins, error)
+
+// newBaseLockup creates the state shared by all the lockup accounts.
+func newBaseLockup(d accountstd.Dependencies) (*BaseLockup, error) {
+	if d.HeaderService == nil {
+		return nil, errors.New("header service is required")
+	}
+	return &BaseLockup{
+		Owner:            collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		OriginalLocking:  collections.NewMap(d.SchemaBuilder, OriginalLockingPrefix, "original_locking", collections.StringKey, sdk.IntValue),
+		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
+		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
+		StartTime:        collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collections.Int64Value),
+		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collections.Int64Value),
+		addressCodec:     d.AddressCodec,
+		headerService:    d.HeaderService,
+	}, nil
+}
+
+// BaseLockup holds the funds of a lockup account in the account address. The
+// owner of the account can delegate and undelegate them, locked or not, and
+// send the unlocked ones. How the funds unlock over time is defined by each
+// lockup account.
+type BaseLockup struct {
+	// Owner is the address of the owner of the account.
+	Owner collections.Item[[]byte]
+	// OriginalLocking maps denoms to the amount locked at the creation of the account.
+	OriginalLocking collections.Map[string, math.Int]
+	// DelegatedFree maps denoms to the amount of unlocked funds delegated.
+	DelegatedFree collections.Map[string, math.Int]
+	// DelegatedLocking maps denoms to the amount of locked funds delegated.
+	DelegatedLocking collections.Map[string, math.Int]
+	// StartTime is the unix time in seconds at which the funds start unlocking.
+	StartTime collections.Item[int64]
+	// EndTime is the unix time in seconds at which all the funds are unlocked.
+	EndTime collections.Item[int64]
+
+	addressCodec  address.Codec
+	headerService header.Service
+}
+
+// init sets the owner, the lockup times and the original locking, which are the
+// funds sent with the account creation. It returns the start time, which
+// defaults to the block time.
+func (bl BaseLockup) init(ctx context.Context, owner string, startTime, endTime int64) (int64, error) {
+	ownerAddr, err := bl.addressCodec.StringToBytes(owner)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid owner address %s: %w", ErrInvalidLockup, owner, err)
+	}
+	if err := bl.Owner.Set(ctx, ownerAddr); err != nil {
+		return 0, err
+	}
+
+	if startTime == 0 {
+		startTime = bl.now(ctx).Unix()
+	}
+	if startTime < 0 || endTime < 0 {
+		return 0, fmt.Errorf("%w: lockup times cannot be negative", ErrInvalidLockup)
+	}
+	if err := bl.StartTime.Set(ctx, startTime); err != nil {
+		return 0, err
+	}
+	if err := bl.EndTime.Set(ctx, endTime); err != nil {
+		return 0, err
+	}
+
+	for _, coin := range accountstd.Funds(ctx) {
+		if err := bl.OriginalLocking.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			return 0, err
+		}
+	}
+	return startTime, nil
+}
+
+// Delegate delegates funds of the account to a validator. The locked funds
+// which are not delegated yet are delegated first.
+func (bl BaseLockup) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate, getLockedCoins getLockedCoinsFunc) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	if err := bl.checkSender(ctx); err != nil {
+		return nil, err
+	}
+	whoami, err := bl.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := bl.balance(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if balance.LT(msg.Amount.Amount) {
+		return nil, fmt.Errorf("%w: delegation amount %s is greater than the balance %s%s", ErrInsufficientFunds, msg.Amount, balance, msg.Amount.Denom)
+	}
+	lockedCoins, err := getLockedCoins(ctx, bl.now(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := bl.trackDelegation(ctx, lockedCoins.AmountOf(msg.Amount.Denom), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	resp, err := accountstd.ExecModule[stakingv1beta1.MsgDelegateResponse](ctx, &stakingv1beta1.MsgDelegate{
+		DelegatorAddress: whoami,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           toAPICoin(msg.Amount),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executeMessagesResponse(resp)
+}
+
+// Undelegate undelegates funds of the account from a validator. The unlocked
+// delegated funds are undelegated first. The undelegation is tracked when it
+// starts, so the undelegated locked funds are not spendable before they are
+// back in the account.
+func (bl BaseLockup) Undelegate(ctx context.Context, msg *lockuptypes.MsgUndelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	if err := bl.checkSender(ctx); err != nil {
+		return nil, err
+	}
+	whoami, err := bl.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bl.trackUndelegation(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	resp, err := accountstd.ExecModule[stakingv1beta1.MsgUndelegateResponse](ctx, &stakingv1beta1.MsgUndelegate{
+		DelegatorAddress: whoami,
+		ValidatorAddress: msg.ValidatorAddress,
+		Amount:           toAPICoin(msg.Amount),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executeMessagesResponse(resp)
+}
+
+// SendCoins sends unlocked funds of the account.
+func (bl BaseLockup) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend, getLockedCoins getLockedCoinsFunc) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	if err := bl.checkSender(ctx); err != nil {
+		return nil, err
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, fmt.Errorf("invalid amount %s", msg.Amount)
+	}
+
+	spendable, err := bl.spendableCoins(ctx, msg.Amount.Denoms(), getLockedCoins)
+	if err != nil {
+		return nil, err
+	}
+	if !spendable.IsAllGTE(msg.Amount) {
+		return nil, fmt.Errorf("%w: %s is greater than the spendable amount %s", ErrInsufficientFunds, msg.Amount, spendable)
+	}
+
+	resp, err := bl.send(ctx, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return executeMessagesResponse(resp)
+}
+
+// WithdrawUnlockedCoins sends all the unlocked funds of the account for the
+// given denoms, or for the denoms of the original locking if none is given.
+func (bl BaseLockup) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw, getLockedCoins getLockedCoinsFunc) (*lockuptypes.MsgWithdrawResponse, error) {
+	if err := bl.checkSender(ctx); err != nil {
+		return nil, err
+	}
+
+	denoms := msg.Denoms
+	if len(denoms) == 0 {
+		originalLocking, err := bl.coins(ctx, bl.OriginalLocking)
+		if err != nil {
+			return nil, err
+		}
+		denoms = originalLocking.Denoms()
+	}
+	spendable, err := bl.spendableCoins(ctx, denoms, getLockedCoins)
+	if err != nil {
+		return nil, err
+	}
+	if spendable.IsZero() {
+		return nil, fmt.Errorf("%w: no funds to withdraw", ErrInsufficientFunds)
+	}
+
+	if _, err := bl.send(ctx, msg.ToAddress, spendable); err != nil {
+		return nil, err
+	}
+	return &lockuptypes.MsgWithdrawResponse{AmountReceived: spendable}, nil
+}
+
+// QuerySpendableTokens returns the funds of the account that are not locked.
+func (bl BaseLockup) QuerySpendableTokens(ctx context.Context, getLockedCoins getLockedCoinsFunc) (*lockuptypes.QuerySpendableAmountResponse, error) {
+	whoami, err := bl.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := accountstd.QueryModule[bankv1beta1.QueryAllBalancesResponse](ctx, &bankv1beta1.QueryAllBalancesRequest{Address: whoami})
+	if err != nil {
+		return nil, err
+	}
+	balances, err := fromAPICoins(resp.Balances)
+	if err != nil {
+		return nil, err
+	}
+
+	spendable, err := bl.spendableFromBalances(ctx, balances, getLockedCoins)
+	if err != nil {
+		return nil, err
+	}
+	return &lockuptypes.QuerySpendableAmountResponse{SpendableTokens: spendable}, nil
+}
+
+// QueryLockupAccountBaseInfo returns the locking state of the account.
+func (bl BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, getLockedCoins getLockedCoinsFunc) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	owner, err := bl.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerAddr, err := bl.addressCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := bl.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := bl.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	originalLocking, err := bl.coins(ctx, bl.OriginalLocking)
+	if err != nil {
+		return nil, err
+	}
+	delegatedFree, err := bl.coins(ctx, bl.DelegatedFree)
+	if err != nil {
+		return nil, err
+	}
+	delegatedLocking, err := bl.coins(ctx, bl.DelegatedLocking)
+	if err != nil {
+		return nil, err
+	}
+	lockedCoins, err := getLockedCoins(ctx, bl.now(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.QueryLockupAccountInfoResponse{
+		Owner:            ownerAddr,
+		OriginalLocking:  originalLocking,
+		DelegatedFree:    delegatedFree,
+		DelegatedLocking: delegatedLocking,
+		StartTime:        startTime,
+		EndTime:          endTime,
+		LockedCoins:      lockedCoins,
+		UnlockedCoins:    originalLocking.Sub(lockedCoins...),
+	}, nil
+}
+
+// trackDelegation tracks a delegation. The locked funds which are not delegated
+// yet are delegated first, the rest of the amount is unlocked funds.
+func (bl BaseLockup) trackDelegation(ctx context.Context, locked math.Int, amount sdk.Coin) error {
+	delegatedLocking, err := bl.amountOf(ctx, bl.DelegatedLocking, amount.Denom)
+	if err != nil {
+		return err
+	}
+	delegatedFree, err := bl.amountOf(ctx, bl.DelegatedFree, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	// x = min(max(locked - delegatedLocking, 0), amount)
+	x := math.MinInt(math.MaxInt(locked.Sub(delegatedLocking), math.ZeroInt()), amount.Amount)
+	// y = amount - x
+	y := amount.Amount.Sub(x)
+
+	if err := bl.DelegatedLocking.Set(ctx, amount.Denom, delegatedLocking.Add(x)); err != nil {
+		return err
+	}
+	return bl.DelegatedFree.Set(ctx, amount.Denom, delegatedFree.Add(y))
+}
+
+// trackUndelegation tracks an undelegation. The unlocked delegated funds are
+// undelegated first, the rest of the amount is locked funds. The amounts are
+// capped at the delegated amounts, as slashed delegations cannot be tracked.
+func (bl BaseLockup) trackUndelegation(ctx context.Context, amount sdk.Coin) error {
+	delegatedLocking, err := bl.amountOf(ctx, bl.DelegatedLocking, amount.Denom)
+	if err != nil {
+		return err
+	}
+	delegatedFree, err := bl.amountOf(ctx, bl.DelegatedFree, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	// x = min(delegatedFree, amount)
+	x := math.MinInt(delegatedFree, amount.Amount)
+	// y = min(delegatedLocking, amount - x)
+	y := math.MinInt(delegatedLocking, amount.Amount.Sub(x))
+
+	if err := bl.DelegatedFree.Set(ctx, amount.Denom, delegatedFree.Sub(x)); err != nil {
+		return err
+	}
+	return bl.DelegatedLocking.Set(ctx, amount.Denom, delegatedLocking.Sub(y))
+}
+
+// spendableCoins returns the unlocked funds of the account for the given denoms.
+func (bl BaseLockup) spendableCoins(ctx context.Context, denoms []string, getLockedCoins getLockedCoinsFunc) (sdk.Coins, error) {
+	balances := sdk.NewCoins()
+	for _, denom := range denoms {
+		balance, err := bl.balance(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		balances = balances.Add(sdk.NewCoin(denom, balance))
+	}
+	return bl.spendableFromBalances(ctx, balances, getLockedCoins)
+}
+
+// spendableFromBalances returns the part of the balances that is not locked.
+// The locked funds which are delegated are not part of the balances, so only
+// the locked funds which are not delegated are subtracted from them.
+func (bl BaseLockup) spendableFromBalances(ctx context.Context, balances sdk.Coins, getLockedCoins getLockedCoinsFunc) (sdk.Coins, error) {
+	lockedCoins, err := getLockedCoins(ctx, bl.now(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	spendable := sdk.NewCoins()
+	for _, balance := range balances {
+		delegatedLocking, err := bl.amountOf(ctx, bl.DelegatedLocking, balance.Denom)
+		if err != nil {
+			return nil, err
+		}
+		locked := math.MaxInt(lockedCoins.AmountOf(balance.Denom).Sub(delegatedLocking), math.ZeroInt())
+		if balance.Amount.GT(locked) {
+			spendable = spendable.Add(sdk.NewCoin(balance.Denom, balance.Amount.Sub(locked)))
+		}
+	}
+	return spendable, nil
+}
+
+// send sends funds of the account with a bank MsgSend.
+func (bl BaseLockup) send(ctx context.Context, toAddress string, amount sdk.Coins) (*bankv1beta1.MsgSendResponse, error) {
+	whoami, err := bl.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	msgSend := &bankv1beta1.MsgSend{FromAddress: whoami, ToAddress: toAddress}
+	for _, coin := range amount {
+		msgSend.Amount = append(msgSend.Amount, toAPICoin(coin))
+	}
+	return accountstd.ExecModule[bankv1beta1.MsgSendResponse](ctx, msgSend)
+}
+
+// balance returns the balance of the account for the given denom.
+func (bl BaseLockup) balance(ctx context.Context, denom string) (math.Int, error) {
+	whoami, err := bl.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return math.Int{}, err
+	}
+	resp, err := accountstd.QueryModule[bankv1beta1.QueryBalanceResponse](ctx, &bankv1beta1.QueryBalanceRequest{Address: whoami, Denom: denom})
+	if err != nil {
+		return math.Int{}, err
+	}
+	if resp.Balance == nil {
+		return math.ZeroInt(), nil
+	}
+	amount, ok := math.NewIntFromString(resp.Balance.Amount)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid balance amount %s", resp.Balance.Amount)
+	}
+	return amount, nil
+}
+
+// checkSender returns an error if the sender is not the owner of the account.
+func (bl BaseLockup) checkSender(ctx context.Context) error {
+	owner, err := bl.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(accountstd.Sender(ctx), owner) {
+		return fmt.Errorf("%w: sender is not the owner of the account", ErrUnauthorized)
+	}
+	return nil
+}
+
+// coins returns the coins of an amounts map.
+func (bl BaseLockup) coins(ctx context.Context, amounts collections.Map[string, math.Int]) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	err := amounts.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		coins = coins.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	return coins, err
+}
+
+// amountOf returns the amount of a denom in an amounts map, or zero.
+func (bl BaseLockup) amountOf(ctx context.Context, amounts collections.Map[string, math.Int], denom string) (math.Int, error) {
+	amount, err := amounts.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return amount, err
+}
+
+func (bl BaseLockup) now(ctx context.Context) time.Time {
+	return bl.headerService.GetHeaderInfo(ctx).Time
+}
+
+func executeMessagesResponse(resp gogoproto.Message) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	respAny, err := accountstd.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &lockuptypes.MsgExecuteMessagesResponse{Responses: []*codectypes.Any{respAny}}, nil
+}
+
+func toAPICoin(coin sdk.Coin) *basev1beta1.Coin {
+	return &basev1beta1.Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+}
+
+func fromAPICoins(coins []*basev1beta1.Coin) (sdk.Coins, error) {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %s%s", coin.Amount, coin.Denom)
+		}
+		result = result.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return result, nil
+}
@@ -0,0 +1,293 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	lockupAddr = "lockup"
+	owner      = "owner"
+	startTime  = 1_000_000
+)
+
+type addressCodec struct{}
+
+func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{ time time.Time }
+
+func (h *headerService) GetHeaderInfo(context.Context) header.Info { return header.Info{Time: h.time} }
+
+// fixture runs a lockup account in account contexts, as x/accounts does, with
+// mocked bank balances and delegations. Undelegated funds are not returned to
+// the account, as if they were still unbonding.
+type fixture struct {
+	t            *testing.T
+	storeService store.KVStoreService
+	ctx          context.Context
+	header       *headerService
+	balances     map[string]sdk.Coins
+	delegated    sdk.Coins
+}
+
+func newFixture(t *testing.T) (*fixture, accountstd.Dependencies) {
+	t.Helper()
+	storeService, ctx := colltest.MockStore()
+	f := &fixture{
+		t:            t,
+		storeService: storeService,
+		ctx:          ctx,
+		header:       &headerService{time: time.Unix(startTime, 0)},
+		balances:     map[string]sdk.Coins{},
+		delegated:    sdk.NewCoins(),
+	}
+	sb := collections.NewSchemaBuilderFromAccessor(implementation.OpenKVStore)
+	return f, accountstd.Dependencies{SchemaBuilder: sb, AddressCodec: addressCodec{}, HeaderService: f.header}
+}
+
+// accountContext returns the context of a message sent to the account. The
+// funds are moved from the sender to the account, as x/accounts does on init.
+func (f *fixture) accountContext(sender string, funds sdk.Coins) context.Context {
+	if !funds.IsZero() {
+		f.balances[sender] = f.balances[sender].Sub(funds...)
+		f.balances[lockupAddr] = f.balances[lockupAddr].Add(funds...)
+	}
+	return implementation.MakeAccountContext(f.ctx, f.storeService, 1, []byte(lockupAddr), []byte(sender), funds, f.execModule, nil, f.queryModule)
+}
+
+func (f *fixture) execModule(_ context.Context, sender []byte, msg, msgResp implementation.ProtoMsg) error {
+	require.Equal(f.t, lockupAddr, string(sender))
+	switch msg := msg.(type) {
+	case *bankv1beta1.MsgSend:
+		amount := f.coins(msg.Amount)
+		balance, hasNeg := f.balances[msg.FromAddress].SafeSub(amount...)
+		if hasNeg {
+			return fmt.Errorf("insufficient funds")
+		}
+		f.balances[msg.FromAddress] = balance
+		f.balances[msg.ToAddress] = f.balances[msg.ToAddress].Add(amount...)
+	case *stakingv1beta1.MsgDelegate:
+		amount := f.coins([]*basev1beta1.Coin{msg.Amount})
+		f.balances[msg.DelegatorAddress] = f.balances[msg.DelegatorAddress].Sub(amount...)
+		f.delegated = f.delegated.Add(amount...)
+	case *stakingv1beta1.MsgUndelegate:
+		f.delegated = f.delegated.Sub(f.coins([]*basev1beta1.Coin{msg.Amount})...)
+	default:
+		return fmt.Errorf("unexpected message %T", msg)
+	}
+	return nil
+}
+
+func (f *fixture) queryModule(_ context.Context, req, resp implementation.ProtoMsg) error {
+	switch req := req.(type) {
+	case *bankv1beta1.QueryBalanceRequest:
+		amount := f.balances[req.Address].AmountOf(req.Denom)
+		resp.(*bankv1beta1.QueryBalanceResponse).Balance = toAPICoin(sdk.NewCoin(req.Denom, amount))
+	case *bankv1beta1.QueryAllBalancesRequest:
+		for _, coin := range f.balances[req.Address] {
+			resp.(*bankv1beta1.QueryAllBalancesResponse).Balances = append(resp.(*bankv1beta1.QueryAllBalancesResponse).Balances, toAPICoin(coin))
+		}
+	default:
+		return fmt.Errorf("unexpected query %T", req)
+	}
+	return nil
+}
+
+func (f *fixture) coins(coins []*basev1beta1.Coin) sdk.Coins {
+	f.t.Helper()
+	result, err := fromAPICoins(coins)
+	require.NoError(f.t, err)
+	return result
+}
+
+func (f *fixture) advance(d time.Duration) {
+	f.header.time = f.header.time.Add(d)
+}
+
+func stake(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func TestContinuousLockingAccount(t *testing.T) {
+	f, deps := newFixture(t)
+	acc, err := NewContinuousLockingAccount(deps)
+	require.NoError(t, err)
+	f.balances[owner] = stake(1000)
+
+	_, err = acc.Init(f.accountContext(owner, nil), &lockuptypes.MsgInitLockupAccount{Owner: owner, EndTime: startTime})
+	require.ErrorIs(t, err, ErrInvalidLockup)
+	_, err = acc.Init(f.accountContext(owner, stake(100)), &lockuptypes.MsgInitLockupAccount{Owner: owner, EndTime: startTime + 100})
+	require.NoError(t, err)
+
+	info, err := acc.QueryLockupAccountInfo(f.accountContext("", nil), &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, owner, info.Owner)
+	require.Equal(t, int64(startTime), info.StartTime)
+	require.Equal(t, stake(100), info.OriginalLocking)
+	require.Equal(t, stake(100), info.LockedCoins)
+
+	// a quarter of the funds unlock after a quarter of the lockup
+	f.advance(25 * time.Second)
+	spendable, err := acc.QuerySpendableAmount(f.accountContext("", nil), &lockuptypes.QuerySpendableAmountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stake(25), spendable.SpendableTokens)
+
+	_, err = acc.SendCoins(f.accountContext("other", nil), &lockuptypes.MsgSend{ToAddress: "other", Amount: stake(10)})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	_, err = acc.SendCoins(f.accountContext(owner, nil), &lockuptypes.MsgSend{ToAddress: "other", Amount: stake(30)})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+	_, err = acc.SendCoins(f.accountContext(owner, nil), &lockuptypes.MsgSend{ToAddress: "other", Amount: stake(10)})
+	require.NoError(t, err)
+	require.Equal(t, stake(10), f.balances["other"])
+
+	// everything is unlocked at the end time
+	f.advance(time.Hour)
+	resp, err := acc.WithdrawUnlockedCoins(f.accountContext(owner, nil), &lockuptypes.MsgWithdraw{ToAddress: owner})
+	require.NoError(t, err)
+	require.Equal(t, stake(90), resp.AmountReceived)
+	require.Equal(t, stake(990), f.balances[owner])
+
+	_, err = acc.WithdrawUnlockedCoins(f.accountContext(owner, nil), &lockuptypes.MsgWithdraw{ToAddress: owner})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestDelayedLockingAccount(t *testing.T) {
+	f, deps := newFixture(t)
+	acc, err := NewDelayedLockingAccount(deps)
+	require.NoError(t, err)
+	f.balances[owner] = stake(100)
+
+	_, err = acc.Init(f.accountContext(owner, stake(100)), &lockuptypes.MsgInitLockupAccount{Owner: owner, EndTime: startTime + 100})
+	require.NoError(t, err)
+
+	f.advance(99 * time.Second)
+	_, err = acc.WithdrawUnlockedCoins(f.accountContext(owner, nil), &lockuptypes.MsgWithdraw{ToAddress: owner, Denoms: []string{"stake"}})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	f.advance(time.Second)
+	resp, err := acc.WithdrawUnlockedCoins(f.accountContext(owner, nil), &lockuptypes.MsgWithdraw{ToAddress: owner, Denoms: []string{"stake"}})
+	require.NoError(t, err)
+	require.Equal(t, stake(100), resp.AmountReceived)
+}
+
+func TestPeriodicLockingAccount(t *testing.T) {
+	f, deps := newFixture(t)
+	acc, err := NewPeriodicLockingAccount(deps)
+	require.NoError(t, err)
+	f.balances[owner] = stake(1000)
+
+	periods := []lockuptypes.Period{
+		{Length: 10, Amount: stake(30)},
+		{Length: 20, Amount: stake(70)},
+	}
+	_, err = acc.Init(f.accountContext(owner, nil), &lockuptypes.MsgInitPeriodicLockingAccount{Owner: owner, LockingPeriods: periods})
+	require.ErrorIs(t, err, ErrInvalidLockup)
+	_, err = acc.Init(f.accountContext(owner, stake(100)), &lockuptypes.MsgInitPeriodicLockingAccount{Owner: owner, LockingPeriods: periods})
+	require.NoError(t, err)
+
+	lockingPeriods, err := acc.QueryLockingPeriods(f.accountContext("", nil), &lockuptypes.QueryLockingPeriodsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, periods, lockingPeriods.LockingPeriods)
+
+	testCases := []struct {
+		elapsed time.Duration
+		locked  sdk.Coins
+	}{
+		{elapsed: 9 * time.Second, locked: stake(100)},
+		{elapsed: 10 * time.Second, locked: stake(70)},
+		{elapsed: 29 * time.Second, locked: stake(70)},
+		{elapsed: 30 * time.Second, locked: sdk.NewCoins()},
+	}
+	for _, tc := range testCases {
+		f.header.time = time.Unix(startTime, 0).Add(tc.elapsed)
+		info, err := acc.QueryLockupAccountInfo(f.accountContext("", nil), &lockuptypes.QueryLockupAccountInfoRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int64(startTime+30), info.EndTime)
+		require.Equal(t, tc.locked, info.LockedCoins, tc.elapsed)
+		require.Equal(t, stake(100).Sub(tc.locked...), info.UnlockedCoins, tc.elapsed)
+	}
+}
+
+func TestPermanentLockingAccount(t *testing.T) {
+	f, deps := newFixture(t)
+	acc, err := NewPermanentLockingAccount(deps)
+	require.NoError(t, err)
+	f.balances[owner] = stake(100)
+
+	_, err = acc.Init(f.accountContext(owner, stake(100)), &lockuptypes.MsgInitLockupAccount{Owner: owner})
+	require.NoError(t, err)
+
+	f.advance(24 * 365 * time.Hour)
+	_, err = acc.SendCoins(f.accountContext(owner, nil), &lockuptypes.MsgSend{ToAddress: owner, Amount: stake(1)})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// the funds sent to the account after its creation are spendable
+	f.balances[lockupAddr] = f.balances[lockupAddr].Add(stake(5)...)
+	spendable, err := acc.QuerySpendableAmount(f.accountContext("", nil), &lockuptypes.QuerySpendableAmountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stake(5), spendable.SpendableTokens)
+}
+
+func TestDelegation(t *testing.T) {
+	f, deps := newFixture(t)
+	acc, err := NewContinuousLockingAccount(deps)
+	require.NoError(t, err)
+	f.balances[owner] = stake(100)
+
+	_, err = acc.Init(f.accountContext(owner, stake(100)), &lockuptypes.MsgInitLockupAccount{Owner: owner, EndTime: startTime + 100})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(f.accountContext("other", nil), &lockuptypes.MsgDelegate{ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 10)})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	_, err = acc.Delegate(f.accountContext(owner, nil), &lockuptypes.MsgDelegate{ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 101)})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// locked funds are delegated first
+	f.advance(50 * time.Second)
+	resp, err := acc.Delegate(f.accountContext(owner, nil), &lockuptypes.MsgDelegate{ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 80)})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 1)
+	require.Equal(t, stake(80), f.delegated)
+
+	info, err := acc.QueryLockupAccountInfo(f.accountContext("", nil), &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stake(50), info.DelegatedLocking)
+	require.Equal(t, stake(30), info.DelegatedFree)
+
+	// all the funds left in the account are unlocked
+	spendable, err := acc.QuerySpendableAmount(f.accountContext("", nil), &lockuptypes.QuerySpendableAmountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stake(20), spendable.SpendableTokens)
+
+	// unlocked delegated funds are undelegated first
+	_, err = acc.Undelegate(f.accountContext(owner, nil), &lockuptypes.MsgUndelegate{ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 40)})
+	require.NoError(t, err)
+	info, err = acc.QueryLockupAccountInfo(f.accountContext("", nil), &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stake(40), info.DelegatedLocking)
+	require.True(t, info.DelegatedFree.IsZero())
+
+	// the undelegated locked funds are not spendable while they are unbonding
+	spendable, err = acc.QuerySpendableAmount(f.accountContext("", nil), &lockuptypes.QuerySpendableAmountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stake(10), spendable.SpendableTokens)
+}
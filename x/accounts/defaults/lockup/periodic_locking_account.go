@@ -0,0 +1,147 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ accountstd.Interface = (*PeriodicLockingAccount)(nil)
+
+// NewPeriodicLockingAccount creates a new periodic locking account.
+func NewPeriodicLockingAccount(d accountstd.Dependencies) (*PeriodicLockingAccount, error) {
+	baseLockup, err := newBaseLockup(d)
+	if err != nil {
+		return nil, err
+	}
+	return &PeriodicLockingAccount{
+		BaseLockup:     baseLockup,
+		LockingPeriods: collections.NewMap(d.SchemaBuilder, LockingPeriodsPrefix, "locking_periods", collections.Uint64Key, accountstd.CollValue[lockuptypes.Period]()),
+	}, nil
+}
+
+// PeriodicLockingAccount implements a lockup account whose funds unlock at the
+// end of consecutive locking periods, starting at its start time.
+type PeriodicLockingAccount struct {
+	*BaseLockup
+	// LockingPeriods maps the index of each locking period to the locking period.
+	LockingPeriods collections.Map[uint64, lockuptypes.Period]
+}
+
+// Init initializes the owner and the locking periods of the account. The funds
+// sent with the account creation are locked, and must equal the total amount
+// of the locking periods.
+func (pla PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitPeriodicLockingAccount) (*lockuptypes.MsgInitPeriodicLockingAccountResponse, error) {
+	if len(msg.LockingPeriods) == 0 {
+		return nil, fmt.Errorf("%w: locking periods cannot be empty", ErrInvalidLockup)
+	}
+	var totalLength int64
+	totalAmount := sdk.NewCoins()
+	for i, period := range msg.LockingPeriods {
+		if period.Length <= 0 {
+			return nil, fmt.Errorf("%w: length of locking period %d must be positive", ErrInvalidLockup, i)
+		}
+		if !period.Amount.IsValid() {
+			return nil, fmt.Errorf("%w: invalid amount %s of locking period %d", ErrInvalidLockup, period.Amount, i)
+		}
+		totalLength += period.Length
+		totalAmount = totalAmount.Add(period.Amount...)
+		if err := pla.LockingPeriods.Set(ctx, uint64(i), period); err != nil {
+			return nil, err
+		}
+	}
+	if funds := accountstd.Funds(ctx); !totalAmount.Equal(funds) {
+		return nil, fmt.Errorf("%w: total amount %s of the locking periods does not equal the funds %s", ErrInvalidLockup, totalAmount, funds)
+	}
+
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = pla.now(ctx).Unix()
+	}
+	if _, err := pla.init(ctx, msg.Owner, startTime, startTime+totalLength); err != nil {
+		return nil, err
+	}
+	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
+}
+
+// GetLockedCoins returns the amount of the original locking still locked at
+// the given time, which is the amount of the locking periods that did not end.
+func (pla PeriodicLockingAccount) GetLockedCoins(ctx context.Context, now time.Time) (sdk.Coins, error) {
+	originalLocking, err := pla.coins(ctx, pla.OriginalLocking)
+	if err != nil {
+		return nil, err
+	}
+	periodEnd, err := pla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockedCoins := sdk.NewCoins()
+	err = pla.LockingPeriods.Walk(ctx, nil, func(_ uint64, period lockuptypes.Period) (bool, error) {
+		periodEnd += period.Length
+		if now.Unix() < periodEnd {
+			return true, nil
+		}
+		unlockedCoins = unlockedCoins.Add(period.Amount...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return originalLocking.Sub(unlockedCoins...), nil
+}
+
+func (pla PeriodicLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pla.BaseLockup.Delegate(ctx, msg, pla.GetLockedCoins)
+}
+
+func (pla PeriodicLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pla.BaseLockup.SendCoins(ctx, msg, pla.GetLockedCoins)
+}
+
+func (pla PeriodicLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (*lockuptypes.MsgWithdrawResponse, error) {
+	return pla.BaseLockup.WithdrawUnlockedCoins(ctx, msg, pla.GetLockedCoins)
+}
+
+func (pla PeriodicLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	return pla.QueryLockupAccountBaseInfo(ctx, pla.GetLockedCoins)
+}
+
+func (pla PeriodicLockingAccount) QuerySpendableAmount(ctx context.Context, _ *lockuptypes.QuerySpendableAmountRequest) (*lockuptypes.QuerySpendableAmountResponse, error) {
+	return pla.QuerySpendableTokens(ctx, pla.GetLockedCoins)
+}
+
+func (pla PeriodicLockingAccount) QueryLockingPeriods(ctx context.Context, _ *lockuptypes.QueryLockingPeriodsRequest) (*lockuptypes.QueryLockingPeriodsResponse, error) {
+	var lockingPeriods []lockuptypes.Period
+	err := pla.LockingPeriods.Walk(ctx, nil, func(_ uint64, period lockuptypes.Period) (bool, error) {
+		lockingPeriods = append(lockingPeriods, period)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lockuptypes.QueryLockingPeriodsResponse{LockingPeriods: lockingPeriods}, nil
+}
+
+func (pla PeriodicLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, pla.Init)
+}
+
+func (pla PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, pla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, pla.Undelegate)
+	accountstd.RegisterExecuteHandler(builder, pla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, pla.WithdrawUnlockedCoins)
+}
+
+func (pla PeriodicLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, pla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, pla.QuerySpendableAmount)
+	accountstd.RegisterQueryHandler(builder, pla.QueryLockingPeriods)
+}
@@ -0,0 +1,78 @@
+package lockup
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ accountstd.Interface = (*PermanentLockingAccount)(nil)
+
+// NewPermanentLockingAccount creates a new permanent locking account.
+func NewPermanentLockingAccount(d accountstd.Dependencies) (*PermanentLockingAccount, error) {
+	baseLockup, err := newBaseLockup(d)
+	if err != nil {
+		return nil, err
+	}
+	return &PermanentLockingAccount{BaseLockup: baseLockup}, nil
+}
+
+// PermanentLockingAccount implements a lockup account whose funds never
+// unlock. They can only be delegated, and the staking rewards are spendable.
+type PermanentLockingAccount struct {
+	*BaseLockup
+}
+
+// Init initializes the owner of the account. The funds sent with the account
+// creation are locked forever, and the end time is ignored.
+func (pla PermanentLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (*lockuptypes.MsgInitLockupAccountResponse, error) {
+	if _, err := pla.init(ctx, msg.Owner, msg.StartTime, 0); err != nil {
+		return nil, err
+	}
+	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+}
+
+// GetLockedCoins returns the original locking, which is always locked.
+func (pla PermanentLockingAccount) GetLockedCoins(ctx context.Context, _ time.Time) (sdk.Coins, error) {
+	return pla.coins(ctx, pla.OriginalLocking)
+}
+
+func (pla PermanentLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pla.BaseLockup.Delegate(ctx, msg, pla.GetLockedCoins)
+}
+
+func (pla PermanentLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (*lockuptypes.MsgExecuteMessagesResponse, error) {
+	return pla.BaseLockup.SendCoins(ctx, msg, pla.GetLockedCoins)
+}
+
+func (pla PermanentLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (*lockuptypes.MsgWithdrawResponse, error) {
+	return pla.BaseLockup.WithdrawUnlockedCoins(ctx, msg, pla.GetLockedCoins)
+}
+
+func (pla PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context, _ *lockuptypes.QueryLockupAccountInfoRequest) (*lockuptypes.QueryLockupAccountInfoResponse, error) {
+	return pla.QueryLockupAccountBaseInfo(ctx, pla.GetLockedCoins)
+}
+
+func (pla PermanentLockingAccount) QuerySpendableAmount(ctx context.Context, _ *lockuptypes.QuerySpendableAmountRequest) (*lockuptypes.QuerySpendableAmountResponse, error) {
+	return pla.QuerySpendableTokens(ctx, pla.GetLockedCoins)
+}
+
+func (pla PermanentLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, pla.Init)
+}
+
+func (pla PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, pla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, pla.Undelegate)
+	accountstd.RegisterExecuteHandler(builder, pla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, pla.WithdrawUnlockedCoins)
+}
+
+func (pla PermanentLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, pla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, pla.QuerySpendableAmount)
+}
@@ -0,0 +1,265 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/branch"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
+)
+
+var (
+	MembersPrefix   = collections.NewPrefix(0)
+	ConfigPrefix    = collections.NewPrefix(1)
+	SequencePrefix  = collections.NewPrefix(2)
+	ProposalsPrefix = collections.NewPrefix(3)
+	VotesPrefix     = collections.NewPrefix(4)
+	// ConfigVersionPrefix is the prefix of the version of the members and the
+	// voting configuration.
+	ConfigVersionPrefix = collections.NewPrefix(5)
+)
+
+var (
+	// ErrUnauthorized is returned when the sender of a message is not allowed to send it.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrInvalidConfig is returned when the members or the voting configuration of a multisig are invalid.
+	ErrInvalidConfig = errors.New("invalid multisig config")
+)
+
+var _ accountstd.Interface = (*Account)(nil)
+
+// NewAccount creates a new multisig account.
+func NewAccount(d accountstd.Dependencies) (*Account, error) {
+	if d.HeaderService == nil {
+		return nil, errors.New("header service is required")
+	}
+	if d.BranchService == nil {
+		return nil, errors.New("branch service is required")
+	}
+	return &Account{
+		Members:       collections.NewMap(d.SchemaBuilder, MembersPrefix, "members", collections.BytesKey, collections.Uint64Value),
+		Config:        collections.NewItem(d.SchemaBuilder, ConfigPrefix, "config", accountstd.CollValue[v1.Config]()),
+		Sequence:      collections.NewSequence(d.SchemaBuilder, SequencePrefix, "sequence"),
+		Proposals:     collections.NewMap(d.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, accountstd.CollValue[v1.Proposal]()),
+		Votes:         collections.NewMap(d.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+		ConfigVersion: collections.NewSequence(d.SchemaBuilder, ConfigVersionPrefix, "config_version"),
+		addressCodec:  d.AddressCodec,
+		headerService: d.HeaderService,
+		branchService: d.BranchService,
+	}, nil
+}
+
+// Account implements a multisig account whose members vote, with their
+// weights, on proposals to execute messages on behalf of the account. The
+// members and the voting configuration are updated by the multisig itself,
+// through proposals executing MsgUpdateConfig, which abort the proposals in
+// their voting period.
+type Account struct {
+	// Members maps the address of each member to its weight.
+	Members collections.Map[[]byte, uint64]
+	// Config is the voting configuration.
+	Config collections.Item[v1.Config]
+	// Sequence is the sequence of proposal ids.
+	Sequence collections.Sequence
+	// Proposals maps proposal ids to proposals.
+	Proposals collections.Map[uint64, v1.Proposal]
+	// Votes maps (proposal id, member address) to the vote option of the member.
+	Votes collections.Map[collections.Pair[uint64, []byte], int32]
+	// ConfigVersion is the version of the members and the voting configuration,
+	// incremented by each update.
+	ConfigVersion collections.Sequence
+
+	addressCodec  address.Codec
+	headerService header.Service
+	branchService branch.Service
+}
+
+// Init initializes the members and the voting configuration of the multisig.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if len(msg.Members) == 0 {
+		return nil, fmt.Errorf("%w: members cannot be empty", ErrInvalidConfig)
+	}
+	if msg.Config == nil {
+		return nil, fmt.Errorf("%w: config cannot be empty", ErrInvalidConfig)
+	}
+
+	seen := make(map[string]bool, len(msg.Members))
+	for _, member := range msg.Members {
+		if seen[member.Address] {
+			return nil, fmt.Errorf("%w: duplicate member %s", ErrInvalidConfig, member.Address)
+		}
+		seen[member.Address] = true
+		if member.Weight == 0 {
+			return nil, fmt.Errorf("%w: member %s has no weight", ErrInvalidConfig, member.Address)
+		}
+		if err := a.setMember(ctx, member); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.Config.Set(ctx, *msg.Config); err != nil {
+		return nil, err
+	}
+	if err := a.validateConfig(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, nil
+}
+
+// Migrate initializes the multisig in place of a legacy x/auth account, with the
+// members and the voting configuration of the MsgInit wrapped by the migration
+// message. The public key of the legacy account does not control the multisig,
+// unless its address is one of the members. It can only be executed by the
+// x/accounts module.
+func (a Account) Migrate(ctx context.Context, msg *migrationv1.MsgMigrate) (*migrationv1.MsgMigrateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, fmt.Errorf("%w: only the accounts module can migrate an account", ErrUnauthorized)
+	}
+	if msg.InitMessage == nil {
+		return nil, fmt.Errorf("%w: init message cannot be empty", ErrInvalidConfig)
+	}
+
+	initMsg, err := accountstd.UnpackAny[v1.MsgInit](msg.InitMessage)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.Init(ctx, initMsg)
+	if err != nil {
+		return nil, err
+	}
+	anyResp, err := accountstd.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &migrationv1.MsgMigrateResponse{InitResponse: anyResp}, nil
+}
+
+// UpdateConfig updates the members and the voting configuration of the
+// multisig, aborting the proposals in their voting period. It can only be
+// executed by the multisig itself.
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if !bytes.Equal(accountstd.Sender(ctx), accountstd.Whoami(ctx)) {
+		return nil, fmt.Errorf("%w: only the multisig can update its config", ErrUnauthorized)
+	}
+
+	for _, member := range msg.UpdateMembers {
+		if err := a.setMember(ctx, member); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Config != nil {
+		if err := a.Config.Set(ctx, *msg.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.validateConfig(ctx); err != nil {
+		return nil, err
+	}
+	// the proposals in their voting period were submitted with the previous
+	// version, so they are aborted.
+	if _, err := a.ConfigVersion.Next(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateConfigResponse{}, nil
+}
+
+// setMember sets the weight of a member, removing it if its weight is zero.
+func (a Account) setMember(ctx context.Context, member *v1.Member) error {
+	addr, err := a.addressCodec.StringToBytes(member.Address)
+	if err != nil {
+		return fmt.Errorf("%w: invalid member address %s: %w", ErrInvalidConfig, member.Address, err)
+	}
+	if member.Weight == 0 {
+		return a.Members.Remove(ctx, addr)
+	}
+	return a.Members.Set(ctx, addr, member.Weight)
+}
+
+// validateConfig checks that the voting configuration can be satisfied by the
+// members of the multisig.
+func (a Account) validateConfig(ctx context.Context) error {
+	totalWeight, err := a.totalWeight(ctx)
+	if err != nil {
+		return err
+	}
+	if totalWeight == 0 {
+		return fmt.Errorf("%w: members cannot be empty", ErrInvalidConfig)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+	switch {
+	case config.Threshold == 0:
+		return fmt.Errorf("%w: threshold must be positive", ErrInvalidConfig)
+	case config.Quorum == 0:
+		return fmt.Errorf("%w: quorum must be positive", ErrInvalidConfig)
+	case config.Threshold > totalWeight:
+		return fmt.Errorf("%w: threshold %d is greater than the total weight %d", ErrInvalidConfig, config.Threshold, totalWeight)
+	case config.Quorum > totalWeight:
+		return fmt.Errorf("%w: quorum %d is greater than the total weight %d", ErrInvalidConfig, config.Quorum, totalWeight)
+	case config.VotingPeriod <= 0:
+		return fmt.Errorf("%w: voting period must be positive", ErrInvalidConfig)
+	}
+	return nil
+}
+
+func (a Account) totalWeight(ctx context.Context) (uint64, error) {
+	var totalWeight uint64
+	err := a.Members.Walk(ctx, nil, func(_ []byte, weight uint64) (bool, error) {
+		if totalWeight+weight < totalWeight {
+			return true, fmt.Errorf("%w: total weight overflows", ErrInvalidConfig)
+		}
+		totalWeight += weight
+		return false, nil
+	})
+	return totalWeight, err
+}
+
+// QueryConfig returns the members and the voting configuration of the multisig.
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	var members []*v1.Member
+	err := a.Members.Walk(ctx, nil, func(addr []byte, weight uint64) (bool, error) {
+		addrStr, err := a.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		members = append(members, &v1.Member{Address: addrStr, Weight: weight})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryConfigResponse{Members: members, Config: &config}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
+	accountstd.RegisterExecuteHandler(builder, a.Vote)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
+	accountstd.RegisterExecuteHandler(builder, a.Migrate) // implements migration
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+}
@@ -0,0 +1,428 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var multisigAddr = []byte("multisig")
+
+type addressCodec struct{}
+
+func (a addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (a addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{ time time.Time }
+
+func (h *headerService) GetHeaderInfo(context.Context) header.Info { return header.Info{Time: h.time} }
+
+// branchService executes functions without branching the mock store, so the
+// state changes of failed functions are not reverted.
+type branchService struct{}
+
+func (branchService) Execute(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func (branchService) ExecuteWithGasLimit(ctx context.Context, _ uint64, f func(ctx context.Context) error) (uint64, error) {
+	return 0, f(ctx)
+}
+
+// fixture runs a multisig account in account contexts, as x/accounts does.
+// The module messages executed by the multisig are recorded, the x/accounts
+// MsgExecute sent by the multisig to itself are executed on it, and the x/accounts
+// MsgInit of an unknown account type fail.
+type fixture struct {
+	t            *testing.T
+	acc          *Account
+	storeService store.KVStoreService
+	ctx          context.Context
+	header       *headerService
+	executed     []implementation.ProtoMsg
+}
+
+func newFixture(t *testing.T, members []*v1.Member, config *v1.Config) *fixture {
+	t.Helper()
+	storeService, ctx := colltest.MockStore()
+	hs := &headerService{time: time.Unix(1_000_000, 0)}
+
+	sb := collections.NewSchemaBuilderFromAccessor(implementation.OpenKVStore)
+	acc, err := NewAccount(accountstd.Dependencies{SchemaBuilder: sb, AddressCodec: addressCodec{}, HeaderService: hs, BranchService: branchService{}})
+	require.NoError(t, err)
+	_, err = sb.Build()
+	require.NoError(t, err)
+
+	f := &fixture{t: t, acc: acc, storeService: storeService, ctx: ctx, header: hs}
+	_, err = acc.Init(f.accountContext("creator"), &v1.MsgInit{Members: members, Config: config})
+	require.NoError(t, err)
+	return f
+}
+
+func (f *fixture) accountContext(sender string) context.Context {
+	return implementation.MakeAccountContext(f.ctx, f.storeService, 1, multisigAddr, []byte(sender), nil, nil, f.execModule, nil)
+}
+
+func (f *fixture) execModule(_ context.Context, sender []byte, msg implementation.ProtoMsg) (implementation.ProtoMsg, error) {
+	require.Equal(f.t, multisigAddr, sender)
+	f.executed = append(f.executed, msg)
+
+	if init, ok := msg.(*accountsv1.MsgInit); ok && init.AccountType == "unknown" {
+		return nil, errors.New("unknown account type")
+	}
+
+	execute, ok := msg.(*accountsv1.MsgExecute)
+	if !ok || execute.Target != string(multisigAddr) {
+		return &accountsv1.MsgInitResponse{}, nil
+	}
+
+	updateConfig, err := accountstd.UnpackAny[v1.MsgUpdateConfig](execute.Message)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.acc.UpdateConfig(f.accountContext(execute.Sender), updateConfig)
+	if err != nil {
+		return nil, err
+	}
+	anyResp, err := accountstd.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &accountsv1.MsgExecuteResponse{Response: anyResp}, nil
+}
+
+func (f *fixture) propose(proposer string, msgs ...implementation.ProtoMsg) (uint64, error) {
+	f.t.Helper()
+	proposal := &v1.Proposal{Title: "title", Summary: "summary"}
+	for _, msg := range msgs {
+		anyMsg, err := accountstd.PackAny(msg)
+		require.NoError(f.t, err)
+		proposal.Messages = append(proposal.Messages, anyMsg)
+	}
+	resp, err := f.acc.CreateProposal(f.accountContext(proposer), &v1.MsgCreateProposal{Proposal: proposal})
+	if err != nil {
+		return 0, err
+	}
+	return resp.ProposalId, nil
+}
+
+func (f *fixture) vote(voter string, proposalID uint64, option v1.VoteOption) error {
+	_, err := f.acc.Vote(f.accountContext(voter), &v1.MsgVote{ProposalId: proposalID, Vote: option})
+	return err
+}
+
+func (f *fixture) execute(proposalID uint64) (*v1.MsgExecuteProposalResponse, error) {
+	return f.acc.ExecuteProposal(f.accountContext("anyone"), &v1.MsgExecuteProposal{ProposalId: proposalID})
+}
+
+func (f *fixture) query(proposalID uint64) *v1.QueryProposalResponse {
+	f.t.Helper()
+	resp, err := f.acc.QueryProposal(f.accountContext(""), &v1.QueryProposal{ProposalId: proposalID})
+	require.NoError(f.t, err)
+	return resp
+}
+
+func (f *fixture) advance(d time.Duration) {
+	f.header.time = f.header.time.Add(d)
+}
+
+var (
+	members = []*v1.Member{
+		{Address: "alice", Weight: 1},
+		{Address: "bob", Weight: 1},
+		{Address: "carol", Weight: 2},
+	}
+	config = &v1.Config{Threshold: 2, Quorum: 3, VotingPeriod: 60}
+)
+
+func TestInit(t *testing.T) {
+	testCases := []struct {
+		name    string
+		members []*v1.Member
+		config  *v1.Config
+		err     string
+	}{
+		{
+			name:    "valid",
+			members: members,
+			config:  config,
+		},
+		{
+			name:   "no members",
+			config: config,
+			err:    "members cannot be empty",
+		},
+		{
+			name:    "no config",
+			members: members,
+			err:     "config cannot be empty",
+		},
+		{
+			name:    "duplicate member",
+			members: []*v1.Member{{Address: "alice", Weight: 1}, {Address: "alice", Weight: 2}},
+			config:  config,
+			err:     "duplicate member alice",
+		},
+		{
+			name:    "member without weight",
+			members: []*v1.Member{{Address: "alice", Weight: 0}, {Address: "bob", Weight: 4}},
+			config:  config,
+			err:     "member alice has no weight",
+		},
+		{
+			name:    "unreachable threshold",
+			members: members,
+			config:  &v1.Config{Threshold: 5, Quorum: 1, VotingPeriod: 60},
+			err:     "threshold 5 is greater than the total weight 4",
+		},
+		{
+			name:    "unreachable quorum",
+			members: members,
+			config:  &v1.Config{Threshold: 1, Quorum: 5, VotingPeriod: 60},
+			err:     "quorum 5 is greater than the total weight 4",
+		},
+		{
+			name:    "zero threshold",
+			members: members,
+			config:  &v1.Config{Quorum: 1, VotingPeriod: 60},
+			err:     "threshold must be positive",
+		},
+		{
+			name:    "zero voting period",
+			members: members,
+			config:  &v1.Config{Threshold: 1, Quorum: 1},
+			err:     "voting period must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeService, ctx := colltest.MockStore()
+			sb := collections.NewSchemaBuilderFromAccessor(implementation.OpenKVStore)
+			acc, err := NewAccount(accountstd.Dependencies{SchemaBuilder: sb, AddressCodec: addressCodec{}, HeaderService: &headerService{}, BranchService: branchService{}})
+			require.NoError(t, err)
+
+			accCtx := implementation.MakeAccountContext(ctx, storeService, 1, multisigAddr, []byte("creator"), nil, nil, nil, nil)
+			_, err = acc.Init(accCtx, &v1.MsgInit{Members: tc.members, Config: tc.config})
+			if tc.err != "" {
+				require.ErrorIs(t, err, ErrInvalidConfig)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := acc.QueryConfig(accCtx, &v1.QueryConfig{})
+			require.NoError(t, err)
+			require.Equal(t, tc.members, resp.Members)
+			require.Equal(t, tc.config, resp.Config)
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	storeService, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilderFromAccessor(implementation.OpenKVStore)
+	acc, err := NewAccount(accountstd.Dependencies{SchemaBuilder: sb, AddressCodec: addressCodec{}, HeaderService: &headerService{}, BranchService: branchService{}})
+	require.NoError(t, err)
+
+	initMsg, err := accountstd.PackAny(&v1.MsgInit{Members: members, Config: config})
+	require.NoError(t, err)
+	msg := &migrationv1.MsgMigrate{Sequence: 3, InitMessage: initMsg}
+
+	// only the accounts module can migrate
+	accCtx := implementation.MakeAccountContext(ctx, storeService, 1, multisigAddr, []byte("alice"), nil, nil, nil, nil)
+	_, err = acc.Migrate(accCtx, msg)
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	accCtx = implementation.MakeAccountContext(ctx, storeService, 1, multisigAddr, address.Module("accounts"), nil, nil, nil, nil)
+	resp, err := acc.Migrate(accCtx, msg)
+	require.NoError(t, err)
+	initResp, err := accountstd.UnpackAny[v1.MsgInitResponse](resp.InitResponse)
+	require.NoError(t, err)
+	require.Equal(t, &v1.MsgInitResponse{}, initResp)
+
+	configResp, err := acc.QueryConfig(accCtx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, members, configResp.Members)
+	require.Equal(t, config, configResp.Config)
+}
+
+func TestProposalLifecycle(t *testing.T) {
+	f := newFixture(t, members, config)
+	msg := &accountsv1.MsgInit{Sender: string(multisigAddr), AccountType: "counter"}
+
+	_, err := f.propose("mallory", msg)
+	require.ErrorIs(t, err, ErrUnauthorized)
+	_, err = f.propose("alice")
+	require.ErrorContains(t, err, "proposal messages cannot be empty")
+
+	// a proposal with a yes vote below the threshold is rejected
+	rejectedID, err := f.propose("alice", msg)
+	require.NoError(t, err)
+	require.NoError(t, f.vote("alice", rejectedID, v1.VoteOption_VOTE_OPTION_YES))
+	require.NoError(t, f.vote("carol", rejectedID, v1.VoteOption_VOTE_OPTION_NO))
+	require.ErrorIs(t, f.vote("mallory", rejectedID, v1.VoteOption_VOTE_OPTION_YES), ErrUnauthorized)
+	require.ErrorContains(t, f.vote("bob", rejectedID, v1.VoteOption_VOTE_OPTION_UNSPECIFIED), "invalid vote option")
+	require.ErrorContains(t, f.vote("alice", rejectedID, v1.VoteOption_VOTE_OPTION_NO), "revotes are not allowed")
+
+	// a proposal with enough yes votes passes
+	passedID, err := f.propose("bob", msg)
+	require.NoError(t, err)
+	require.NoError(t, f.vote("carol", passedID, v1.VoteOption_VOTE_OPTION_YES))
+	require.NoError(t, f.vote("bob", passedID, v1.VoteOption_VOTE_OPTION_ABSTAIN))
+
+	proposal := f.query(passedID)
+	require.Equal(t, &v1.Tally{Yes: 2, Abstain: 1}, proposal.Tally)
+	require.Equal(t, "bob", proposal.Proposal.Proposer)
+	require.Equal(t, f.header.time.Unix()+60, proposal.Proposal.VotingPeriodEnd)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, proposal.Proposal.Status)
+
+	// proposals cannot be executed during their voting period
+	_, err = f.execute(passedID)
+	require.ErrorContains(t, err, "has not ended")
+
+	f.advance(time.Minute)
+	require.ErrorContains(t, f.vote("alice", passedID, v1.VoteOption_VOTE_OPTION_YES), "has ended")
+
+	resp, err := f.execute(rejectedID)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_REJECTED, resp.Status)
+	require.Empty(t, resp.Responses)
+	require.Empty(t, f.executed)
+
+	resp, err = f.execute(passedID)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXECUTED, resp.Status)
+	require.Len(t, resp.Responses, 1)
+	require.Equal(t, []implementation.ProtoMsg{msg}, f.executed)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXECUTED, f.query(passedID).Proposal.Status)
+
+	// proposals are executed once
+	_, err = f.execute(passedID)
+	require.ErrorContains(t, err, "was already PROPOSAL_STATUS_EXECUTED")
+	_, err = f.execute(rejectedID)
+	require.ErrorContains(t, err, "was already PROPOSAL_STATUS_REJECTED")
+	_, err = f.execute(100)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestEarlyExecutionAndRevote(t *testing.T) {
+	f := newFixture(t, members, &v1.Config{Threshold: 2, Quorum: 2, VotingPeriod: 60, Revote: true, EarlyExecution: true})
+	msg := &accountsv1.MsgInit{Sender: string(multisigAddr), AccountType: "counter"}
+
+	id, err := f.propose("alice", msg)
+	require.NoError(t, err)
+	require.NoError(t, f.vote("carol", id, v1.VoteOption_VOTE_OPTION_NO))
+
+	_, err = f.execute(id)
+	require.ErrorContains(t, err, "has not passed yet")
+
+	require.NoError(t, f.vote("carol", id, v1.VoteOption_VOTE_OPTION_YES))
+	resp, err := f.execute(id)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXECUTED, resp.Status)
+	require.Len(t, f.executed, 1)
+}
+
+func TestUpdateConfig(t *testing.T) {
+	f := newFixture(t, members, &v1.Config{Threshold: 2, Quorum: 2, VotingPeriod: 60, EarlyExecution: true})
+
+	// only the multisig can update its config
+	_, err := f.acc.UpdateConfig(f.accountContext("carol"), &v1.MsgUpdateConfig{Config: &v1.Config{Threshold: 1, Quorum: 1, VotingPeriod: 1}})
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	selfExecute := func(msg *v1.MsgUpdateConfig) *accountsv1.MsgExecute {
+		anyMsg, err := accountstd.PackAny(msg)
+		require.NoError(t, err)
+		return &accountsv1.MsgExecute{Sender: string(multisigAddr), Target: string(multisigAddr), Message: anyMsg}
+	}
+
+	// a proposal in its voting period when the config is updated
+	pendingID, err := f.propose("bob", &accountsv1.MsgInit{Sender: string(multisigAddr), AccountType: "counter"})
+	require.NoError(t, err)
+	require.NoError(t, f.vote("bob", pendingID, v1.VoteOption_VOTE_OPTION_YES))
+
+	// rotate alice out for dave, and raise the threshold
+	id, err := f.propose("alice", selfExecute(&v1.MsgUpdateConfig{
+		UpdateMembers: []*v1.Member{{Address: "alice", Weight: 0}, {Address: "dave", Weight: 3}},
+		Config:        &v1.Config{Threshold: 4, Quorum: 4, VotingPeriod: 120},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, f.vote("carol", id, v1.VoteOption_VOTE_OPTION_YES))
+	_, err = f.execute(id)
+	require.NoError(t, err)
+
+	resp, err := f.acc.QueryConfig(f.accountContext(""), &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Member{{Address: "bob", Weight: 1}, {Address: "carol", Weight: 2}, {Address: "dave", Weight: 3}}, resp.Members)
+	require.Equal(t, &v1.Config{Threshold: 4, Quorum: 4, VotingPeriod: 120}, resp.Config)
+
+	// the pending proposal is aborted, rather than tallied with the new members
+	require.ErrorContains(t, f.vote("carol", pendingID, v1.VoteOption_VOTE_OPTION_YES), "was aborted")
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_ABORTED, f.query(pendingID).Proposal.Status)
+	abortedResp, err := f.execute(pendingID)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_ABORTED, abortedResp.Status)
+	require.Len(t, f.executed, 1)
+	_, err = f.execute(pendingID)
+	require.ErrorContains(t, err, "was already PROPOSAL_STATUS_ABORTED")
+
+	// alice is no longer a member, and the new threshold applies
+	_, err = f.propose("alice", selfExecute(&v1.MsgUpdateConfig{}))
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	id, err = f.propose("dave", selfExecute(&v1.MsgUpdateConfig{
+		Config: &v1.Config{Threshold: 7, Quorum: 1, VotingPeriod: 1},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, f.vote("dave", id, v1.VoteOption_VOTE_OPTION_YES))
+	f.advance(2 * time.Minute)
+	require.Equal(t, &v1.Tally{Yes: 3}, f.query(id).Tally)
+
+	resp2, err := f.execute(id)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_REJECTED, resp2.Status)
+
+	// updates leaving the config unsatisfiable fail
+	_, err = f.acc.UpdateConfig(f.accountContext(string(multisigAddr)), &v1.MsgUpdateConfig{
+		Config: &v1.Config{Threshold: 7, Quorum: 1, VotingPeriod: 1},
+	})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestProposalFailure(t *testing.T) {
+	f := newFixture(t, members, &v1.Config{Threshold: 2, Quorum: 2, VotingPeriod: 60, EarlyExecution: true})
+	msg := &accountsv1.MsgInit{Sender: string(multisigAddr), AccountType: "counter"}
+	failingMsg := &accountsv1.MsgInit{Sender: string(multisigAddr), AccountType: "unknown"}
+
+	id, err := f.propose("alice", msg, failingMsg)
+	require.NoError(t, err)
+	require.NoError(t, f.vote("carol", id, v1.VoteOption_VOTE_OPTION_YES))
+
+	// the failure of the messages is recorded rather than returned
+	resp, err := f.execute(id)
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_FAILED, resp.Status)
+	require.Empty(t, resp.Responses)
+	require.Equal(t, []implementation.ProtoMsg{msg, failingMsg}, f.executed)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_FAILED, f.query(id).Proposal.Status)
+
+	// and failed proposals cannot be executed again
+	_, err = f.execute(id)
+	require.ErrorContains(t, err, "was already PROPOSAL_STATUS_FAILED")
+}
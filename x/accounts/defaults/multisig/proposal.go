@@ -0,0 +1,259 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+)
+
+// CreateProposal submits a proposal, whose voting period starts immediately
+// with the current members and voting configuration. It can only be executed by
+// a member.
+func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal) (*v1.MsgCreateProposalResponse, error) {
+	sender := accountstd.Sender(ctx)
+	if err := a.assertMember(ctx, sender); err != nil {
+		return nil, err
+	}
+	if msg.Proposal == nil {
+		return nil, errors.New("proposal cannot be empty")
+	}
+	if len(msg.Proposal.Messages) == 0 {
+		return nil, errors.New("proposal messages cannot be empty")
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := a.addressCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+	configVersion, err := a.ConfigVersion.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proposal := v1.Proposal{
+		Title:           msg.Proposal.Title,
+		Summary:         msg.Proposal.Summary,
+		Messages:        msg.Proposal.Messages,
+		Proposer:        proposer,
+		VotingPeriodEnd: a.now(ctx).Add(time.Duration(config.VotingPeriod) * time.Second).Unix(),
+		Status:          v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+		ConfigVersion:   configVersion,
+	}
+	if err := a.Proposals.Set(ctx, id, proposal); err != nil {
+		return nil, err
+	}
+	return &v1.MsgCreateProposalResponse{ProposalId: id}, nil
+}
+
+// Vote votes on a proposal during its voting period. It can only be executed
+// by a member, who can change its vote only if the multisig allows revotes.
+func (a Account) Vote(ctx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResponse, error) {
+	sender := accountstd.Sender(ctx)
+	if err := a.assertMember(ctx, sender); err != nil {
+		return nil, err
+	}
+	if _, ok := v1.VoteOption_name[int32(msg.Vote)]; !ok || msg.Vote == v1.VoteOption_VOTE_OPTION_UNSPECIFIED {
+		return nil, fmt.Errorf("invalid vote option %s", msg.Vote)
+	}
+
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %d: %w", msg.ProposalId, err)
+	}
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD || a.now(ctx).Unix() >= proposal.VotingPeriodEnd {
+		return nil, fmt.Errorf("voting period of proposal %d has ended", msg.ProposalId)
+	}
+	aborted, err := a.isAborted(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if aborted {
+		return nil, fmt.Errorf("proposal %d was aborted by an update of the multisig config", msg.ProposalId)
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := collections.Join(msg.ProposalId, sender)
+	if !config.Revote {
+		voted, err := a.Votes.Has(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if voted {
+			return nil, fmt.Errorf("already voted on proposal %d, and revotes are not allowed", msg.ProposalId)
+		}
+	}
+
+	if err := a.Votes.Set(ctx, key, int32(msg.Vote)); err != nil {
+		return nil, err
+	}
+	return &v1.MsgVoteResponse{}, nil
+}
+
+// ExecuteProposal executes the messages of a proposal that passed, or rejects
+// it if it did not pass at the end of its voting period. The messages are
+// executed atomically: if one of them fails, the state changes of all of them
+// are reverted and the proposal fails. A proposal can only be executed before
+// the end of its voting period if the multisig allows early executions, and it
+// is aborted if the members or the voting configuration were updated during its
+// voting period. It can be executed by anyone.
+func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %d: %w", msg.ProposalId, err)
+	}
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return nil, fmt.Errorf("proposal %d was already %s", msg.ProposalId, proposal.Status)
+	}
+
+	aborted, err := a.isAborted(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if aborted {
+		return a.setStatus(ctx, msg.ProposalId, proposal, v1.ProposalStatus_PROPOSAL_STATUS_ABORTED)
+	}
+
+	// the members and the voting configuration are the ones the proposal was
+	// submitted with, as they were not updated.
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tally, err := a.tally(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	passed := tally.Yes+tally.No+tally.Abstain >= config.Quorum && tally.Yes >= config.Threshold
+	votingEnded := a.now(ctx).Unix() >= proposal.VotingPeriodEnd
+	switch {
+	case !votingEnded && !config.EarlyExecution:
+		return nil, fmt.Errorf("voting period of proposal %d has not ended", msg.ProposalId)
+	case !votingEnded && !passed:
+		return nil, fmt.Errorf("proposal %d has not passed yet", msg.ProposalId)
+	case !passed:
+		return a.setStatus(ctx, msg.ProposalId, proposal, v1.ProposalStatus_PROPOSAL_STATUS_REJECTED)
+	}
+
+	// the proposal is marked as executed before its messages are, so that they
+	// cannot execute it again.
+	resp, err := a.setStatus(ctx, msg.ProposalId, proposal, v1.ProposalStatus_PROPOSAL_STATUS_EXECUTED)
+	if err != nil {
+		return nil, err
+	}
+	err = accountstd.Branch(ctx, a.branchService, func(ctx context.Context) error {
+		var err error
+		resp.Responses, err = accountstd.ExecModuleAnys(ctx, proposal.Messages)
+		return err
+	})
+	if err != nil {
+		// the failure is recorded rather than returned, so that the proposal
+		// cannot be executed again.
+		return a.setStatus(ctx, msg.ProposalId, proposal, v1.ProposalStatus_PROPOSAL_STATUS_FAILED)
+	}
+	return resp, nil
+}
+
+// setStatus sets the status of a proposal, at the end of its voting period.
+func (a Account) setStatus(ctx context.Context, proposalID uint64, proposal v1.Proposal, status v1.ProposalStatus) (*v1.MsgExecuteProposalResponse, error) {
+	proposal.Status = status
+	if err := a.Proposals.Set(ctx, proposalID, proposal); err != nil {
+		return nil, err
+	}
+	return &v1.MsgExecuteProposalResponse{Status: status}, nil
+}
+
+// QueryProposal returns a proposal and the current tally of its votes. A
+// proposal in its voting period whose voting period was aborted is reported as
+// aborted, although it is only recorded as such when executed.
+func (a Account) QueryProposal(ctx context.Context, msg *v1.QueryProposal) (*v1.QueryProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %d: %w", msg.ProposalId, err)
+	}
+	aborted, err := a.isAborted(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if aborted {
+		proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_ABORTED
+	}
+	tally, err := a.tally(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryProposalResponse{Proposal: &proposal, Tally: tally}, nil
+}
+
+// tally returns the weights of the votes on a proposal, with the current
+// weights of the members, which are the ones the proposal was submitted with
+// unless it was aborted.
+func (a Account) tally(ctx context.Context, proposalID uint64) (*v1.Tally, error) {
+	tally := &v1.Tally{}
+	rng := collections.NewPrefixedPairRange[uint64, []byte](proposalID)
+	err := a.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], vote int32) (bool, error) {
+		weight, err := a.Members.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return true, err
+		}
+
+		switch v1.VoteOption(vote) {
+		case v1.VoteOption_VOTE_OPTION_YES:
+			tally.Yes += weight
+		case v1.VoteOption_VOTE_OPTION_NO:
+			tally.No += weight
+		case v1.VoteOption_VOTE_OPTION_ABSTAIN:
+			tally.Abstain += weight
+		}
+		return false, nil
+	})
+	return tally, err
+}
+
+// isAborted returns true if the proposal is in its voting period, and the
+// members or the voting configuration were updated since it was submitted.
+func (a Account) isAborted(ctx context.Context, proposal v1.Proposal) (bool, error) {
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return false, nil
+	}
+	configVersion, err := a.ConfigVersion.Peek(ctx)
+	if err != nil {
+		return false, err
+	}
+	return proposal.ConfigVersion != configVersion, nil
+}
+
+// assertMember returns an error if the address is not a member of the multisig.
+func (a Account) assertMember(ctx context.Context, addr []byte) error {
+	isMember, err := a.Members.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return fmt.Errorf("%w: sender is not a member of the multisig", ErrUnauthorized)
+	}
+	return nil
+}
+
+func (a Account) now(ctx context.Context) time.Time {
+	return a.headerService.GetHeaderInfo(ctx).Time
+}
@@ -0,0 +1,49 @@
+package implementation
+
+import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	collcodec "cosmossdk.io/collections/codec"
+)
+
+// CollValue returns a collections value codec for a gogoproto message, which
+// accounts can use to store protobuf messages in their state. The Anys of the
+// message are kept packed, so that no interface registry is needed.
+func CollValue[T any, PT ProtoMsgG[T]]() collcodec.ValueCodec[T] {
+	return protoValueCodec[T, PT]{messageName: gogoproto.MessageName(PT(new(T)))}
+}
+
+type protoValueCodec[T any, PT ProtoMsgG[T]] struct {
+	messageName string
+}
+
+func (c protoValueCodec[T, PT]) Encode(value T) ([]byte, error) {
+	return gogoproto.Marshal(PT(&value))
+}
+
+func (c protoValueCodec[T, PT]) Decode(b []byte) (value T, err error) {
+	err = gogoproto.Unmarshal(b, PT(&value))
+	return value, err
+}
+
+func (c protoValueCodec[T, PT]) EncodeJSON(value T) ([]byte, error) {
+	var buf bytes.Buffer
+	err := (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, PT(&value))
+	return buf.Bytes(), err
+}
+
+func (c protoValueCodec[T, PT]) DecodeJSON(b []byte) (value T, err error) {
+	err = jsonpb.Unmarshal(bytes.NewReader(b), PT(&value))
+	return value, err
+}
+
+func (c protoValueCodec[T, PT]) Stringify(value T) string {
+	return PT(&value).String()
+}
+
+func (c protoValueCodec[T, PT]) ValueType() string {
+	return "gogoproto/" + c.messageName
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/binary"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/branch"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/x/accounts/internal/prefixstore"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var AccountStatePrefix = collections.NewPrefix(255)
@@ -20,8 +23,11 @@ type (
 type contextKey struct{}
 
 type contextValue struct {
+	storeSvc          store.KVStoreService  // storeSvc is the x/accounts module store service.
+	accNumber         uint64                // accNumber is the number of the account, which prefixes its store.
 	store             store.KVStore         // store is the prefixed store for the account.
 	sender            []byte                // sender is the address of the entity invoking the account action.
+	funds             sdk.Coins             // funds are the funds transferred by the sender to the account with the action.
 	whoami            []byte                // whoami is the address of the account being invoked.
 	originalContext   context.Context       // originalContext that was used to build the account context.
 	moduleExec        ModuleExecFunc        // moduleExec is a function that executes a module message, when the resp type is known.
@@ -34,6 +40,7 @@ type contextValue struct {
 // accountAddr: the address of the account being invoked, which is used to give the
 // account a prefixed storage.
 // sender: the address of entity invoking the account action.
+// funds: the funds transferred by the sender to the account with the action.
 // moduleExec: a function that executes a module message.
 // moduleQuery: a function that queries a module.
 func MakeAccountContext(
@@ -42,13 +49,17 @@ func MakeAccountContext(
 	accNumber uint64,
 	accountAddr []byte,
 	sender []byte,
+	funds sdk.Coins,
 	moduleExec ModuleExecFunc,
 	moduleExecUntyped ModuleExecUntypedFunc,
 	moduleQuery ModuleQueryFunc,
 ) context.Context {
 	return context.WithValue(ctx, contextKey{}, contextValue{
+		storeSvc:          storeSvc,
+		accNumber:         accNumber,
 		store:             makeAccountStore(ctx, storeSvc, accNumber),
 		sender:            sender,
+		funds:             funds,
 		whoami:            accountAddr,
 		originalContext:   ctx,
 		moduleExecUntyped: moduleExecUntyped,
@@ -66,6 +77,19 @@ func makeAccountStore(ctx context.Context, storeSvc store.KVStoreService, accNum
 	return prefixstore.New(storeSvc.OpenKVStore(ctx), append(AccountStatePrefix, prefix...))
 }
 
+// Branch executes fn with an account context on a branch of the original
+// context, so that the state changes of fn, to the account and to the modules
+// whose messages it executes, are committed only if it returns no error.
+func Branch(ctx context.Context, branchService branch.Service, fn func(ctx context.Context) error) error {
+	v := ctx.Value(contextKey{}).(contextValue)
+	return branchService.Execute(v.originalContext, func(branchedCtx context.Context) error {
+		branchedValue := v
+		branchedValue.originalContext = branchedCtx
+		branchedValue.store = makeAccountStore(branchedCtx, v.storeSvc, v.accNumber)
+		return fn(context.WithValue(branchedCtx, contextKey{}, branchedValue))
+	})
+}
+
 // ExecModuleUntyped can be used to execute a message towards a module, when the response type is unknown.
 func ExecModuleUntyped(ctx context.Context, msg ProtoMsg) (ProtoMsg, error) {
 	// get sender
@@ -117,6 +141,12 @@ func Sender(ctx context.Context) []byte {
 	return ctx.Value(contextKey{}).(contextValue).sender
 }
 
+// Funds returns the funds transferred by the sender to the account with the
+// account action.
+func Funds(ctx context.Context) sdk.Coins {
+	return ctx.Value(contextKey{}).(contextValue).funds
+}
+
 // Whoami returns the address of the account being invoked.
 func Whoami(ctx context.Context) []byte {
 	return ctx.Value(contextKey{}).(contextValue).whoami
@@ -3,6 +3,7 @@ package implementation
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"testing"
 
 	"github.com/cosmos/gogoproto/types"
@@ -10,6 +11,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/store"
 )
 
 func TestMakeAccountContext(t *testing.T) {
@@ -18,7 +20,7 @@ func TestMakeAccountContext(t *testing.T) {
 	sender := []byte("sender")
 	sb := collections.NewSchemaBuilderFromAccessor(OpenKVStore)
 
-	accountCtx := MakeAccountContext(originalContext, storeService, 1, accountAddr, sender, nil, nil, nil)
+	accountCtx := MakeAccountContext(originalContext, storeService, 1, accountAddr, sender, nil, nil, nil, nil)
 
 	// ensure whoami
 	require.Equal(t, accountAddr, Whoami(accountCtx))
@@ -44,7 +46,7 @@ func TestMakeAccountContext(t *testing.T) {
 	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 3, 232}, value)
 
 	// ensure calling ExecModule works
-	accountCtx = MakeAccountContext(originalContext, storeService, 1, []byte("legit-exec-module"), []byte("invoker"), func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+	accountCtx = MakeAccountContext(originalContext, storeService, 1, []byte("legit-exec-module"), []byte("invoker"), nil, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
 		// ensure we unwrapped the context when invoking a module call
 		require.Equal(t, originalContext, ctx)
 		Merge(msgResp, &types.StringValue{Value: "module exec was called"})
@@ -56,7 +58,7 @@ func TestMakeAccountContext(t *testing.T) {
 	require.True(t, Equal(&types.StringValue{Value: "module exec was called"}, resp))
 
 	// ensure calling ExecModuleUntyped works
-	accountCtx = MakeAccountContext(originalContext, storeService, 1, []byte("legit-exec-module-untyped"), []byte("invoker"), nil, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+	accountCtx = MakeAccountContext(originalContext, storeService, 1, []byte("legit-exec-module-untyped"), []byte("invoker"), nil, nil, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
 		require.Equal(t, originalContext, ctx)
 		return &types.StringValue{Value: "module exec untyped was called"}, nil
 	}, nil)
@@ -67,7 +69,7 @@ func TestMakeAccountContext(t *testing.T) {
 
 	// ensure calling QueryModule works, also by setting everything else communication related to nil
 	// we can guarantee that exec paths do not impact query paths.
-	accountCtx = MakeAccountContext(originalContext, storeService, 1, nil, nil, nil, nil, func(ctx context.Context, req, resp ProtoMsg) error {
+	accountCtx = MakeAccountContext(originalContext, storeService, 1, nil, nil, nil, nil, nil, func(ctx context.Context, req, resp ProtoMsg) error {
 		require.Equal(t, originalContext, ctx)
 		Merge(resp, &types.StringValue{Value: "module query was called"})
 		return nil
@@ -77,3 +79,80 @@ func TestMakeAccountContext(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, Equal(&types.StringValue{Value: "module query was called"}, resp))
 }
+
+// copyBranchService branches mock stores by copying them, committing the copy
+// back if the function does not fail.
+type copyBranchService struct {
+	storeService *colltest.StoreService
+}
+
+func (b copyBranchService) Execute(ctx context.Context, f func(ctx context.Context) error) error {
+	branchedCtx := b.storeService.NewStoreContext()
+	copyStore(b.storeService.OpenKVStore(ctx), b.storeService.OpenKVStore(branchedCtx))
+	if err := f(branchedCtx); err != nil {
+		return err
+	}
+	copyStore(b.storeService.OpenKVStore(branchedCtx), b.storeService.OpenKVStore(ctx))
+	return nil
+}
+
+func (b copyBranchService) ExecuteWithGasLimit(ctx context.Context, _ uint64, f func(ctx context.Context) error) (uint64, error) {
+	return 0, b.Execute(ctx, f)
+}
+
+func copyStore(src, dst store.KVStore) {
+	it, err := src.Iterator(nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err := dst.Set(it.Key(), it.Value()); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func TestBranch(t *testing.T) {
+	storeService, originalContext := colltest.MockStore()
+	sb := collections.NewSchemaBuilderFromAccessor(OpenKVStore)
+	ta, err := NewTestAccount(sb)
+	require.NoError(t, err)
+	branchService := copyBranchService{storeService: storeService}
+
+	var moduleCtx context.Context
+	accountCtx := MakeAccountContext(originalContext, storeService, 1, []byte("account"), []byte("sender"), nil, nil, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+		moduleCtx = ctx
+		return msg, nil
+	}, nil)
+	require.NoError(t, ta.Item.Set(accountCtx, 1))
+
+	// the state changes of failed functions are reverted
+	err = Branch(accountCtx, branchService, func(ctx context.Context) error {
+		require.Equal(t, []byte("account"), Whoami(ctx))
+		require.Equal(t, []byte("sender"), Sender(ctx))
+		value, err := ta.Item.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), value)
+
+		require.NoError(t, ta.Item.Set(ctx, 2))
+		_, err = ExecModuleUntyped(ctx, &types.UInt64Value{Value: 2})
+		require.NoError(t, err)
+		// module messages are executed on the branch
+		require.NotEqual(t, originalContext, moduleCtx)
+		return errors.New("failure")
+	})
+	require.EqualError(t, err, "failure")
+	value, err := ta.Item.Get(accountCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), value)
+
+	// and the ones of successful functions are committed
+	err = Branch(accountCtx, branchService, func(ctx context.Context) error {
+		return ta.Item.Set(ctx, 3)
+	})
+	require.NoError(t, err)
+	value, err = ta.Item.Get(accountCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), value)
+}
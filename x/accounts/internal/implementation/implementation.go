@@ -6,12 +6,17 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/branch"
+	"cosmossdk.io/core/header"
 )
 
 // Dependencies are passed to the constructor of a smart account.
 type Dependencies struct {
 	SchemaBuilder *collections.SchemaBuilder
 	AddressCodec  address.Codec
+	HeaderService header.Service
+	// BranchService executes functions in a branch of the state, see Branch.
+	BranchService branch.Service
 }
 
 // AccountCreatorFunc is a function that creates an account.
@@ -19,13 +24,20 @@ type AccountCreatorFunc = func(deps Dependencies) (string, Account, error)
 
 // MakeAccountsMap creates a map of account names to account implementations
 // from a list of account creator functions.
-func MakeAccountsMap(addressCodec address.Codec, accounts []AccountCreatorFunc) (map[string]Implementation, error) {
+func MakeAccountsMap(
+	addressCodec address.Codec,
+	headerService header.Service,
+	branchService branch.Service,
+	accounts []AccountCreatorFunc,
+) (map[string]Implementation, error) {
 	accountsMap := make(map[string]Implementation, len(accounts))
 	for _, makeAccount := range accounts {
 		stateSchemaBuilder := collections.NewSchemaBuilderFromAccessor(OpenKVStore)
 		deps := Dependencies{
 			SchemaBuilder: stateSchemaBuilder,
 			AddressCodec:  addressCodec,
+			HeaderService: headerService,
+			BranchService: branchService,
 		}
 		name, accountInterface, err := makeAccount(deps)
 		if err != nil {
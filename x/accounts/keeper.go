@@ -11,17 +11,25 @@ import (
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"google.golang.org/protobuf/proto"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/branch"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/x/accounts/accountstd"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
 	"cosmossdk.io/x/accounts/internal/implementation"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
-	errAccountTypeNotFound = errors.New("account type not found")
+	errAccountTypeNotFound   = errors.New("account type not found")
+	errAccountAlreadyExists  = errors.New("account already exists")
+	errMigrationNotSupported = errors.New("account type does not support migration")
 	// ErrUnauthorized is returned when a message sender is not allowed to perform the operation.
 	ErrUnauthorized = errors.New("unauthorized")
 )
@@ -65,23 +73,27 @@ type InterfaceRegistry interface {
 func NewKeeper(
 	ss store.KVStoreService,
 	es event.Service,
+	hs header.Service,
 	bs BranchExecutor,
 	addressCodec address.Codec,
 	signerProvider SignerProvider,
 	execRouter MsgRouter,
 	queryRouter QueryRouter,
 	ir InterfaceRegistry,
+	config Config,
 	accounts ...accountstd.AccountCreatorFunc,
 ) (Keeper, error) {
 	sb := collections.NewSchemaBuilder(ss)
 	keeper := Keeper{
 		storeService:    ss,
 		eventService:    es,
+		headerService:   hs,
 		branchExecutor:  bs,
 		addressCodec:    addressCodec,
 		signerProvider:  signerProvider,
 		msgRouter:       execRouter,
 		queryRouter:     queryRouter,
+		config:          config,
 		Schema:          collections.Schema{},
 		AccountNumber:   collections.NewSequence(sb, AccountNumberKey, "account_number"),
 		AccountsByType:  collections.NewMap(sb, AccountTypeKeyPrefix, "accounts_by_type", collections.BytesKey, collections.StringValue),
@@ -94,7 +106,7 @@ func NewKeeper(
 		return Keeper{}, err
 	}
 	keeper.Schema = schema
-	keeper.accounts, err = implementation.MakeAccountsMap(keeper.addressCodec, accounts)
+	keeper.accounts, err = implementation.MakeAccountsMap(keeper.addressCodec, keeper.headerService, keeper.branchExecutor, accounts)
 	if err != nil {
 		return Keeper{}, err
 	}
@@ -106,11 +118,13 @@ type Keeper struct {
 	// deps coming from the runtime
 	storeService   store.KVStoreService
 	eventService   event.Service
+	headerService  header.Service
 	addressCodec   address.Codec
 	branchExecutor BranchExecutor
 	msgRouter      MsgRouter
 	signerProvider SignerProvider
 	queryRouter    QueryRouter
+	config         Config
 
 	accounts map[string]implementation.Implementation
 
@@ -136,6 +150,7 @@ func (k Keeper) Init(
 	accountType string,
 	creator []byte,
 	initRequest implementation.ProtoMsg,
+	funds sdk.Coins,
 ) (implementation.ProtoMsg, []byte, error) {
 	impl, err := k.getImplementation(accountType)
 	if err != nil {
@@ -154,8 +169,15 @@ func (k Keeper) Init(
 		return nil, nil, err
 	}
 
+	// transfer the funds to the account before its initialization
+	if !funds.IsZero() {
+		if err := k.sendFunds(ctx, creator, accountAddr, funds); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// make the context and init the account
-	ctx = k.makeAccountContext(ctx, num, accountAddr, creator, false)
+	ctx = k.makeAccountContext(ctx, num, accountAddr, creator, funds, false)
 	resp, err := impl.Init(ctx, initRequest)
 	if err != nil {
 		return nil, nil, err
@@ -172,6 +194,76 @@ func (k Keeper) Init(
 	return resp, accountAddr, nil
 }
 
+// MigrateLegacyAccount migrates a legacy x/auth account, identified by its address,
+// public key and sequence, to an account of the given type keeping the same address.
+// The account implementation must handle migrationv1.MsgMigrate, which wraps
+// the init message provided by the owner of the legacy account. It returns the
+// response of the account to the initialisation.
+func (k Keeper) MigrateLegacyAccount(
+	ctx context.Context,
+	accountAddr []byte,
+	accountType string,
+	pubKey *implementation.Any,
+	sequence uint64,
+	initMsg *implementation.Any,
+) (*implementation.Any, error) {
+	impl, err := k.getImplementation(accountType)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the address is not already used by an account
+	exists, err := k.AccountsByType.Has(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: %x", errAccountAlreadyExists, accountAddr)
+	}
+
+	// get the next account number
+	num, err := k.AccountNumber.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the context and migrate the account, the migration message
+	// is sent by the module in place of the init message.
+	ctx = k.makeAccountContext(ctx, num, accountAddr, ModuleAccountAddress, nil, false)
+	resp, err := impl.Execute(ctx, &migrationv1.MsgMigrate{
+		PubKey:      pubKey,
+		Sequence:    sequence,
+		InitMessage: initMsg,
+	})
+	if err != nil {
+		if implementation.IsRoutingError(err) {
+			return nil, fmt.Errorf("%w: %s", errMigrationNotSupported, accountType)
+		}
+		return nil, err
+	}
+	migrateResp, ok := resp.(*migrationv1.MsgMigrateResponse)
+	if !ok {
+		return nil, fmt.Errorf("invalid migration response type, got: %T", resp)
+	}
+
+	// map account address to account type
+	if err := k.AccountsByType.Set(ctx, accountAddr, accountType); err != nil {
+		return nil, err
+	}
+	// map account number to account address
+	if err := k.AccountByNumber.Set(ctx, accountAddr, num); err != nil {
+		return nil, err
+	}
+	return migrateResp.InitResponse, nil
+}
+
+// IsAccountsModuleAccount returns true if the address belongs to an account
+// of the x/accounts module.
+func (k Keeper) IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool {
+	has, _ := k.AccountsByType.Has(ctx, accountAddr)
+	return has
+}
+
 // Execute executes a state transition on the given account.
 func (k Keeper) Execute(
 	ctx context.Context,
@@ -201,7 +293,7 @@ func (k Keeper) Execute(
 	}
 
 	// make the context and execute the account state transition.
-	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, sender, false)
+	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, sender, nil, false)
 	return impl.Execute(ctx, execRequest)
 }
 
@@ -232,7 +324,7 @@ func (k Keeper) Query(
 	}
 
 	// make the context and execute the account query
-	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, nil, true)
+	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, nil, nil, true)
 	return impl.Query(ctx, queryRequest)
 }
 
@@ -251,7 +343,7 @@ func (k Keeper) makeAddress(accNum uint64) ([]byte, error) {
 }
 
 // makeAccountContext makes a new context for the given account.
-func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, accountAddr, sender []byte, isQuery bool) context.Context {
+func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, accountAddr, sender []byte, funds sdk.Coins, isQuery bool) context.Context {
 	// if it's not a query we create a context that allows to do anything.
 	if !isQuery {
 		return implementation.MakeAccountContext(
@@ -260,6 +352,7 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, ac
 			accountNumber,
 			accountAddr,
 			sender,
+			funds,
 			k.sendModuleMessage,
 			k.sendModuleMessageUntyped,
 			k.queryModule,
@@ -274,6 +367,7 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, ac
 		accountNumber,
 		accountAddr,
 		nil,
+		nil,
 		func(ctx context.Context, sender []byte, msg, msgResp implementation.ProtoMsg) error {
 			return fmt.Errorf("cannot execute in query context")
 		},
@@ -284,6 +378,33 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, ac
 	)
 }
 
+// sendFunds transfers funds from the creator of an account to the account,
+// with a bank MsgSend on behalf of the creator, who signed the account creation.
+func (k Keeper) sendFunds(ctx context.Context, from, to []byte, funds sdk.Coins) error {
+	fromAddr, err := k.addressCodec.BytesToString(from)
+	if err != nil {
+		return err
+	}
+	toAddr, err := k.addressCodec.BytesToString(to)
+	if err != nil {
+		return err
+	}
+	msg := &bankv1beta1.MsgSend{FromAddress: fromAddr, ToAddress: toAddr}
+	for _, coin := range funds {
+		msg.Amount = append(msg.Amount, &basev1beta1.Coin{Denom: coin.Denom, Amount: coin.Amount.String()})
+	}
+
+	messageName := implementation.MessageName(msg)
+	handler := k.msgRouter.HybridHandlerByMsgName(messageName)
+	if handler == nil {
+		return fmt.Errorf("unknown message: %s", messageName)
+	}
+	if err := handler(ctx, msg, &bankv1beta1.MsgSendResponse{}); err != nil {
+		return fmt.Errorf("failed to transfer funds: %w", err)
+	}
+	return nil
+}
+
 // sendAnyMessages it a helper function that executes untyped codectypes.Any messages
 // The messages must all belong to a module.
 func (k Keeper) sendAnyMessages(ctx context.Context, sender []byte, anyMessages []*implementation.Any) ([]*implementation.Any, error) {
@@ -58,6 +58,32 @@ func (k Keeper) ExecuteUserOperation(
 	return resp
 }
 
+// SimulateUserOperation simulates the execution of an abstracted account UserOperation.
+// The gas limits of the UserOperation which are not set, or which exceed the
+// simulation gas limit of the keeper Config, are set to it, so that the gas used by
+// each step of the UserOperation can be estimated. The state changes are not
+// discarded, so it must be called on a branched context.
+func (k Keeper) SimulateUserOperation(
+	ctx context.Context,
+	bundler string,
+	op *v1.UserOperation,
+) *v1.UserOperationResponse {
+	simOp := *op
+	simOp.AuthenticationGasLimit = k.simulationGasLimit(simOp.AuthenticationGasLimit)
+	simOp.BundlerPaymentGasLimit = k.simulationGasLimit(simOp.BundlerPaymentGasLimit)
+	simOp.ExecutionGasLimit = k.simulationGasLimit(simOp.ExecutionGasLimit)
+	return k.ExecuteUserOperation(ctx, bundler, &simOp)
+}
+
+// simulationGasLimit returns the gas limit of a step of a simulated UserOperation
+// whose gas limit is gasLimit.
+func (k Keeper) simulationGasLimit(gasLimit uint64) uint64 {
+	if gasLimit == 0 || gasLimit > k.config.SimulationGasLimit {
+		return k.config.SimulationGasLimit
+	}
+	return gasLimit
+}
+
 // Authenticate handles the authentication flow of an abstracted account.
 // Authentication happens in an isolated context with the authentication gas limit.
 // If the authentication is successful, then the state is committed.
@@ -13,6 +13,9 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/testing/counter"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestKeeper_Init(t *testing.T) {
@@ -28,7 +31,7 @@ func TestKeeper_Init(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		sender := []byte("sender")
 
-		resp, addr, err := m.Init(ctx, "test", sender, &types.Empty{})
+		resp, addr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil)
 		require.NoError(t, err)
 		require.Equal(t, &types.Empty{}, resp)
 		require.NotNil(t, addr)
@@ -45,9 +48,25 @@ func TestKeeper_Init(t *testing.T) {
 	})
 
 	t.Run("unknown account type", func(t *testing.T) {
-		_, _, err := m.Init(ctx, "unknown", []byte("sender"), &types.Empty{})
+		_, _, err := m.Init(ctx, "unknown", []byte("sender"), &types.Empty{}, nil)
 		require.ErrorIs(t, err, errAccountTypeNotFound)
 	})
+
+	t.Run("funds are sent to the account", func(t *testing.T) {
+		var sent *bankv1beta1.MsgSend
+		m.msgRouter = mockExec(func(ctx context.Context, msg, msgResp implementation.ProtoMsg) error {
+			sent = msg.(*bankv1beta1.MsgSend)
+			return nil
+		})
+
+		_, addr, err := m.Init(ctx, "test", []byte("sender"), &types.Empty{}, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&bankv1beta1.MsgSend{
+			FromAddress: "sender",
+			ToAddress:   string(addr),
+			Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+		}, sent))
+	})
 }
 
 func TestKeeper_Execute(t *testing.T) {
@@ -56,7 +75,7 @@ func TestKeeper_Execute(t *testing.T) {
 
 	// create account
 	sender := []byte("sender")
-	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{})
+	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil)
 	require.NoError(t, err)
 
 	t.Run("ok", func(t *testing.T) {
@@ -99,7 +118,7 @@ func TestKeeper_Query(t *testing.T) {
 
 	// create account
 	sender := []byte("sender")
-	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{})
+	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil)
 	require.NoError(t, err)
 
 	t.Run("ok", func(t *testing.T) {
@@ -134,3 +153,47 @@ func TestKeeper_Query(t *testing.T) {
 		require.True(t, implementation.Equal(&types.Int64Value{Value: 1000}, resp))
 	})
 }
+
+func TestKeeper_MigrateLegacyAccount(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("counter", counter.NewAccount))
+	m.queryRouter = mockQuery(func(ctx context.Context, req, resp implementation.ProtoMsg) error { return nil })
+
+	legacyAddr := []byte("legacy")
+	pubKey, err := implementation.PackAny(&types.BytesValue{Value: []byte("pubkey")})
+	require.NoError(t, err)
+	initMsg, err := implementation.PackAny(&types.Empty{})
+	require.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := m.MigrateLegacyAccount(ctx, legacyAddr, "test", pubKey, 5, initMsg)
+		require.NoError(t, err)
+		require.Equal(t, initMsg, resp)
+
+		// ensure account mapping, the address is kept
+		accType, err := m.AccountsByType.Get(ctx, legacyAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test", accType)
+		require.True(t, m.IsAccountsModuleAccount(ctx, legacyAddr))
+
+		// ensure the migration handler received the legacy sequence
+		counterResp, err := m.Query(ctx, legacyAddr, &types.DoubleValue{})
+		require.NoError(t, err)
+		require.True(t, implementation.Equal(&types.UInt64Value{Value: 5}, counterResp))
+	})
+
+	t.Run("account already exists", func(t *testing.T) {
+		_, err := m.MigrateLegacyAccount(ctx, legacyAddr, "test", pubKey, 5, initMsg)
+		require.ErrorIs(t, err, errAccountAlreadyExists)
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.MigrateLegacyAccount(ctx, []byte("legacy-2"), "unknown", pubKey, 5, initMsg)
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("account type does not support migration", func(t *testing.T) {
+		_, err := m.MigrateLegacyAccount(ctx, []byte("legacy-2"), "counter", pubKey, 5, initMsg)
+		require.ErrorIs(t, err, errMigrationNotSupported)
+		require.False(t, m.IsAccountsModuleAccount(ctx, []byte("legacy-2")))
+	})
+}
@@ -2,9 +2,7 @@ package accounts
 
 import (
 	"context"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"errors"
 
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/accounts/internal/implementation"
@@ -34,7 +32,7 @@ func (m msgServer) Init(ctx context.Context, request *v1.MsgInit) (*v1.MsgInitRe
 	}
 
 	// run account creation logic
-	resp, accAddr, err := m.k.Init(ctx, request.AccountType, creator, msg)
+	resp, accAddr, err := m.k.Init(ctx, request.AccountType, creator, msg, request.Funds)
 	if err != nil {
 		return nil, err
 	}
@@ -103,5 +101,21 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 }
 
 func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle) (*v1.MsgExecuteBundleResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	_, err := m.k.addressCodec.StringToBytes(req.Bundler)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Operations) == 0 {
+		return nil, errors.New("bundle cannot be empty")
+	}
+
+	// each operation is executed in isolation, a failing operation
+	// does not fail the bundle, its error is reported in its response.
+	responses := make([]*v1.UserOperationResponse, len(req.Operations))
+	for i, op := range req.Operations {
+		responses[i] = m.k.ExecuteUserOperation(ctx, req.Bundler, op)
+	}
+	return &v1.MsgExecuteBundleResponse{
+		Responses: responses,
+	}, nil
 }
@@ -12,6 +12,8 @@ import (
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/testing/account_abstraction"
+	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
 
@@ -53,3 +55,56 @@ func TestMsgServer(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, execResp)
 }
+
+func TestMsgServer_ExecuteBundle(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("aa_minimal", account_abstraction.NewMinimalAbstractedAccount))
+	k.msgRouter = mockExec(func(ctx context.Context, msg, msgResp implementation.ProtoMsg) error {
+		_, ok := msg.(*v1.MsgExecute)
+		require.True(t, ok)
+		return nil
+	})
+	k.signerProvider = mockSigner(func(msg implementation.ProtoMsg) ([]byte, error) {
+		return []byte(msg.(*v1.MsgExecute).Sender), nil
+	})
+
+	_, accAddr, err := k.Init(ctx, "aa_minimal", []byte("creator"), &rotationv1.MsgInit{PubKeyBytes: []byte("pubkey")}, nil)
+	require.NoError(t, err)
+	msgExecute, err := implementation.PackAny(&v1.MsgExecute{Sender: string(accAddr), Target: "target"})
+	require.NoError(t, err)
+
+	s := NewMsgServer(k)
+
+	_, err = s.ExecuteBundle(ctx, &v1.MsgExecuteBundle{Bundler: "bundler"})
+	require.ErrorContains(t, err, "bundle cannot be empty")
+
+	resp, err := s.ExecuteBundle(ctx, &v1.MsgExecuteBundle{
+		Bundler: "bundler",
+		Operations: []*v1.UserOperation{
+			{
+				Sender:                 string(accAddr),
+				AuthenticationMethod:   "secp256k1",
+				AuthenticationGasLimit: branchGas,
+				BundlerPaymentGasLimit: branchGas,
+				ExecutionMessages:      []*implementation.Any{msgExecute},
+				ExecutionGasLimit:      branchGas,
+			},
+			{
+				Sender:                 string(accAddr),
+				AuthenticationMethod:   "unknown",
+				AuthenticationGasLimit: branchGas,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 2)
+
+	// the first operation succeeded
+	require.Empty(t, resp.Responses[0].Error)
+	require.Equal(t, uint64(branchGas), resp.Responses[0].AuthenticationGasUsed)
+	require.Equal(t, uint64(branchGas), resp.Responses[0].ExecutionGasUsed)
+	require.Len(t, resp.Responses[0].ExecutionResponses, 1)
+
+	// the second operation failed, without failing the bundle
+	require.Contains(t, resp.Responses[1].Error, ErrAuthentication.Error())
+	require.Empty(t, resp.Responses[1].ExecutionResponses)
+}
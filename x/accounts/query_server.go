@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/x/accounts/internal/implementation"
@@ -58,6 +59,21 @@ func (q queryServer) Schema(_ context.Context, request *v1.SchemaRequest) (*v1.S
 	return schema, nil
 }
 
+func (q queryServer) AccountSchema(ctx context.Context, request *v1.AccountSchemaRequest) (*v1.AccountSchemaResponse, error) {
+	schema, err := q.Schema(ctx, &v1.SchemaRequest{AccountType: request.AccountType})
+	if err != nil {
+		return nil, err
+	}
+	fileDescriptors, err := v1.MakeSchemaFileDescriptors(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AccountSchemaResponse{
+		Schema:          schema,
+		FileDescriptors: fileDescriptors,
+	}, nil
+}
+
 func (q queryServer) AccountType(ctx context.Context, request *v1.AccountTypeRequest) (*v1.AccountTypeResponse, error) {
 	addr, err := q.k.addressCodec.StringToBytes(request.Address)
 	if err != nil {
@@ -71,3 +87,16 @@ func (q queryServer) AccountType(ctx context.Context, request *v1.AccountTypeReq
 		AccountType: accType,
 	}, nil
 }
+
+func (q queryServer) SimulateUserOperation(ctx context.Context, request *v1.SimulateUserOperationRequest) (*v1.SimulateUserOperationResponse, error) {
+	_, err := q.k.addressCodec.StringToBytes(request.Bundler)
+	if err != nil {
+		return nil, err
+	}
+	if request.UserOperation == nil {
+		return nil, errors.New("user operation cannot be empty")
+	}
+	return &v1.SimulateUserOperationResponse{
+		UserOperationResponse: q.k.SimulateUserOperation(ctx, request.Bundler, request.UserOperation),
+	}, nil
+}
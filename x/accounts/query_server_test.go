@@ -6,11 +6,17 @@ import (
 
 	"github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/testing/account_abstraction"
+	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
 
@@ -50,3 +56,72 @@ func TestQueryServer(t *testing.T) {
 
 	require.Equal(t, "10", resp.(*types.StringValue).Value)
 }
+
+func TestQueryServer_SimulateUserOperation(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("aa_minimal", account_abstraction.NewMinimalAbstractedAccount))
+	_, accAddr, err := k.Init(ctx, "aa_minimal", []byte("creator"), &rotationv1.MsgInit{PubKeyBytes: []byte("pubkey")}, nil)
+	require.NoError(t, err)
+
+	qs := NewQueryServer(k)
+
+	_, err = qs.SimulateUserOperation(ctx, &v1.SimulateUserOperationRequest{Bundler: "bundler"})
+	require.ErrorContains(t, err, "user operation cannot be empty")
+
+	// the gas limits which are not set are the simulation gas limit
+	op := &v1.UserOperation{
+		Sender:               string(accAddr),
+		AuthenticationMethod: "secp256k1",
+	}
+	resp, err := qs.SimulateUserOperation(ctx, &v1.SimulateUserOperationRequest{
+		Bundler:       "bundler",
+		UserOperation: op,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.UserOperationResponse.Error)
+	require.Equal(t, uint64(branchGas), resp.UserOperationResponse.AuthenticationGasUsed)
+	require.Equal(t, uint64(branchGas), resp.UserOperationResponse.ExecutionGasUsed)
+	require.Zero(t, op.AuthenticationGasLimit)
+
+	// the gas limits are capped by the simulation gas limit
+	k.config.SimulationGasLimit = branchGas - 1
+	op.AuthenticationGasLimit = branchGas
+	resp, err = NewQueryServer(k).SimulateUserOperation(ctx, &v1.SimulateUserOperationRequest{
+		Bundler:       "bundler",
+		UserOperation: op,
+	})
+	require.NoError(t, err)
+	require.Contains(t, resp.UserOperationResponse.Error, "out of gas")
+	require.Equal(t, uint64(branchGas-1), resp.UserOperationResponse.AuthenticationGasUsed)
+}
+
+func TestQueryServer_AccountSchema(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+	qs := NewQueryServer(k)
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := qs.AccountSchema(ctx, &v1.AccountSchemaRequest{AccountType: "test"})
+		require.NoError(t, err)
+		require.Equal(t, "google.protobuf.Empty", resp.Schema.InitSchema.Request)
+
+		// the files can be rebuilt in order, and define the messages of the handlers
+		fds := &descriptorpb.FileDescriptorSet{}
+		for _, bz := range resp.FileDescriptors {
+			fd := &descriptorpb.FileDescriptorProto{}
+			require.NoError(t, proto.Unmarshal(bz, fd))
+			fds.File = append(fds.File, fd)
+		}
+		files, err := protodesc.NewFiles(fds)
+		require.NoError(t, err)
+		for _, handler := range resp.Schema.ExecuteHandlers {
+			_, err := files.FindDescriptorByName(protoreflect.FullName(handler.Request))
+			require.NoError(t, err)
+			_, err = files.FindDescriptorByName(protoreflect.FullName(handler.Response))
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := qs.AccountSchema(ctx, &v1.AccountSchemaRequest{AccountType: "unknown"})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+}
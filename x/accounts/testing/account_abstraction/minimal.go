@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/protobuf/proto"
+
 	"cosmossdk.io/api/cosmos/crypto/secp256k1"
 	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/accountstd"
 	account_abstractionv1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	migrationv1 "cosmossdk.io/x/accounts/interfaces/migration/v1"
 	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -38,6 +41,25 @@ func (a MinimalAbstractedAccount) Init(ctx context.Context, msg *rotationv1.MsgI
 	return nil, a.PubKey.Set(ctx, &secp256k1.PubKey{Key: msg.PubKeyBytes})
 }
 
+// Migrate initializes the account in place of a legacy x/auth account, keeping
+// its secp256k1 public key and its sequence.
+func (a MinimalAbstractedAccount) Migrate(ctx context.Context, msg *migrationv1.MsgMigrate) (*migrationv1.MsgMigrateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, fmt.Errorf("sender is not the accounts module")
+	}
+	if msg.PubKey == nil || msg.PubKey.TypeUrl != "/cosmos.crypto.secp256k1.PubKey" {
+		return nil, fmt.Errorf("legacy account must have a secp256k1 public key")
+	}
+	pubKey := &secp256k1.PubKey{}
+	if err := proto.Unmarshal(msg.PubKey.Value, pubKey); err != nil {
+		return nil, err
+	}
+	if err := a.PubKey.Set(ctx, pubKey); err != nil {
+		return nil, err
+	}
+	return &migrationv1.MsgMigrateResponse{}, a.Sequence.Set(ctx, msg.Sequence)
+}
+
 func (a MinimalAbstractedAccount) RotatePubKey(ctx context.Context, msg *rotationv1.MsgRotatePubKey) (*rotationv1.MsgRotatePubKeyResponse, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -63,6 +85,7 @@ func (a MinimalAbstractedAccount) RegisterInitHandler(builder *accountstd.InitBu
 func (a MinimalAbstractedAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.RotatePubKey)
 	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // implements account_abstraction
+	accountstd.RegisterExecuteHandler(builder, a.Migrate)      // implements migration
 }
 
 func (a MinimalAbstractedAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
@@ -11,7 +12,9 @@ import (
 
 	"cosmossdk.io/collections/colltest"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/branch"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/accounts/internal/implementation"
 )
 
@@ -36,6 +39,31 @@ func (e eventService) EmitNonConsensus(ctx context.Context, event protoiface.Mes
 
 func (e eventService) EventManager(ctx context.Context) event.Manager { return e }
 
+var _ header.Service = (*headerService)(nil)
+
+type headerService struct{}
+
+func (h headerService) GetHeaderInfo(context.Context) header.Info { return header.Info{} }
+
+var _ branch.Service = (*branchService)(nil)
+
+// branchService does not branch the state, and every execution consumes
+// branchGas gas.
+type branchService struct{}
+
+const branchGas = 10
+
+func (b branchService) Execute(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func (b branchService) ExecuteWithGasLimit(ctx context.Context, gasLimit uint64, f func(ctx context.Context) error) (gasUsed uint64, err error) {
+	if gasLimit < branchGas {
+		return gasLimit, errors.New("out of gas")
+	}
+	return branchGas, f(ctx)
+}
+
 var _ InterfaceRegistry = (*interfaceRegistry)(nil)
 
 type interfaceRegistry struct{}
@@ -47,7 +75,7 @@ func (i interfaceRegistry) RegisterImplementations(any, ...gogoproto.Message) {}
 func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Keeper, context.Context) {
 	t.Helper()
 	ss, ctx := colltest.MockStore()
-	m, err := NewKeeper(ss, eventService{}, nil, addressCodec{}, nil, nil, nil, interfaceRegistry{}, accounts...)
+	m, err := NewKeeper(ss, eventService{}, headerService{}, branchService{}, addressCodec{}, nil, nil, nil, interfaceRegistry{}, DefaultConfig(), accounts...)
 	require.NoError(t, err)
 	return m, ctx
 }
@@ -296,6 +296,112 @@ func (m *SchemaResponse_Handler) GetResponse() string {
 	return ""
 }
 
+// AccountSchemaRequest is the request type for the Query/AccountSchema RPC method.
+type AccountSchemaRequest struct {
+	// account_type defines the account type to query the schema for.
+	AccountType string `protobuf:"bytes,1,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+}
+
+func (m *AccountSchemaRequest) Reset()         { *m = AccountSchemaRequest{} }
+func (m *AccountSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountSchemaRequest) ProtoMessage()    {}
+func (*AccountSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{4}
+}
+func (m *AccountSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountSchemaRequest.Merge(m, src)
+}
+func (m *AccountSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountSchemaRequest proto.InternalMessageInfo
+
+func (m *AccountSchemaRequest) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+// AccountSchemaResponse is the response type for the Query/AccountSchema RPC method.
+type AccountSchemaResponse struct {
+	// schema defines the names of the request and response messages of the
+	// init, execute and query handlers of the account type.
+	Schema *SchemaResponse `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// file_descriptors defines the serialized google.protobuf.FileDescriptorProto
+	// of the files defining the request and response messages of the handlers,
+	// and of their dependencies. A file always comes after the files it imports.
+	// As in gRPC server reflection, the descriptors are kept serialized to avoid
+	// depending on descriptor.proto.
+	FileDescriptors [][]byte `protobuf:"bytes,2,rep,name=file_descriptors,json=fileDescriptors,proto3" json:"file_descriptors,omitempty"`
+}
+
+func (m *AccountSchemaResponse) Reset()         { *m = AccountSchemaResponse{} }
+func (m *AccountSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountSchemaResponse) ProtoMessage()    {}
+func (*AccountSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{5}
+}
+func (m *AccountSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountSchemaResponse.Merge(m, src)
+}
+func (m *AccountSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountSchemaResponse proto.InternalMessageInfo
+
+func (m *AccountSchemaResponse) GetSchema() *SchemaResponse {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *AccountSchemaResponse) GetFileDescriptors() [][]byte {
+	if m != nil {
+		return m.FileDescriptors
+	}
+	return nil
+}
+
 // AccountTypeRequest is the request type for the Query/AccountType RPC method.
 type AccountTypeRequest struct {
 	// address defines the address to query the account type for.
@@ -306,7 +412,7 @@ func (m *AccountTypeRequest) Reset()         { *m = AccountTypeRequest{} }
 func (m *AccountTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountTypeRequest) ProtoMessage()    {}
 func (*AccountTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16ad14c22e3080d2, []int{4}
+	return fileDescriptor_16ad14c22e3080d2, []int{6}
 }
 func (m *AccountTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +458,7 @@ func (m *AccountTypeResponse) Reset()         { *m = AccountTypeResponse{} }
 func (m *AccountTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountTypeResponse) ProtoMessage()    {}
 func (*AccountTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16ad14c22e3080d2, []int{5}
+	return fileDescriptor_16ad14c22e3080d2, []int{7}
 }
 func (m *AccountTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,49 +494,169 @@ func (m *AccountTypeResponse) GetAccountType() string {
 	return ""
 }
 
+// SimulateUserOperationRequest is the query request used to simulate a
+// UserOperation.
+type SimulateUserOperationRequest struct {
+	// bundler defines the address of the bundler that sends the operation.
+	Bundler string `protobuf:"bytes,1,opt,name=bundler,proto3" json:"bundler,omitempty"`
+	// user_operation defines the user operation to simulate. The gas limits
+	// are capped by the simulation gas limit of the node, which is also used
+	// for the gas limits which are not set, so that the gas used can be
+	// estimated.
+	UserOperation *UserOperation `protobuf:"bytes,2,opt,name=user_operation,json=userOperation,proto3" json:"user_operation,omitempty"`
+}
+
+func (m *SimulateUserOperationRequest) Reset()         { *m = SimulateUserOperationRequest{} }
+func (m *SimulateUserOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateUserOperationRequest) ProtoMessage()    {}
+func (*SimulateUserOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{8}
+}
+func (m *SimulateUserOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateUserOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateUserOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateUserOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateUserOperationRequest.Merge(m, src)
+}
+func (m *SimulateUserOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateUserOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateUserOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateUserOperationRequest proto.InternalMessageInfo
+
+func (m *SimulateUserOperationRequest) GetBundler() string {
+	if m != nil {
+		return m.Bundler
+	}
+	return ""
+}
+
+func (m *SimulateUserOperationRequest) GetUserOperation() *UserOperation {
+	if m != nil {
+		return m.UserOperation
+	}
+	return nil
+}
+
+// SimulateUserOperationResponse is the query response returned by the simulation.
+// It will return the UserOperationResponse.
+type SimulateUserOperationResponse struct {
+	// user_operation_response defines the response of the simulation.
+	UserOperationResponse *UserOperationResponse `protobuf:"bytes,1,opt,name=user_operation_response,json=userOperationResponse,proto3" json:"user_operation_response,omitempty"`
+}
+
+func (m *SimulateUserOperationResponse) Reset()         { *m = SimulateUserOperationResponse{} }
+func (m *SimulateUserOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateUserOperationResponse) ProtoMessage()    {}
+func (*SimulateUserOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{9}
+}
+func (m *SimulateUserOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateUserOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateUserOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateUserOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateUserOperationResponse.Merge(m, src)
+}
+func (m *SimulateUserOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateUserOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateUserOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateUserOperationResponse proto.InternalMessageInfo
+
+func (m *SimulateUserOperationResponse) GetUserOperationResponse() *UserOperationResponse {
+	if m != nil {
+		return m.UserOperationResponse
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AccountQueryRequest)(nil), "cosmos.accounts.v1.AccountQueryRequest")
 	proto.RegisterType((*AccountQueryResponse)(nil), "cosmos.accounts.v1.AccountQueryResponse")
 	proto.RegisterType((*SchemaRequest)(nil), "cosmos.accounts.v1.SchemaRequest")
 	proto.RegisterType((*SchemaResponse)(nil), "cosmos.accounts.v1.SchemaResponse")
 	proto.RegisterType((*SchemaResponse_Handler)(nil), "cosmos.accounts.v1.SchemaResponse.Handler")
+	proto.RegisterType((*AccountSchemaRequest)(nil), "cosmos.accounts.v1.AccountSchemaRequest")
+	proto.RegisterType((*AccountSchemaResponse)(nil), "cosmos.accounts.v1.AccountSchemaResponse")
 	proto.RegisterType((*AccountTypeRequest)(nil), "cosmos.accounts.v1.AccountTypeRequest")
 	proto.RegisterType((*AccountTypeResponse)(nil), "cosmos.accounts.v1.AccountTypeResponse")
+	proto.RegisterType((*SimulateUserOperationRequest)(nil), "cosmos.accounts.v1.SimulateUserOperationRequest")
+	proto.RegisterType((*SimulateUserOperationResponse)(nil), "cosmos.accounts.v1.SimulateUserOperationResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/accounts/v1/query.proto", fileDescriptor_16ad14c22e3080d2) }
 
 var fileDescriptor_16ad14c22e3080d2 = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x8e, 0xd3, 0x40,
-	0x10, 0xc6, 0x6d, 0x9f, 0x48, 0x60, 0x7c, 0x77, 0xa0, 0xe5, 0x84, 0x8c, 0x0b, 0x2b, 0xe7, 0x82,
-	0x8b, 0x28, 0xd6, 0x5c, 0xa0, 0xa0, 0x43, 0xa1, 0x3a, 0x89, 0x2a, 0x06, 0x1a, 0x24, 0x14, 0x7c,
-	0xf6, 0x92, 0x8b, 0xb8, 0x78, 0x1d, 0xef, 0x3a, 0x8a, 0xdf, 0x82, 0x57, 0xe0, 0x6d, 0x52, 0xa6,
-	0xa4, 0x44, 0xc9, 0x8b, 0xa0, 0xec, 0x9f, 0xd8, 0x11, 0x21, 0x56, 0x3a, 0xcf, 0xce, 0xb7, 0xbf,
-	0xd9, 0x99, 0x6f, 0x12, 0xf0, 0x62, 0xca, 0x26, 0x94, 0x05, 0x51, 0x1c, 0xd3, 0x22, 0xe5, 0x2c,
-	0x98, 0x5d, 0x07, 0xd3, 0x82, 0xe4, 0x25, 0xce, 0x72, 0xca, 0x29, 0x42, 0x32, 0x8f, 0x75, 0x1e,
-	0xcf, 0xae, 0xdd, 0xe7, 0x23, 0x4a, 0x47, 0xf7, 0x24, 0x10, 0x8a, 0xdb, 0xe2, 0x7b, 0x10, 0xa5,
-	0x4a, 0xee, 0x7f, 0x85, 0xa7, 0x7d, 0xa9, 0x1c, 0x6c, 0x20, 0x21, 0x99, 0x16, 0x84, 0x71, 0xf4,
-	0x0c, 0x5a, 0x3c, 0xca, 0x47, 0x84, 0x3b, 0x66, 0xc7, 0xec, 0x3e, 0x0a, 0x55, 0x84, 0x30, 0xb4,
-	0x73, 0x29, 0x71, 0xac, 0x8e, 0xd9, 0xb5, 0x7b, 0x17, 0x58, 0xb2, 0xb1, 0x66, 0xe3, 0x7e, 0x5a,
-	0x86, 0x5a, 0xe4, 0xdf, 0xc0, 0xc5, 0x2e, 0x9e, 0x65, 0x34, 0x65, 0x04, 0xbd, 0x82, 0x87, 0xb9,
-	0xfa, 0x16, 0x15, 0xfe, 0x07, 0xda, 0xaa, 0xfc, 0x1e, 0x9c, 0x7d, 0x8c, 0xef, 0xc8, 0x24, 0xd2,
-	0x4f, 0xbc, 0x84, 0x53, 0xd5, 0xe3, 0x90, 0x97, 0x19, 0x51, 0x0f, 0xb5, 0xd5, 0xd9, 0xa7, 0x32,
-	0x23, 0xfe, 0xc2, 0x82, 0x73, 0x7d, 0x49, 0x15, 0xfe, 0x00, 0xf6, 0x38, 0x1d, 0xf3, 0x21, 0x13,
-	0xc7, 0xaa, 0xf6, 0x4b, 0xfc, 0xef, 0xd0, 0xf0, 0xee, 0x45, 0x7c, 0x13, 0xa5, 0xc9, 0x3d, 0xc9,
-	0x43, 0xd8, 0x5c, 0x97, 0x39, 0xf4, 0x19, 0x9e, 0x90, 0x39, 0x89, 0x0b, 0x4e, 0x86, 0x77, 0x32,
-	0xcd, 0x1c, 0xab, 0x73, 0x72, 0x24, 0xf1, 0xb1, 0x62, 0xa8, 0x98, 0xa1, 0x01, 0x9c, 0x0b, 0x47,
-	0x2b, 0xe8, 0xc9, 0xd1, 0xd0, 0x33, 0x41, 0xd0, 0x48, 0xf7, 0x1d, 0xb4, 0xd5, 0x37, 0x72, 0x2a,
-	0x0b, 0xe5, 0xc8, 0x74, 0x88, 0xdc, 0x9a, 0x29, 0x96, 0x48, 0x55, 0xe3, 0xc7, 0x80, 0xfa, 0xd5,
-	0x64, 0xb5, 0x07, 0x0e, 0xb4, 0xa3, 0x24, 0xc9, 0x09, 0x63, 0x9a, 0xa5, 0x42, 0xff, 0xed, 0x76,
-	0xaf, 0xa4, 0x5e, 0x8d, 0xbf, 0xd9, 0xb4, 0xde, 0x2f, 0x0b, 0x1e, 0x88, 0x65, 0x41, 0x31, 0x9c,
-	0xd6, 0x97, 0x07, 0x5d, 0xed, 0xeb, 0x7f, 0xcf, 0xf6, 0xba, 0xdd, 0x66, 0xa1, 0x6a, 0xcb, 0x40,
-	0x03, 0x68, 0x29, 0x37, 0x2f, 0x0f, 0x8d, 0x57, 0x82, 0xfd, 0x66, 0x07, 0x7c, 0x03, 0x7d, 0x03,
-	0xbb, 0xd6, 0x3b, 0x7a, 0x71, 0xe0, 0x35, 0xb5, 0x61, 0xba, 0x57, 0x8d, 0x3a, 0x5d, 0xe1, 0xfd,
-	0x9b, 0xc5, 0xca, 0x33, 0x97, 0x2b, 0xcf, 0xfc, 0xb3, 0xf2, 0xcc, 0x9f, 0x6b, 0xcf, 0x58, 0xae,
-	0x3d, 0xe3, 0xf7, 0xda, 0x33, 0xbe, 0xb8, 0x92, 0xc1, 0x92, 0x1f, 0x78, 0x4c, 0x83, 0x79, 0xfd,
-	0x6f, 0xe2, 0xb6, 0x25, 0x7e, 0x5a, 0xaf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x95, 0x18, 0x10,
-	0x13, 0x43, 0x04, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8f, 0xd3, 0xff, 0x3f, 0x85, 0x27, 0x4d, 0x5b, 0x5d, 0x5b, 0x30, 0x16, 0x58, 0xad, 0x07,
+	0xda, 0x22, 0x74, 0x6e, 0x0b, 0x03, 0xb0, 0xa0, 0x20, 0x86, 0x48, 0x0c, 0x28, 0x2e, 0x5d, 0x90,
+	0x90, 0x71, 0x9c, 0x4b, 0x6a, 0x91, 0xf8, 0xdc, 0xbb, 0x73, 0xd5, 0x2c, 0x1d, 0xd8, 0xd8, 0xf8,
+	0x58, 0x1d, 0x3b, 0x32, 0xa2, 0xe4, 0x8b, 0xa0, 0xd8, 0x77, 0x89, 0x5d, 0x4c, 0x5e, 0xb6, 0x7b,
+	0xde, 0x7e, 0xcf, 0xef, 0x79, 0xb3, 0xc1, 0xf4, 0x29, 0xef, 0x53, 0x6e, 0x7b, 0xbe, 0x4f, 0xe3,
+	0x50, 0x70, 0xfb, 0xf2, 0xd8, 0xbe, 0x88, 0x09, 0x1b, 0xe0, 0x88, 0x51, 0x41, 0x11, 0x4a, 0xed,
+	0x58, 0xd9, 0xf1, 0xe5, 0xb1, 0xf1, 0xa8, 0x4b, 0x69, 0xb7, 0x47, 0xec, 0xc4, 0xa3, 0x15, 0x77,
+	0x6c, 0x2f, 0x94, 0xee, 0xc6, 0xf3, 0x02, 0x38, 0xf9, 0x76, 0xbd, 0x16, 0x17, 0xcc, 0xf3, 0x45,
+	0x40, 0xc3, 0xd4, 0xdb, 0xfa, 0x02, 0x5b, 0xf5, 0xd4, 0xd8, 0x1c, 0xa7, 0x74, 0xc8, 0x45, 0x4c,
+	0xb8, 0x40, 0x0f, 0xa0, 0x22, 0x3c, 0xd6, 0x25, 0x42, 0xd7, 0x76, 0xb5, 0x83, 0xfb, 0x8e, 0x94,
+	0x10, 0x86, 0x55, 0x96, 0xba, 0xe8, 0xe5, 0x5d, 0xed, 0xa0, 0x7a, 0xb2, 0x8d, 0x53, 0x26, 0x58,
+	0x31, 0xc1, 0xf5, 0x70, 0xe0, 0x28, 0x27, 0xab, 0x01, 0xdb, 0x79, 0x78, 0x1e, 0xd1, 0x90, 0x13,
+	0x74, 0x04, 0xf7, 0x98, 0x7c, 0xeb, 0xda, 0x0c, 0xa0, 0x89, 0x97, 0x75, 0x02, 0xb5, 0x53, 0xff,
+	0x9c, 0xf4, 0x3d, 0x45, 0x71, 0x0f, 0xd6, 0x54, 0x59, 0x62, 0x10, 0x11, 0x49, 0xb4, 0x2a, 0x75,
+	0x9f, 0x06, 0x11, 0xb1, 0x6e, 0xca, 0xb0, 0xae, 0x82, 0x64, 0xe2, 0x0f, 0x50, 0x0d, 0xc2, 0x40,
+	0xb8, 0x3c, 0x51, 0xcb, 0xdc, 0xcf, 0xf0, 0xdf, 0x2d, 0xc6, 0xf9, 0x40, 0xdc, 0xf0, 0xc2, 0x76,
+	0x8f, 0x30, 0x07, 0xc6, 0xe1, 0xa9, 0x0d, 0x9d, 0xc1, 0x26, 0xb9, 0x22, 0x7e, 0x2c, 0x88, 0x7b,
+	0x9e, 0x9a, 0xb9, 0x5e, 0xde, 0x5d, 0x59, 0x12, 0x71, 0x43, 0x62, 0x48, 0x99, 0xa3, 0x26, 0xac,
+	0x27, 0xf3, 0x9f, 0x82, 0xae, 0x2c, 0x0d, 0x5a, 0x4b, 0x10, 0x14, 0xa4, 0xf1, 0x16, 0x56, 0xe5,
+	0x1b, 0xe9, 0xd3, 0x11, 0xa6, 0x2d, 0x53, 0x22, 0x32, 0x32, 0x43, 0x29, 0x27, 0xa6, 0x69, 0xfb,
+	0x5f, 0x4f, 0x06, 0xb9, 0xf4, 0x14, 0xae, 0x61, 0xe7, 0x4e, 0xa8, 0x9c, 0xc5, 0x1b, 0xa8, 0xe4,
+	0xc6, 0x60, 0xcd, 0xaf, 0xcf, 0x91, 0x11, 0xe8, 0x10, 0x36, 0x3b, 0x41, 0x8f, 0xb8, 0x6d, 0xc2,
+	0x7d, 0x16, 0x44, 0x82, 0xca, 0xd6, 0xaf, 0x39, 0x1b, 0x63, 0xfd, 0xfb, 0xa9, 0xda, 0xc2, 0x80,
+	0xea, 0x53, 0x3a, 0x8a, 0xb8, 0x0e, 0xab, 0x5e, 0xbb, 0xcd, 0x08, 0xe7, 0xaa, 0x0d, 0x52, 0xb4,
+	0x5e, 0xc1, 0x56, 0xce, 0x5f, 0xb2, 0x5d, 0xa0, 0xd2, 0xef, 0x1a, 0x3c, 0x3e, 0x0d, 0xfa, 0x71,
+	0xcf, 0x13, 0xe4, 0x8c, 0x13, 0xf6, 0x31, 0x22, 0xcc, 0x1b, 0x1f, 0x5b, 0x26, 0x69, 0x2b, 0x4e,
+	0xc6, 0xa0, 0x92, 0x4a, 0x11, 0x35, 0x60, 0x3d, 0xe6, 0x84, 0xb9, 0x54, 0x85, 0xc8, 0xfb, 0xda,
+	0x2b, 0xea, 0x49, 0x1e, 0xbb, 0x16, 0x67, 0xc5, 0x31, 0x89, 0x27, 0xff, 0x20, 0x21, 0x2b, 0xf1,
+	0xe0, 0x61, 0x3e, 0x97, 0x7b, 0xe7, 0x16, 0x0f, 0xe7, 0x27, 0x55, 0xf3, 0xd8, 0x89, 0x8b, 0xd4,
+	0x27, 0x3f, 0xfe, 0x83, 0xff, 0x93, 0x8b, 0x47, 0x3e, 0xac, 0x65, 0xbf, 0x00, 0x68, 0xbf, 0x08,
+	0xbb, 0xe0, 0x13, 0x64, 0x1c, 0xcc, 0x77, 0x94, 0xbb, 0x59, 0x42, 0x4d, 0xa8, 0xc8, 0x93, 0xdc,
+	0x9b, 0xb5, 0x43, 0x29, 0xf0, 0x02, 0x6b, 0x66, 0x95, 0xd0, 0x57, 0xa8, 0x66, 0xb6, 0x00, 0x3d,
+	0x9d, 0xc1, 0x26, 0xb3, 0x56, 0xc6, 0xfe, 0x5c, 0xbf, 0x49, 0x86, 0x0e, 0xd4, 0x72, 0x77, 0x81,
+	0x66, 0x55, 0x9c, 0x2f, 0xe1, 0x70, 0x01, 0xcf, 0x49, 0x9e, 0x6b, 0xd8, 0x29, 0xdc, 0x07, 0x74,
+	0x54, 0xd8, 0x88, 0x19, 0xfb, 0x6b, 0x1c, 0x2f, 0x11, 0xa1, 0xf2, 0xbf, 0x7b, 0x79, 0x33, 0x34,
+	0xb5, 0xdb, 0xa1, 0xa9, 0xfd, 0x1e, 0x9a, 0xda, 0xcf, 0x91, 0x59, 0xba, 0x1d, 0x99, 0xa5, 0x5f,
+	0x23, 0xb3, 0xf4, 0xd9, 0x48, 0xd1, 0x78, 0xfb, 0x1b, 0x0e, 0xa8, 0x7d, 0x95, 0xfd, 0x65, 0xb5,
+	0x2a, 0xc9, 0x7f, 0xe0, 0xc5, 0x9f, 0x01, 0x00, 0xa5, 0x83, 0x76, 0x7c, 0x1e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -451,6 +677,13 @@ type QueryClient interface {
 	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResponse, error)
 	// AccountType returns the account type for an address.
 	AccountType(ctx context.Context, in *AccountTypeRequest, opts ...grpc.CallOption) (*AccountTypeResponse, error)
+	// AccountSchema returns the schema of an account type together with the
+	// descriptors of the messages accepted by its handlers, so that clients can
+	// build the messages of account types they do not know about.
+	AccountSchema(ctx context.Context, in *AccountSchemaRequest, opts ...grpc.CallOption) (*AccountSchemaResponse, error)
+	// SimulateUserOperation simulates a user operation, returning the gas used by
+	// each of its parts and the responses of its messages.
+	SimulateUserOperation(ctx context.Context, in *SimulateUserOperationRequest, opts ...grpc.CallOption) (*SimulateUserOperationResponse, error)
 }
 
 type queryClient struct {
@@ -488,6 +721,24 @@ func (c *queryClient) AccountType(ctx context.Context, in *AccountTypeRequest, o
 	return out, nil
 }
 
+func (c *queryClient) AccountSchema(ctx context.Context, in *AccountSchemaRequest, opts ...grpc.CallOption) (*AccountSchemaResponse, error) {
+	out := new(AccountSchemaResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Query/AccountSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateUserOperation(ctx context.Context, in *SimulateUserOperationRequest, opts ...grpc.CallOption) (*SimulateUserOperationResponse, error) {
+	out := new(SimulateUserOperationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Query/SimulateUserOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// AccountQuery runs an account query.
@@ -496,6 +747,13 @@ type QueryServer interface {
 	Schema(context.Context, *SchemaRequest) (*SchemaResponse, error)
 	// AccountType returns the account type for an address.
 	AccountType(context.Context, *AccountTypeRequest) (*AccountTypeResponse, error)
+	// AccountSchema returns the schema of an account type together with the
+	// descriptors of the messages accepted by its handlers, so that clients can
+	// build the messages of account types they do not know about.
+	AccountSchema(context.Context, *AccountSchemaRequest) (*AccountSchemaResponse, error)
+	// SimulateUserOperation simulates a user operation, returning the gas used by
+	// each of its parts and the responses of its messages.
+	SimulateUserOperation(context.Context, *SimulateUserOperationRequest) (*SimulateUserOperationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -511,6 +769,12 @@ func (*UnimplementedQueryServer) Schema(ctx context.Context, req *SchemaRequest)
 func (*UnimplementedQueryServer) AccountType(ctx context.Context, req *AccountTypeRequest) (*AccountTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountType not implemented")
 }
+func (*UnimplementedQueryServer) AccountSchema(ctx context.Context, req *AccountSchemaRequest) (*AccountSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountSchema not implemented")
+}
+func (*UnimplementedQueryServer) SimulateUserOperation(ctx context.Context, req *SimulateUserOperationRequest) (*SimulateUserOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateUserOperation not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -570,6 +834,42 @@ func _Query_AccountType_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Query/AccountSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountSchema(ctx, req.(*AccountSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateUserOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateUserOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateUserOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Query/SimulateUserOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateUserOperation(ctx, req.(*SimulateUserOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.accounts.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -586,6 +886,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountType",
 			Handler:    _Query_AccountType_Handler,
 		},
+		{
+			MethodName: "AccountSchema",
+			Handler:    _Query_AccountSchema_Handler,
+		},
+		{
+			MethodName: "SimulateUserOperation",
+			Handler:    _Query_SimulateUserOperation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/query.proto",
@@ -798,6 +1106,80 @@ func (m *SchemaResponse_Handler) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileDescriptors) > 0 {
+		for iNdEx := len(m.FileDescriptors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FileDescriptors[iNdEx])
+			copy(dAtA[i:], m.FileDescriptors[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FileDescriptors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccountTypeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -858,27 +1240,104 @@ func (m *AccountTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SimulateUserOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccountQueryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SimulateUserOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateUserOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	if m.UserOperation != nil {
+		{
+			size, err := m.UserOperation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bundler) > 0 {
+		i -= len(m.Bundler)
+		copy(dAtA[i:], m.Bundler)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bundler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateUserOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateUserOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateUserOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserOperationResponse != nil {
+		{
+			size, err := m.UserOperationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Request != nil {
 		l = m.Request.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -954,6 +1413,38 @@ func (m *SchemaResponse_Handler) Size() (n int) {
 	return n
 }
 
+func (m *AccountSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FileDescriptors) > 0 {
+		for _, b := range m.FileDescriptors {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *AccountTypeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -980,6 +1471,36 @@ func (m *AccountTypeResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateUserOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bundler)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UserOperation != nil {
+		l = m.UserOperation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateUserOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserOperationResponse != nil {
+		l = m.UserOperationResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1017,9 +1538,331 @@ func (m *AccountQueryRequest) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &types.Any{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitSchema == nil {
+				m.InitSchema = &SchemaResponse_Handler{}
+			}
+			if err := m.InitSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHandlers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1029,27 +1872,29 @@ func (m *AccountQueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.ExecuteHandlers = append(m.ExecuteHandlers, &SchemaResponse_Handler{})
+			if err := m.ExecuteHandlers[len(m.ExecuteHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHandlers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1076,10 +1921,8 @@ func (m *AccountQueryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &types.Any{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QueryHandlers = append(m.QueryHandlers, &SchemaResponse_Handler{})
+			if err := m.QueryHandlers[len(m.QueryHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1104,7 +1947,7 @@ func (m *AccountQueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,17 +1970,17 @@ func (m *AccountQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Handler: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Handler: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1147,27 +1990,55 @@ func (m *AccountQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &types.Any{}
+			m.Request = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Response = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1190,7 +2061,7 @@ func (m *AccountQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1213,10 +2084,10 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1272,7 +2143,7 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *AccountSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1295,15 +2166,15 @@ func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitSchema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1330,52 +2201,18 @@ func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitSchema == nil {
-				m.InitSchema = &SchemaResponse_Handler{}
+			if m.Schema == nil {
+				m.Schema = &SchemaResponse{}
 			}
-			if err := m.InitSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHandlers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecuteHandlers = append(m.ExecuteHandlers, &SchemaResponse_Handler{})
-			if err := m.ExecuteHandlers[len(m.ExecuteHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHandlers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileDescriptors", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1385,25 +2222,23 @@ func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryHandlers = append(m.QueryHandlers, &SchemaResponse_Handler{})
-			if err := m.QueryHandlers[len(m.QueryHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FileDescriptors = append(m.FileDescriptors, make([]byte, postIndex-iNdEx))
+			copy(m.FileDescriptors[len(m.FileDescriptors)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1426,7 +2261,7 @@ func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
+func (m *AccountTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1449,15 +2284,15 @@ func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Handler: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Handler: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1485,11 +2320,61 @@ func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1517,7 +2402,7 @@ func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Response = string(dAtA[iNdEx:postIndex])
+			m.AccountType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1540,7 +2425,7 @@ func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulateUserOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1563,15 +2448,15 @@ func (m *AccountTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateUserOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateUserOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1599,7 +2484,43 @@ func (m *AccountTypeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Bundler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserOperation == nil {
+				m.UserOperation = &UserOperation{}
+			}
+			if err := m.UserOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1622,7 +2543,7 @@ func (m *AccountTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateUserOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1645,17 +2566,17 @@ func (m *AccountTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateUserOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateUserOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOperationResponse", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1665,23 +2586,27 @@ func (m *AccountTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountType = string(dAtA[iNdEx:postIndex])
+			if m.UserOperationResponse == nil {
+				m.UserOperationResponse = &UserOperationResponse{}
+			}
+			if err := m.UserOperationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,10 +1,15 @@
 package v1
 
 import (
+	"fmt"
 	"strings"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/x/accounts/internal/implementation"
 )
@@ -42,6 +47,51 @@ func makeHandlersSchema(handlers map[string]implementation.HandlerSchema) []*Sch
 	return schemas
 }
 
+// MakeSchemaFileDescriptors returns the serialized descriptors of the files
+// defining the request and response messages of the handlers of an account
+// schema, and of their dependencies. A file always comes after the files it
+// imports, so that clients can rebuild them in order.
+func MakeSchemaFileDescriptors(schema *SchemaResponse) ([][]byte, error) {
+	var (
+		fileDescriptors [][]byte
+		added           = make(map[string]struct{})
+	)
+	var addFile func(fd protoreflect.FileDescriptor) error
+	addFile = func(fd protoreflect.FileDescriptor) error {
+		if _, ok := added[fd.Path()]; ok || fd.IsPlaceholder() {
+			return nil
+		}
+		added[fd.Path()] = struct{}{}
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			if err := addFile(imports.Get(i).FileDescriptor); err != nil {
+				return err
+			}
+		}
+		bz, err := proto.Marshal(protodesc.ToFileDescriptorProto(fd))
+		if err != nil {
+			return err
+		}
+		fileDescriptors = append(fileDescriptors, bz)
+		return nil
+	}
+
+	handlers := append([]*SchemaResponse_Handler{schema.InitSchema}, schema.ExecuteHandlers...)
+	handlers = append(handlers, schema.QueryHandlers...)
+	for _, handler := range handlers {
+		for _, name := range []string{handler.Request, handler.Response} {
+			desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+			if err != nil {
+				return nil, fmt.Errorf("unable to find the descriptor of message %s: %w", name, err)
+			}
+			if err := addFile(desc.ParentFile()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return fileDescriptors, nil
+}
+
 func MsgServiceDesc() *grpc.ServiceDesc {
 	return &_Msg_serviceDesc
 }
@@ -7,7 +7,10 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -37,6 +40,9 @@ type MsgInit struct {
 	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
 	// message is the message to be sent to the account.
 	Message *types.Any `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// funds are the funds transferred from the sender to the account before its
+	// initialization.
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
 }
 
 func (m *MsgInit) Reset()         { *m = MsgInit{} }
@@ -93,6 +99,13 @@ func (m *MsgInit) GetMessage() *types.Any {
 	return nil
 }
 
+func (m *MsgInit) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
 // MsgInitResponse defines the Create response type for the Msg/Create RPC method.
 type MsgInitResponse struct {
 	// account_address is the address of the newly created account.
@@ -372,38 +385,44 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/tx.proto", fileDescriptor_29c2b6d8a13d4189) }
 
 var fileDescriptor_29c2b6d8a13d4189 = []byte{
-	// 491 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xcd, 0x24, 0x90, 0xd0, 0x9b, 0x42, 0xd1, 0x08, 0x15, 0xe3, 0x4a, 0x56, 0x1a, 0x10, 0x84,
-	0xaa, 0x1a, 0xd3, 0xc0, 0xaa, 0xbb, 0x54, 0xe2, 0xb5, 0x88, 0x10, 0x16, 0x6c, 0xd8, 0x20, 0xc7,
-	0x1e, 0x46, 0x11, 0x89, 0xc7, 0xf2, 0x9d, 0x54, 0xf1, 0x02, 0x09, 0xf8, 0x00, 0xc4, 0xa7, 0xf4,
-	0x33, 0x58, 0x76, 0xc9, 0x12, 0x25, 0x8b, 0xfe, 0x06, 0xb2, 0x3d, 0xe3, 0x04, 0x68, 0x42, 0xa5,
-	0xee, 0x3c, 0x73, 0xce, 0x3d, 0x0f, 0xcd, 0x35, 0xec, 0x04, 0x12, 0xc7, 0x12, 0x5d, 0x3f, 0x08,
-	0xe4, 0x24, 0x52, 0xe8, 0x1e, 0x1f, 0xb8, 0x6a, 0xca, 0xe2, 0x44, 0x2a, 0x49, 0x69, 0x01, 0x32,
-	0x03, 0xb2, 0xe3, 0x03, 0xfb, 0x8e, 0x90, 0x52, 0x8c, 0xb8, 0x9b, 0x33, 0x06, 0x93, 0x0f, 0xae,
-	0x1f, 0xa5, 0x05, 0xdd, 0xbe, 0xad, 0xb5, 0xc6, 0x28, 0x32, 0x99, 0x31, 0x0a, 0x0d, 0xec, 0x9f,
-	0x63, 0xa2, 0xbf, 0xdf, 0xfb, 0x03, 0x54, 0x89, 0x1f, 0xa8, 0xa1, 0x8c, 0x0a, 0x76, 0xfb, 0x0b,
-	0x81, 0x46, 0x1f, 0xc5, 0xcb, 0x68, 0xa8, 0xe8, 0x36, 0xd4, 0x91, 0x47, 0x21, 0x4f, 0x2c, 0xd2,
-	0x22, 0x9d, 0x0d, 0x4f, 0x9f, 0xe8, 0x2e, 0x6c, 0x1a, 0x01, 0x95, 0xc6, 0xdc, 0xaa, 0xe6, 0x68,
-	0x53, 0xdf, 0xbd, 0x49, 0x63, 0x4e, 0x19, 0x34, 0xc6, 0x1c, 0xd1, 0x17, 0xdc, 0xaa, 0xb5, 0x48,
-	0xa7, 0xd9, 0xbd, 0xc5, 0x8a, 0xe8, 0xcc, 0x44, 0x67, 0xbd, 0x28, 0xf5, 0x0c, 0xe9, 0xb0, 0xf9,
-	0xf5, 0xec, 0x64, 0x4f, 0xeb, 0xb7, 0x47, 0xb0, 0xa5, 0x23, 0x78, 0x1c, 0x63, 0x19, 0x21, 0xa7,
-	0x0f, 0x60, 0xab, 0xcc, 0x1c, 0x86, 0x09, 0x47, 0xd4, 0x99, 0x6e, 0xe8, 0xeb, 0x5e, 0x71, 0x4b,
-	0x1f, 0xc1, 0xb5, 0x44, 0x0f, 0xe5, 0xb9, 0x56, 0x39, 0x97, 0xac, 0x76, 0x0a, 0xd0, 0x47, 0xf1,
-	0x74, 0xca, 0x83, 0x89, 0xe2, 0x2b, 0x3b, 0x6f, 0x43, 0x5d, 0xf9, 0x89, 0xe0, 0x4a, 0xb7, 0xd5,
-	0xa7, 0xcb, 0x15, 0x7d, 0x06, 0x74, 0x61, 0x5d, 0x76, 0x5d, 0xae, 0x40, 0x2e, 0x54, 0xe1, 0x13,
-	0xdc, 0x5c, 0xe8, 0x1c, 0x4d, 0xa2, 0x70, 0xc4, 0xa9, 0x05, 0x8d, 0x41, 0xfe, 0x65, 0x9a, 0x98,
-	0x23, 0xed, 0x01, 0xc8, 0x98, 0x27, 0x7e, 0xf6, 0xea, 0x68, 0x55, 0x5b, 0xb5, 0x4e, 0xb3, 0xbb,
-	0xcb, 0xfe, 0xdd, 0x36, 0xf6, 0x16, 0x79, 0xf2, 0xca, 0x30, 0xbd, 0xa5, 0xa1, 0xc3, 0xcd, 0xac,
-	0x85, 0x11, 0x6c, 0x07, 0x60, 0xfd, 0x6d, 0x5f, 0x96, 0x79, 0x0e, 0x1b, 0x26, 0x66, 0xf6, 0x64,
-	0x99, 0xd7, 0xc3, 0xff, 0x7b, 0xe9, 0x09, 0x6f, 0x31, 0xdb, 0xfd, 0x56, 0x85, 0x5a, 0x1f, 0x05,
-	0x7d, 0x01, 0x57, 0xf2, 0xe5, 0xdc, 0x39, 0x4f, 0x45, 0xaf, 0x8d, 0x7d, 0x77, 0x0d, 0x58, 0x46,
-	0x7b, 0x0d, 0x0d, 0xf3, 0xea, 0xce, 0x0a, 0xbe, 0xc6, 0xed, 0xfb, 0xeb, 0xf1, 0x52, 0x32, 0x80,
-	0xeb, 0x7f, 0xbe, 0xc2, 0xbd, 0xf5, 0x83, 0x05, 0xcb, 0xde, 0xbf, 0x08, 0xcb, 0x98, 0xd8, 0x57,
-	0x3f, 0x9f, 0x9d, 0xec, 0x91, 0xa3, 0x27, 0x3f, 0x66, 0x0e, 0x39, 0x9d, 0x39, 0xe4, 0xd7, 0xcc,
-	0x21, 0xdf, 0xe7, 0x4e, 0xe5, 0x74, 0xee, 0x54, 0x7e, 0xce, 0x9d, 0xca, 0x3b, 0xbb, 0x50, 0xc3,
-	0xf0, 0x23, 0x1b, 0x4a, 0x77, 0xba, 0xfc, 0xe7, 0x0f, 0xea, 0xf9, 0x0a, 0x3d, 0xfe, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x38, 0x4c, 0xe4, 0x12, 0x7b, 0x04, 0x00, 0x00,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0xf4, 0x23, 0x74, 0x52, 0x28, 0x5a, 0x55, 0xc5, 0x75, 0x25, 0xb7, 0x0d, 0x08,
+	0x42, 0x55, 0xd6, 0x4d, 0xe1, 0xd4, 0x5b, 0x82, 0xf8, 0x3a, 0x44, 0x08, 0x0b, 0x2e, 0x5c, 0x90,
+	0x3f, 0xb6, 0x8b, 0xd5, 0xc4, 0x1b, 0x79, 0xd6, 0x51, 0x72, 0x40, 0x42, 0x3c, 0x00, 0xe2, 0x39,
+	0x38, 0xf5, 0x31, 0x7a, 0xec, 0x91, 0x13, 0xa0, 0x04, 0xa9, 0xaf, 0x81, 0x6c, 0xaf, 0x9d, 0x14,
+	0xda, 0x50, 0x4e, 0xd9, 0xdd, 0xff, 0xcc, 0x7f, 0xf6, 0x37, 0x3b, 0x31, 0x6c, 0x78, 0x02, 0xbb,
+	0x02, 0x2d, 0xc7, 0xf3, 0x44, 0x1c, 0x4a, 0xb4, 0xfa, 0x0d, 0x4b, 0x0e, 0x68, 0x2f, 0x12, 0x52,
+	0x10, 0x92, 0x89, 0x34, 0x17, 0x69, 0xbf, 0x61, 0xac, 0x73, 0x21, 0x78, 0x87, 0x59, 0x69, 0x84,
+	0x1b, 0x1f, 0x5a, 0x4e, 0x38, 0xcc, 0xc2, 0x8d, 0x55, 0x2e, 0xb8, 0x48, 0x97, 0x56, 0xb2, 0x52,
+	0xa7, 0xa6, 0xaa, 0xe0, 0x3a, 0xc8, 0xac, 0x7e, 0xc3, 0x65, 0xd2, 0x69, 0x58, 0x9e, 0x08, 0x42,
+	0xa5, 0xdf, 0x52, 0x7a, 0x17, 0x79, 0x52, 0xbc, 0x8b, 0x5c, 0x09, 0xbb, 0x17, 0x5c, 0x4d, 0xad,
+	0xdf, 0x39, 0x2e, 0xca, 0xc8, 0xf1, 0x64, 0x20, 0x94, 0x4d, 0xed, 0x97, 0x06, 0x95, 0x36, 0xf2,
+	0x17, 0x61, 0x20, 0xc9, 0x1a, 0x2c, 0x22, 0x0b, 0x7d, 0x16, 0xe9, 0xda, 0x96, 0x56, 0x5f, 0xb2,
+	0xd5, 0x8e, 0x6c, 0xc3, 0x72, 0x6e, 0x20, 0x87, 0x3d, 0xa6, 0x97, 0x53, 0xb5, 0xaa, 0xce, 0x5e,
+	0x0f, 0x7b, 0x8c, 0x50, 0xa8, 0x74, 0x19, 0xa2, 0xc3, 0x99, 0x3e, 0xb7, 0xa5, 0xd5, 0xab, 0xfb,
+	0xab, 0x34, 0x03, 0xa6, 0x39, 0x30, 0x6d, 0x86, 0x43, 0x3b, 0x0f, 0x22, 0x0e, 0x2c, 0x1c, 0xc6,
+	0xa1, 0x8f, 0xfa, 0xfc, 0xd6, 0x5c, 0xbd, 0xba, 0xbf, 0x4e, 0x55, 0xcb, 0x12, 0x5a, 0xaa, 0x68,
+	0xe9, 0x63, 0x11, 0x84, 0xad, 0xbd, 0x93, 0xef, 0x9b, 0xa5, 0xaf, 0x3f, 0x36, 0xeb, 0x3c, 0x90,
+	0xef, 0x63, 0x97, 0x7a, 0xa2, 0x6b, 0x29, 0xc2, 0xec, 0xe7, 0x01, 0xfa, 0x47, 0x56, 0x72, 0x2f,
+	0x4c, 0x13, 0xd0, 0xce, 0x9c, 0x0f, 0xaa, 0x9f, 0xce, 0x8e, 0x77, 0x14, 0x42, 0xad, 0x03, 0x2b,
+	0x8a, 0xd2, 0x66, 0xd8, 0x13, 0x21, 0x32, 0x72, 0x0f, 0x56, 0x8a, 0xb6, 0xf8, 0x7e, 0xc4, 0x10,
+	0x15, 0xf6, 0x0d, 0x75, 0xdc, 0xcc, 0x4e, 0xc9, 0x1e, 0x5c, 0x8b, 0x54, 0x92, 0x5e, 0x9e, 0x01,
+	0x57, 0x44, 0xd5, 0x86, 0x00, 0x6d, 0xe4, 0x4f, 0x06, 0xcc, 0x8b, 0x25, 0xbb, 0xb4, 0xad, 0x6b,
+	0xb0, 0x28, 0x9d, 0x88, 0x33, 0xa9, 0x1a, 0xaa, 0x76, 0xff, 0xdb, 0xcb, 0xf3, 0xa0, 0x4f, 0x81,
+	0x4c, 0x4a, 0x17, 0xac, 0xd3, 0x08, 0xda, 0x95, 0x10, 0x3e, 0xc0, 0xcd, 0x89, 0x4f, 0x2b, 0x0e,
+	0xfd, 0x0e, 0x23, 0x3a, 0x54, 0xdc, 0x74, 0x95, 0x93, 0xe4, 0x5b, 0xd2, 0x04, 0x10, 0x3d, 0x16,
+	0x39, 0xc9, 0x60, 0xa1, 0x5e, 0x4e, 0xdf, 0x74, 0x9b, 0xfe, 0xfd, 0x37, 0xa0, 0x6f, 0x90, 0x45,
+	0x2f, 0xf3, 0x48, 0x7b, 0x2a, 0xe9, 0x60, 0x39, 0xa1, 0xc8, 0x0d, 0x6b, 0x1e, 0xe8, 0x7f, 0x96,
+	0x2f, 0x60, 0x9e, 0xc1, 0x52, 0x7e, 0xcd, 0xe4, 0xc9, 0x92, 0x5a, 0xf7, 0xff, 0x5d, 0x4b, 0x65,
+	0xd8, 0x93, 0xdc, 0xfd, 0xcf, 0x65, 0x98, 0x6b, 0x23, 0x27, 0xcf, 0x61, 0x3e, 0x9d, 0xff, 0x8d,
+	0x8b, 0x5c, 0xd4, 0xd8, 0x18, 0xb7, 0x67, 0x88, 0xc5, 0xd5, 0x5e, 0x41, 0x25, 0x7f, 0x75, 0xf3,
+	0x92, 0x78, 0xa5, 0x1b, 0x77, 0x67, 0xeb, 0x85, 0xa5, 0x07, 0xd7, 0xcf, 0xbf, 0xc2, 0x9d, 0xd9,
+	0x89, 0x59, 0x94, 0xb1, 0x7b, 0x95, 0xa8, 0xbc, 0x88, 0xb1, 0xf0, 0xf1, 0xec, 0x78, 0x47, 0x6b,
+	0x3d, 0x3a, 0x19, 0x99, 0xda, 0xe9, 0xc8, 0xd4, 0x7e, 0x8e, 0x4c, 0xed, 0xcb, 0xd8, 0x2c, 0x9d,
+	0x8e, 0xcd, 0xd2, 0xb7, 0xb1, 0x59, 0x7a, 0x6b, 0x64, 0x6e, 0xe8, 0x1f, 0xd1, 0x40, 0x58, 0x83,
+	0xe9, 0x8f, 0x8b, 0xbb, 0x98, 0x8e, 0xd0, 0xc3, 0xdf, 0x03, 0x00, 0xd3, 0x41, 0x71, 0x8a, 0x14,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -586,6 +605,20 @@ func (m *MsgInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Message != nil {
 		{
 			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
@@ -851,6 +884,12 @@ func (m *MsgInit) Size() (n int) {
 		l = m.Message.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1074,6 +1113,40 @@ func (m *MsgInit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types1.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
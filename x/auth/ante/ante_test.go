@@ -14,9 +14,13 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/keeper"
+	authsigning "cosmossdk.io/x/auth/signing"
+	authtx "cosmossdk.io/x/auth/tx"
 	authtypes "cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -604,6 +608,41 @@ func TestAnteHandlerSequences(t *testing.T) {
 	}
 }
 
+// Test that the key of a base account migrated to x/accounts cannot sign txs
+// anymore.
+func TestAnteHandlerMigratedAccount(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	feeAmount := testdata.NewTestFeeAmount()
+	accs := suite.CreateTestAccounts(1)
+	addr := accs[0].acc.GetAddress()
+	args := TestCaseArgs{
+		chainID:   suite.ctx.ChainID(),
+		feeAmount: feeAmount,
+		gasLimit:  testdata.NewTestGasLimit(),
+		msgs:      []sdk.Msg{testdata.NewTestMsg(addr)},
+	}.WithAccountsInfo(accs)
+
+	// the key signs before the migration
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, feeAmount).Return(nil)
+	suite.RunTestCase(t, TestCase{desc: "signature before migration", expPass: true}, args)
+
+	suite.accountsModKeeper.EXPECT().
+		MigrateLegacyAccount(gomock.Any(), []byte(addr), "multisig", gomock.Any(), uint64(1), nil).
+		Return(nil, nil)
+	_, err := keeper.NewMsgServerImpl(suite.accountKeeper).MigrateAccount(suite.ctx, &authtypes.MsgMigrateAccount{
+		Signer:      addr.String(),
+		AccountType: "multisig",
+	})
+	require.NoError(t, err)
+
+	// the key cannot sign for the address anymore, with any sequence
+	for _, seq := range []uint64{0, 1, 2} {
+		args.accSeqs = []uint64{seq}
+		suite.RunTestCase(t, TestCase{desc: "signature after migration", expErr: sdkerrors.ErrUnknownAddress}, args)
+	}
+}
+
 // Test logic around fee deduction.
 func TestAnteHandlerFees(t *testing.T) {
 	// Same data for every test cases
@@ -1449,3 +1488,80 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	_, err = suite.anteHandler(suite.ctx, tx, false)
 	require.NotNil(t, err, "antehandler on recheck did not fail once feePayer no longer has sufficient funds")
 }
+
+func TestAnteHandlerEIP712(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	// SIGN_MODE_EIP_712 is not enabled by default, so we create a custom
+	// TxConfig and ante handler here which include it.
+	txConfig, err := authtx.NewTxConfigWithOptions(suite.encCfg.Codec, authtx.ConfigOptions{
+		EnabledSignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_EIP_712},
+	})
+	require.NoError(t, err)
+	suite.clientCtx = suite.clientCtx.WithTxConfig(txConfig)
+	suite.anteHandler, err = ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   suite.accountKeeper,
+			BankKeeper:      suite.bankKeeper,
+			FeegrantKeeper:  suite.feeGrantKeeper,
+			SignModeHandler: txConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+	)
+	require.NoError(t, err)
+
+	priv := ethsecp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	feeAmount := testdata.NewTestFeeAmount()
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, feeAmount).Return(nil).AnyTimes()
+	gasLimit := testdata.NewTestGasLimit()
+
+	newTx := func(t *testing.T, priv cryptotypes.PrivKey, seq uint64) authsigning.Tx {
+		t.Helper()
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(feeAmount)
+		suite.txBuilder.SetGasLimit(gasLimit)
+		suite.txBuilder.SetMemo("eip-712")
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_EIP_712)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// a signature of another key, of the address of the eth_secp256k1 key, is rejected
+	_, err = suite.anteHandler(suite.ctx, newTx(t, otherPriv, 0), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	// a signature of a tampered tx is rejected
+	newTx(t, priv, 0)
+	suite.txBuilder.SetMemo("tampered")
+	_, err = suite.anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a signature with the wrong sign mode is rejected
+	sigs, err := newTx(t, priv, 0).GetSignaturesV2()
+	require.NoError(t, err)
+	sigs[0].Data.(*signing.SingleSignatureData).SignMode = signing.SignMode_SIGN_MODE_DIRECT
+	require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+	_, err = suite.anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the EIP-712 signature of the eth_secp256k1 key is accepted
+	tx := newTx(t, priv, 0)
+	_, err = suite.anteHandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+
+	acc = suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.True(t, priv.PubKey().Equals(acc.GetPubKey()))
+	require.Equal(t, uint64(1), acc.GetSequence())
+
+	// and cannot be replayed
+	_, err = suite.anteHandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+}
@@ -199,7 +199,10 @@ func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. Likewise, if a timeout timestamp is provided
+// and is before the current block time, then an error is returned. As only
+// SIGN_MODE_DIRECT and SIGN_MODE_DIRECT_AUX sign the timeout timestamp, txs with
+// a timeout timestamp signed with other sign modes are rejected.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
@@ -213,5 +216,20 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		)
 	}
 
+	if timestampTx, ok := tx.(sdk.TxWithTimeoutTimestamp); ok && !timestampTx.GetTimeoutTimestamp().IsZero() {
+		if !simulate {
+			if err := validateDirectSignModes(tx, "txs with a timeout timestamp"); err != nil {
+				return ctx, err
+			}
+		}
+
+		blockTime, timeoutTimestamp := ctx.HeaderInfo().Time, timestampTx.GetTimeoutTimestamp()
+		if blockTime.After(timeoutTimestamp) {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", blockTime, timeoutTimestamp,
+			)
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
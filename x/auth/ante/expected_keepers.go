@@ -2,6 +2,7 @@ package ante
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/x/auth/types"
@@ -23,3 +24,10 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// UnorderedTxManager defines the expected store of the unordered txs which are
+// not expired, used for their replay protection.
+type UnorderedTxManager interface {
+	ContainsUnorderedTx(ctx context.Context, timeout time.Time, txHash []byte) (bool, error)
+	AddUnorderedTx(ctx context.Context, timeout time.Time, txHash []byte) error
+}
@@ -19,6 +19,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -263,7 +264,7 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 
 func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 	switch typedPubKey := pubKey.(type) {
-	case *secp256k1.PubKey:
+	case *secp256k1.PubKey, *ethsecp256k1.PubKey:
 		pubKeyObject, err := secp256k1dcrd.ParsePubKey(typedPubKey.Bytes())
 		if err != nil {
 			if errors.Is(err, secp256k1dcrd.ErrPubKeyNotOnCurve) {
@@ -324,6 +325,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
 	}
 
+	unordered := isUnorderedTx(tx)
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signers[i])
 		if err != nil {
@@ -340,7 +342,10 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, err
 		}
 
-		if sig.Sequence != acc.GetSequence() {
+		// unordered txs are protected against replay by the UnorderedTxDecorator,
+		// which must then be part of the ante chain, rather than by the sequences
+		// of their signers
+		if !unordered && sig.Sequence != acc.GetSequence() {
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -363,7 +368,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				Address:       acc.GetAddress().String(),
 				ChainID:       chainID,
 				AccountNumber: accNum,
-				Sequence:      acc.GetSequence(),
+				Sequence:      sig.Sequence,
 				PubKey: &anypb.Any{
 					TypeUrl: anyPk.TypeUrl,
 					Value:   anyPk.Value,
@@ -424,10 +429,14 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return sdk.Context{}, err
 	}
 
+	// the sequences are left unchanged by unordered txs
+	unordered := isUnorderedTx(tx)
 	for _, signer := range signers {
 		acc := isd.ak.GetAccount(ctx, signer)
-		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
-			panic(err)
+		if !unordered {
+			if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+				panic(err)
+			}
 		}
 
 		pubKey := acc.GetPubKey()
@@ -438,7 +447,9 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 			return ctx, err
 		}
 
-		isd.ak.SetAccount(ctx, acc)
+		if !unordered {
+			isd.ak.SetAccount(ctx, acc)
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -498,6 +509,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case *ethsecp256k1.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+
 	case *secp256r1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
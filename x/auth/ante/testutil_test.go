@@ -43,15 +43,16 @@ type TestAccount struct {
 
 // AnteTestSuite is a test suite to be used with ante handler tests.
 type AnteTestSuite struct {
-	anteHandler    sdk.AnteHandler
-	ctx            sdk.Context
-	clientCtx      client.Context
-	txBuilder      client.TxBuilder
-	accountKeeper  keeper.AccountKeeper
-	bankKeeper     *authtestutil.MockBankKeeper
-	txBankKeeper   *txtestutil.MockBankKeeper
-	feeGrantKeeper *antetestutil.MockFeegrantKeeper
-	encCfg         moduletestutil.TestEncodingConfig
+	anteHandler       sdk.AnteHandler
+	ctx               sdk.Context
+	clientCtx         client.Context
+	txBuilder         client.TxBuilder
+	accountKeeper     keeper.AccountKeeper
+	accountsModKeeper *authtestutil.MockAccountsModKeeper
+	bankKeeper        *authtestutil.MockBankKeeper
+	txBankKeeper      *txtestutil.MockBankKeeper
+	feeGrantKeeper    *antetestutil.MockFeegrantKeeper
+	encCfg            moduletestutil.TestEncodingConfig
 }
 
 // SetupTest setups a new test, with new app, context, and anteHandler.
@@ -62,6 +63,8 @@ func SetupTestSuite(t *testing.T, isCheckTx bool) *AnteTestSuite {
 	suite.bankKeeper = authtestutil.NewMockBankKeeper(ctrl)
 	suite.txBankKeeper = txtestutil.NewMockBankKeeper(ctrl)
 	suite.feeGrantKeeper = antetestutil.NewMockFeegrantKeeper(ctrl)
+	suite.accountsModKeeper = authtestutil.NewMockAccountsModKeeper(ctrl)
+	suite.accountsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
@@ -81,6 +84,7 @@ func SetupTestSuite(t *testing.T, isCheckTx bool) *AnteTestSuite {
 		suite.encCfg.Codec, runtime.NewKVStoreService(key), types.ProtoBaseAccount, maccPerms, authcodec.NewBech32Codec("cosmos"),
 		sdk.Bech32MainPrefix, types.NewModuleAddress("gov").String(),
 	)
+	suite.accountKeeper.SetAccountsModKeeper(suite.accountsModKeeper)
 	suite.accountKeeper.GetModuleAccount(suite.ctx, types.FeeCollectorName)
 	err := suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams())
 	require.NoError(t, err)
@@ -95,11 +99,12 @@ func SetupTestSuite(t *testing.T, isCheckTx bool) *AnteTestSuite {
 
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   suite.accountKeeper,
-			BankKeeper:      suite.bankKeeper,
-			FeegrantKeeper:  suite.feeGrantKeeper,
-			SignModeHandler: suite.encCfg.TxConfig.SignModeHandler(),
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:      suite.accountKeeper,
+			BankKeeper:         suite.bankKeeper,
+			FeegrantKeeper:     suite.feeGrantKeeper,
+			SignModeHandler:    suite.encCfg.TxConfig.SignModeHandler(),
+			SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxManager: suite.accountKeeper,
 		},
 	)
 
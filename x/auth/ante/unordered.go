@@ -0,0 +1,136 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// DefaultMaxUnorderedTxTimeoutDuration is the default maximum duration between
+// the block time and the timeout timestamp of an unordered tx. It bounds the
+// number of unordered txs kept in state for their replay protection.
+const DefaultMaxUnorderedTxTimeoutDuration = 10 * time.Minute
+
+// UnorderedTxDecorator defines an AnteHandler decorator that protects unordered
+// txs against replay. Unordered txs do not use the sequences of their signers,
+// they must instead set a timeout timestamp, and the hash of each of them is
+// kept in state until this timeout: an unordered tx is rejected if its hash is
+// already known.
+//
+// As the sequences of the signers are not part of the signed bytes of an
+// unordered tx, they must all be signed with SIGN_MODE_DIRECT or
+// SIGN_MODE_DIRECT_AUX, which sign the whole tx body.
+type UnorderedTxDecorator struct {
+	maxTimeoutDuration time.Duration
+	utm                UnorderedTxManager
+}
+
+func NewUnorderedTxDecorator(maxTimeoutDuration time.Duration, utm UnorderedTxManager) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		maxTimeoutDuration: maxTimeoutDuration,
+		utm:                utm,
+	}
+}
+
+func (d UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		// the tx is protected against replay by the sequences of its signers
+		return next(ctx, tx, simulate)
+	}
+
+	if d.utm == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered txs are not supported")
+	}
+
+	blockTime := ctx.HeaderInfo().Time
+	timeout := unorderedTx.GetTimeoutTimestamp()
+	if timeout.IsZero() {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must have a timeout timestamp")
+	}
+	if timeout.Before(blockTime) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", blockTime, timeout,
+		)
+	}
+	if maxTimeout := blockTime.Add(d.maxTimeoutDuration); timeout.After(maxTimeout) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout timestamp %s exceeds the maximum %s", timeout, maxTimeout,
+		)
+	}
+
+	if !simulate {
+		if err := validateDirectSignModes(tx, "unordered txs"); err != nil {
+			return ctx, err
+		}
+	}
+
+	txHash := sha256.Sum256(ctx.TxBytes())
+	contains, err := d.utm.ContainsUnorderedTx(ctx, timeout, txHash[:])
+	if err != nil {
+		return ctx, err
+	}
+	if contains {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx %X is duplicated", txHash)
+	}
+
+	if err := d.utm.AddUnorderedTx(ctx, timeout, txHash[:]); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateDirectSignModes checks that all the signatures of a tx sign its whole
+// body, as other sign modes do not cover the unordered and timeout_timestamp
+// fields. txKind describes the txs which are subject to the check in errors.
+func validateDirectSignModes(tx sdk.Tx, txKind string) error {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	for _, sig := range sigs {
+		if !onlyDirectSigners(sig.Data) {
+			return errorsmod.Wrapf(sdkerrors.ErrNotSupported, "%s must be signed with SIGN_MODE_DIRECT or SIGN_MODE_DIRECT_AUX", txKind)
+		}
+	}
+
+	return nil
+}
+
+// onlyDirectSigners checks SignatureData to see if all signers are using
+// SIGN_MODE_DIRECT or SIGN_MODE_DIRECT_AUX.
+func onlyDirectSigners(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_DIRECT || v.SignMode == signing.SignMode_SIGN_MODE_DIRECT_AUX
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if !onlyDirectSigners(s) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// isUnorderedTx returns true if the tx is unordered, and is thus not protected
+// against replay by the sequences of its signers.
+func isUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
@@ -0,0 +1,182 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/auth/ante"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestUnorderedTxDecorator(t *testing.T) {
+	now := time.Unix(1_000_000, 0).UTC()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	testCases := []struct {
+		name        string
+		unordered   bool
+		timeout     time.Time
+		signMode    signing.SignMode
+		simulate    bool
+		replay      bool
+		expectedErr error
+	}{
+		{"ordered tx", false, time.Time{}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, false, false, nil},
+		{"unordered tx", true, now.Add(time.Minute), signing.SignMode_SIGN_MODE_DIRECT, false, false, nil},
+		{"unordered tx (max timeout)", true, now.Add(ante.DefaultMaxUnorderedTxTimeoutDuration), signing.SignMode_SIGN_MODE_DIRECT, false, false, nil},
+		{"unordered tx (direct aux)", true, now.Add(time.Minute), signing.SignMode_SIGN_MODE_DIRECT_AUX, false, false, nil},
+		{"unordered tx without timeout", true, time.Time{}, signing.SignMode_SIGN_MODE_DIRECT, false, false, sdkerrors.ErrInvalidRequest},
+		{"unordered tx timed out", true, now.Add(-time.Second), signing.SignMode_SIGN_MODE_DIRECT, false, false, sdkerrors.ErrTxTimeout},
+		{"unordered tx timeout too far", true, now.Add(ante.DefaultMaxUnorderedTxTimeoutDuration + time.Second), signing.SignMode_SIGN_MODE_DIRECT, false, false, sdkerrors.ErrInvalidRequest},
+		{"unordered tx signed with amino json", true, now.Add(time.Minute), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, false, false, sdkerrors.ErrNotSupported},
+		{"unordered tx signed with amino json (simulate)", true, now.Add(time.Minute), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, true, false, nil},
+		{"unordered tx replayed", true, now.Add(time.Minute), signing.SignMode_SIGN_MODE_DIRECT, false, true, sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			antehandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnorderedTxTimeoutDuration, suite.accountKeeper))
+
+			require.NoError(t, suite.txBuilder.SetMsgs(msg))
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.SetUnordered(tc.unordered)
+			suite.txBuilder.SetTimeoutTimestamp(tc.timeout)
+
+			// the decorator only checks the sign modes of the signatures
+			require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey: priv1.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: tc.signMode},
+			}))
+			tx := suite.txBuilder.GetTx()
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now}).WithTxBytes(txBytes)
+			if tc.replay {
+				_, err = antehandler(ctx, tx, tc.simulate)
+				require.NoError(t, err)
+			}
+
+			_, err = antehandler(ctx, tx, tc.simulate)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	// the decorator rejects unordered txs without an unordered tx manager
+	suite := SetupTestSuite(t, true)
+	antehandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnorderedTxTimeoutDuration, nil))
+
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetUnordered(true)
+	suite.txBuilder.SetTimeoutTimestamp(now.Add(time.Minute))
+	tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	_, err = antehandler(suite.ctx.WithHeaderInfo(header.Info{Time: now}), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+}
+
+func TestTxTimeoutTimestamp(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	now := time.Unix(1_000_000, 0).UTC()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutHeightDecorator())
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+
+	testCases := []struct {
+		name        string
+		timeout     time.Time
+		signMode    signing.SignMode
+		expectedErr error
+	}{
+		{"default value", time.Time{}, signing.SignMode_SIGN_MODE_DIRECT, nil},
+		{"no timeout (later timestamp)", now.Add(time.Second), signing.SignMode_SIGN_MODE_DIRECT, nil},
+		{"no timeout (same timestamp)", now, signing.SignMode_SIGN_MODE_DIRECT, nil},
+		{"timeout (earlier timestamp)", now.Add(-time.Second), signing.SignMode_SIGN_MODE_DIRECT, sdkerrors.ErrTxTimeout},
+		{"no timestamp with amino json", time.Time{}, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil},
+		{"timestamp not covered by amino json", now.Add(time.Second), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sdkerrors.ErrNotSupported},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			require.NoError(t, suite.txBuilder.SetMsgs(msg))
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), tc.signMode)
+			require.NoError(t, err)
+
+			// the timeout timestamp is set after signing, as anyone could do for
+			// signatures which do not cover it
+			suite.txBuilder.SetTimeoutTimestamp(tc.timeout)
+
+			ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now})
+			_, err = antehandler(ctx, tx, false)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestAnteHandlerUnorderedTx(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	now := time.Unix(1_000_000, 0).UTC()
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Height: 1, Time: now})
+
+	accs := suite.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	runUnorderedTx := func(memo string, seq uint64) error {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.SetUnordered(true)
+		suite.txBuilder.SetTimeoutTimestamp(now.Add(time.Minute))
+
+		_, err := suite.DeliverMsgs(t, []cryptotypes.PrivKey{accs[0].priv}, []sdk.Msg{msg}, feeAmount, gasLimit,
+			[]uint64{accs[0].acc.GetAccountNumber()}, []uint64{seq}, suite.ctx.ChainID(), false)
+		return err
+	}
+
+	// the sequence of the signer is neither checked nor incremented
+	require.NoError(t, runUnorderedTx("first", 0))
+	require.NoError(t, runUnorderedTx("second", 5))
+	require.Equal(t, uint64(0), suite.accountKeeper.GetAccount(suite.ctx, accs[0].acc.GetAddress()).GetSequence())
+
+	// the same tx cannot be executed twice
+	require.ErrorIs(t, runUnorderedTx("first", 0), sdkerrors.ErrInvalidRequest)
+
+	// once pruned, the tx still cannot be replayed as it timed out
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Height: 2, Time: now.Add(time.Minute + time.Second)})
+	require.NoError(t, suite.accountKeeper.RemoveExpiredUnorderedTxs(suite.ctx))
+	require.ErrorIs(t, runUnorderedTx("first", 0), sdkerrors.ErrTxTimeout)
+}
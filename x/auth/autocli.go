@@ -87,6 +87,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "MigrateAccount",
+					Use:            "migrate-account [account-type] [account-init-msg]",
+					Short:          "Migrate the base account of the signer to an x/accounts account of the given type, keeping its address",
+					Example:        fmt.Sprintf(`%s tx auth migrate-account multisig '{"@type": "/cosmos.accounts.defaults.multisig.v1.MsgInit", "members": [...], "config": {...}}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account_type"}, {ProtoField: "account_init_msg"}},
+				},
 			},
 		},
 	}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/collections"
 
@@ -20,8 +21,12 @@ func (ak AccountKeeper) NewAccountWithAddress(ctx context.Context, addr sdk.AccA
 	return ak.NewAccount(ctx, acc)
 }
 
-// NewAccount sets the next account number to a given account interface
+// NewAccount sets the next account number to a given account interface.
+// It panics if the address of the account is owned by x/accounts.
 func (ak AccountKeeper) NewAccount(ctx context.Context, acc sdk.AccountI) sdk.AccountI {
+	if ak.isAccountsModAddress(ctx, acc.GetAddress()) {
+		panic(fmt.Errorf("address %s is owned by x/accounts", acc.GetAddress()))
+	}
 	if err := acc.SetAccountNumber(ak.NextAccountNumber(ctx)); err != nil {
 		panic(err)
 	}
@@ -30,9 +35,23 @@ func (ak AccountKeeper) NewAccount(ctx context.Context, acc sdk.AccountI) sdk.Ac
 }
 
 // HasAccount implements AccountKeeperI.
+// Addresses owned by x/accounts are reported as existing, so that no base
+// account is created for them, in particular for migrated base accounts. They
+// are only looked up when no x/auth account is stored at the address.
 func (ak AccountKeeper) HasAccount(ctx context.Context, addr sdk.AccAddress) bool {
-	has, _ := ak.Accounts.Has(ctx, addr)
-	return has
+	if has, _ := ak.Accounts.Has(ctx, addr); has {
+		return true
+	}
+	return ak.isAccountsModAddress(ctx, addr)
+}
+
+// isAccountsModAddress returns true if the address is owned by x/accounts: it
+// is the address of a migrated base account, or of an x/accounts account.
+func (ak AccountKeeper) isAccountsModAddress(ctx context.Context, addr sdk.AccAddress) bool {
+	if migrated, _ := ak.MigratedAccounts.Has(ctx, addr); migrated {
+		return true
+	}
+	return ak.accountsModKeeper != nil && ak.accountsModKeeper.IsAccountsModuleAccount(ctx, addr)
 }
 
 // GetAccount implements AccountKeeperI.
@@ -17,6 +17,18 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		panic(err)
 	}
 
+	// the migrated accounts are set first, so that no account can be set at
+	// their addresses
+	for _, addrStr := range data.MigratedAccounts {
+		addr, err := ak.addressCodec.StringToBytes(addrStr)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.MigratedAccounts.Set(ctx, addr); err != nil {
+			panic(err)
+		}
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		panic(err)
@@ -48,5 +60,20 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	var migratedAccounts []string
+	err := ak.MigratedAccounts.Walk(ctx, nil, func(addr sdk.AccAddress) (bool, error) {
+		addrStr, err := ak.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		migratedAccounts = append(migratedAccounts, addrStr)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.MigratedAccounts = migratedAccounts
+	return genState
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -27,7 +28,8 @@ type AccountKeeperI interface {
 	// Return a new account with the next account number. Does not save the new account to the store.
 	NewAccount(context.Context, sdk.AccountI) sdk.AccountI
 
-	// Check if an account exists in the store.
+	// Check if an account exists in the store. Addresses owned by x/accounts
+	// are reported as existing, while GetAccount returns nil for them.
 	HasAccount(context.Context, sdk.AccAddress) bool
 
 	// Retrieve an account from the store.
@@ -93,6 +95,11 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
+	// accountsModKeeper is the x/accounts keeper, it is optional and used to
+	// migrate base accounts to x/accounts accounts. It is set with
+	// SetAccountsModKeeper.
+	accountsModKeeper types.AccountsModKeeper
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -103,6 +110,12 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// UnorderedTxs key: timeout timestamp + tx hash, of the unordered txs which
+	// are not expired
+	UnorderedTxs collections.KeySet[collections.Pair[time.Time, []byte]]
+	// MigratedAccounts key: AccAddr, of the base accounts migrated to
+	// x/accounts, whose addresses cannot be used by x/auth accounts anymore
+	MigratedAccounts collections.KeySet[sdk.AccAddress]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -125,16 +138,18 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	ak := AccountKeeper{
-		addressCodec:  ac,
-		bech32Prefix:  bech32Prefix,
-		storeService:  storeService,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
-		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		addressCodec:     ac,
+		bech32Prefix:     bech32Prefix,
+		storeService:     storeService,
+		proto:            proto,
+		cdc:              cdc,
+		permAddrs:        permAddrs,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:    collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Accounts:         collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		UnorderedTxs:     collections.NewKeySet(sb, types.UnorderedTxsKeyPrefix, "unordered_txs", collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey)),
+		MigratedAccounts: collections.NewKeySet(sb, types.MigratedAccountsKeyPrefix, "migrated_accounts", sdk.AccAddressKey),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -144,6 +159,16 @@ func NewAccountKeeper(
 	return ak
 }
 
+// SetAccountsModKeeper sets the x/accounts keeper, without which base accounts
+// cannot be migrated to x/accounts.
+func (ak *AccountKeeper) SetAccountsModKeeper(accountsModKeeper types.AccountsModKeeper) {
+	if ak.accountsModKeeper != nil {
+		panic("cannot set the x/accounts keeper twice")
+	}
+
+	ak.accountsModKeeper = accountsModKeeper
+}
+
 // GetAuthority returns the x/auth module's authority.
 func (ak AccountKeeper) GetAuthority() string {
 	return ak.authority
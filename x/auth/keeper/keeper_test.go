@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -214,4 +215,53 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	nextNum = suite.accountKeeper.NextAccountNumber(ctx)
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
+
+	suite.SetupTest() // reset
+	ctx = suite.ctx
+	// the addresses of migrated accounts are tombstoned, and exported
+	migratedAddr := sdk.AccAddress(pubKey2.Address())
+	genState = types.GenesisState{
+		Params:           types.DefaultParams(),
+		MigratedAccounts: []string{migratedAddr.String()},
+	}
+
+	suite.accountKeeper.InitGenesis(ctx, genState)
+
+	suite.Require().Nil(suite.accountKeeper.GetAccount(ctx, migratedAddr))
+	suite.Require().True(suite.accountKeeper.HasAccount(ctx, migratedAddr))
+	suite.Require().Equal(genState.MigratedAccounts, suite.accountKeeper.ExportGenesis(ctx).MigratedAccounts)
+}
+
+func (suite *KeeperTestSuite) TestRemoveExpiredUnorderedTxs() {
+	now := time.Unix(1_000_000, 0).UTC()
+	hash1, hash2, hash3 := []byte("hash1"), []byte("hash2"), []byte("hash3")
+
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(suite.ctx, now.Add(-time.Minute), hash1))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(suite.ctx, now, hash2))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(suite.ctx, now.Add(time.Minute), hash3))
+
+	contains, err := suite.accountKeeper.ContainsUnorderedTx(suite.ctx, now.Add(-time.Minute), hash1)
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
+
+	// a tx is only known with its own timeout
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(suite.ctx, now, hash1)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+
+	// the txs are kept until the block time is past their timeout
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: now})
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, now.Add(-time.Minute), hash1)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, now, hash2)
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, now.Add(time.Minute), hash3)
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
 }
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -40,3 +42,43 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAccount) (*types.MsgMigrateAccountResponse, error) {
+	if ms.ak.accountsModKeeper == nil {
+		return nil, errors.New("account migration is not supported")
+	}
+
+	signer, err := ms.ak.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+	if msg.AccountType == "" {
+		return nil, errors.New("account type cannot be empty")
+	}
+
+	acc := ms.ak.GetAccount(ctx, signer)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Signer)
+	}
+	// only base accounts are migrated, as the state of other accounts, such as
+	// vesting accounts, would be lost.
+	baseAcc, ok := acc.(*types.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("only base accounts can be migrated, got %T", acc)
+	}
+
+	initResp, err := ms.ak.accountsModKeeper.MigrateLegacyAccount(ctx, signer, msg.AccountType, baseAcc.PubKey, baseAcc.Sequence, msg.AccountInitMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the address is now owned by x/accounts, balances and staking positions
+	// are kept as they are indexed by address. The address is tombstoned so
+	// that no x/auth account can use it anymore.
+	ms.ak.RemoveAccount(ctx, acc)
+	if err := ms.ak.MigratedAccounts.Set(ctx, signer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateAccountResponse{InitResponse: initResp}, nil
+}
@@ -1,7 +1,21 @@
 package keeper_test
 
 import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
+	storetypes "cosmossdk.io/store/types"
+	authcodec "cosmossdk.io/x/auth/codec"
+	"cosmossdk.io/x/auth/keeper"
+	authtestutil "cosmossdk.io/x/auth/testutil"
 	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +123,116 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigrateAccount() {
+	// the suite keeper has no x/accounts keeper
+	_, err := s.msgServer.MigrateAccount(s.ctx, &types.MsgMigrateAccount{})
+	s.Require().ErrorContains(err, "account migration is not supported")
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	accountsModKeeper := authtestutil.NewMockAccountsModKeeper(gomock.NewController(s.T()))
+	// accountsAddr is the address of an x/accounts account
+	accountsAddr := sdk.AccAddress("accounts")
+	accountsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), []byte(accountsAddr)).Return(true).AnyTimes()
+	accountsModKeeper.EXPECT().IsAccountsModuleAccount(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	ak := keeper.NewAccountKeeper(
+		s.encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		types.ProtoBaseAccount,
+		map[string][]string{randomPerm: {"random"}},
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		types.NewModuleAddress("gov").String(),
+	)
+	ak.SetAccountsModKeeper(accountsModKeeper)
+	msgServer := keeper.NewMsgServerImpl(ak)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := ak.NewAccountWithAddress(ctx, addr)
+	s.Require().NoError(acc.SetPubKey(pubKey))
+	s.Require().NoError(acc.SetSequence(7))
+	ak.SetAccount(ctx, acc)
+	ak.GetModuleAccount(ctx, randomPerm)
+
+	initMsg, err := codectypes.NewAnyWithValue(&types.Params{})
+	s.Require().NoError(err)
+	initResp, err := codectypes.NewAnyWithValue(&types.MsgUpdateParamsResponse{})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgMigrateAccount
+		malleate  func()
+		expErrMsg string
+	}{
+		{
+			name:      "invalid signer",
+			req:       &types.MsgMigrateAccount{Signer: "foo", AccountType: "multisig"},
+			expErrMsg: "invalid signer address",
+		},
+		{
+			name:      "empty account type",
+			req:       &types.MsgMigrateAccount{Signer: addr.String()},
+			expErrMsg: "account type cannot be empty",
+		},
+		{
+			name:      "unknown account",
+			req:       &types.MsgMigrateAccount{Signer: sdk.AccAddress("unknown").String(), AccountType: "multisig"},
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "module account",
+			req:       &types.MsgMigrateAccount{Signer: types.NewModuleAddress(randomPerm).String(), AccountType: "multisig"},
+			expErrMsg: "only base accounts can be migrated",
+		},
+		{
+			name: "migration failure keeps the account",
+			req:  &types.MsgMigrateAccount{Signer: addr.String(), AccountType: "unknown", AccountInitMsg: initMsg},
+			malleate: func() {
+				accountsModKeeper.EXPECT().
+					MigrateLegacyAccount(gomock.Any(), []byte(addr), "unknown", gomock.Any(), uint64(7), initMsg).
+					Return(nil, errors.New("account type not found"))
+			},
+			expErrMsg: "account type not found",
+		},
+		{
+			name: "success",
+			req:  &types.MsgMigrateAccount{Signer: addr.String(), AccountType: "multisig", AccountInitMsg: initMsg},
+			malleate: func() {
+				accountsModKeeper.EXPECT().
+					MigrateLegacyAccount(gomock.Any(), []byte(addr), "multisig", acc.(*types.BaseAccount).PubKey, uint64(7), initMsg).
+					Return(initResp, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+			resp, err := msgServer.MigrateAccount(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(initResp, resp.InitResponse)
+		})
+	}
+
+	// the base account is removed, and its address is tombstoned
+	s.Require().Nil(ak.GetAccount(ctx, addr))
+	migrated, err := ak.MigratedAccounts.Has(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(migrated)
+
+	// no x/auth account can be created at the addresses owned by x/accounts
+	for _, addr := range []sdk.AccAddress{addr, accountsAddr} {
+		s.Require().True(ak.HasAccount(ctx, addr))
+		s.Require().Panics(func() { ak.NewAccountWithAddress(ctx, addr) })
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContainsUnorderedTx returns true if the unordered tx with the given timeout
+// and hash was already executed, and is not yet expired.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx context.Context, timeout time.Time, txHash []byte) (bool, error) {
+	return ak.UnorderedTxs.Has(ctx, collections.Join(timeout, txHash))
+}
+
+// AddUnorderedTx adds an unordered tx with the given timeout and hash, which is
+// kept until it expires.
+func (ak AccountKeeper) AddUnorderedTx(ctx context.Context, timeout time.Time, txHash []byte) error {
+	return ak.UnorderedTxs.Set(ctx, collections.Join(timeout, txHash))
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered txs whose timeout is before
+// the block time, and which can thus no longer be executed.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+
+	iter, err := ak.UnorderedTxs.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var expired []collections.Pair[time.Time, []byte]
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+
+		// the txs are ordered by timeout
+		if !key.K1().Before(blockTime) {
+			break
+		}
+
+		expired = append(expired, key)
+	}
+
+	for _, key := range expired {
+		if err := ak.UnorderedTxs.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
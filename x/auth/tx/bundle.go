@@ -0,0 +1,209 @@
+package tx
+
+import (
+	"bytes"
+	"math"
+
+	protov2 "google.golang.org/protobuf/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+	"cosmossdk.io/x/tx/decode"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// bundleWrapper is a bundle of txs decoded from a tx.TxBundle. Its messages,
+// signers and signatures are those of its txs, in order, so that mempools
+// handle it like any other tx.
+type bundleWrapper struct {
+	txs      []*wrapper
+	txsBytes [][]byte
+}
+
+var (
+	_ sdk.BundleTx                = &bundleWrapper{}
+	_ authsigning.SigVerifiableTx = &bundleWrapper{}
+)
+
+// BundleCommitment returns the commitment of a bundle whose txs have the given
+// messages, in execution order, which each tx of the bundle must set with
+// SetBundleCommitment before being signed.
+func BundleCommitment(txsMsgs ...[]sdk.Msg) ([]byte, error) {
+	txsBodyBytes := make([][]byte, len(txsMsgs))
+	for i, msgs := range txsMsgs {
+		anys, err := tx.SetMsgs(msgs)
+		if err != nil {
+			return nil, err
+		}
+
+		txsBodyBytes[i], err = (&tx.TxBody{Messages: anys}).Marshal()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return decode.BundleCommitment(txsBodyBytes)
+}
+
+// decodeTxBundle decodes the bytes of a tx.TxBundle, which contain at least one
+// tx since they start with the txs field. Bundles cannot be nested, and each tx
+// must commit to the bundle and be signed with SIGN_MODE_DIRECT or
+// SIGN_MODE_DIRECT_AUX, the only sign modes covering its bundle commitment.
+func decodeTxBundle(cdc codec.Codec, txBytes []byte) (*bundleWrapper, error) {
+	var bundle tx.TxBundle
+
+	// reject all unknown proto fields in the TxBundle
+	err := unknownproto.RejectUnknownFieldsStrict(txBytes, &bundle, cdc.InterfaceRegistry())
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	err = cdc.Unmarshal(txBytes, &bundle)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	txs := make([]*wrapper, len(bundle.Txs))
+	txsBodyBytes := make([][]byte, len(bundle.Txs))
+	for i, bz := range bundle.Txs {
+		if decode.IsBundle(bz) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "bundled tx %d: tx bundles cannot be nested", i)
+		}
+
+		txs[i], err = decodeTxRaw(cdc, bz)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "bundled tx %d", i)
+		}
+		txsBodyBytes[i] = txs[i].bodyBz
+	}
+
+	commitment, err := decode.BundleCommitment(txsBodyBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	for i, tx := range txs {
+		switch {
+		case len(tx.GetBundleCommitment()) == 0:
+			return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "bundled tx %d: missing bundle commitment", i)
+		case !bytes.Equal(tx.GetBundleCommitment(), commitment):
+			return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "bundled tx %d: invalid bundle commitment", i)
+		}
+
+		for _, signerInfo := range tx.tx.AuthInfo.SignerInfos {
+			if !isDirectModeInfo(signerInfo.ModeInfo) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "bundled tx %d: bundled txs must be signed with %s or %s", i, signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_DIRECT_AUX)
+			}
+		}
+	}
+
+	return &bundleWrapper{txs: txs, txsBytes: bundle.Txs}, nil
+}
+
+// isDirectModeInfo returns true if the signer with the given mode info, and all
+// its signers if it is a multisig, sign with SIGN_MODE_DIRECT or
+// SIGN_MODE_DIRECT_AUX.
+func isDirectModeInfo(modeInfo *tx.ModeInfo) bool {
+	switch {
+	case modeInfo.GetSingle() != nil:
+		mode := modeInfo.GetSingle().Mode
+		return mode == signing.SignMode_SIGN_MODE_DIRECT || mode == signing.SignMode_SIGN_MODE_DIRECT_AUX
+	case modeInfo.GetMulti() != nil:
+		for _, modeInfo := range modeInfo.GetMulti().ModeInfos {
+			if !isDirectModeInfo(modeInfo) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *bundleWrapper) GetTxs() []sdk.Tx {
+	txs := make([]sdk.Tx, len(b.txs))
+	for i, tx := range b.txs {
+		txs[i] = tx
+	}
+	return txs
+}
+
+func (b *bundleWrapper) GetTxsBytes() [][]byte {
+	return b.txsBytes
+}
+
+func (b *bundleWrapper) GetMsgs() []sdk.Msg {
+	var msgs []sdk.Msg
+	for _, tx := range b.txs {
+		msgs = append(msgs, tx.GetMsgs()...)
+	}
+	return msgs
+}
+
+func (b *bundleWrapper) GetMsgsV2() ([]protov2.Message, error) {
+	var msgs []protov2.Message
+	for _, tx := range b.txs {
+		txMsgs, err := tx.GetMsgsV2()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, txMsgs...)
+	}
+	return msgs, nil
+}
+
+// GetGas returns the sum of the gas limits of the txs, capped to the maximum
+// uint64.
+func (b *bundleWrapper) GetGas() uint64 {
+	var gas uint64
+	for _, tx := range b.txs {
+		if gas > math.MaxUint64-tx.GetGas() {
+			return math.MaxUint64
+		}
+		gas += tx.GetGas()
+	}
+	return gas
+}
+
+func (b *bundleWrapper) GetSigners() ([][]byte, error) {
+	var signers [][]byte
+	for _, tx := range b.txs {
+		txSigners, err := tx.GetSigners()
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, txSigners...)
+	}
+	return signers, nil
+}
+
+func (b *bundleWrapper) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	var pks []cryptotypes.PubKey
+	for _, tx := range b.txs {
+		txPks, err := tx.GetPubKeys()
+		if err != nil {
+			return nil, err
+		}
+		pks = append(pks, txPks...)
+	}
+	return pks, nil
+}
+
+func (b *bundleWrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	var sigs []signing.SignatureV2
+	for _, tx := range b.txs {
+		txSigs, err := tx.GetSignaturesV2()
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, txSigs...)
+	}
+	return sigs, nil
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip712"
 	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -44,10 +45,16 @@ type ConfigOptions struct {
 	// TextualCoinMetadataQueryFn is the function that will be used to query coin metadata when constructing
 	// textual sign mode handler. This is required if SIGN_MODE_TEXTUAL is enabled.
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// EIP712 are the options that will be used when constructing the SIGN_MODE_EIP_712 sign mode handler, if it is
+	// enabled. The resolvers of SigningOptions are used if they are not set.
+	EIP712 eip712.SignModeHandlerOptions
 	// CustomSignModes are the custom sign modes that will be added to the txsigning.HandlerMap.
 	CustomSignModes []txsigning.SignModeHandler
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
 	ProtoDecoder sdk.TxDecoder
+	// EnableTxBundles makes the default protobuf decoder also decode tx bundles, see BundleTxDecoder.
+	// It is ignored if ProtoDecoder is specified.
+	EnableTxBundles bool
 	// ProtoEncoder is the encoder that will be used to encode protobuf transactions.
 	ProtoEncoder sdk.TxEncoder
 	// JSONDecoder is the decoder that will be used to decode json transactions.
@@ -68,7 +75,7 @@ var DefaultSignModes = []signingtypes.SignMode{
 // first enabled sign mode will become the default sign mode.
 //
 // NOTE: Use NewTxConfigWithOptions to provide a custom signing handler in case the sign mode
-// is not supported by default (eg: SignMode_SIGN_MODE_EIP_191), or to enable SIGN_MODE_TEXTUAL.
+// is not supported by default (eg: SignMode_SIGN_MODE_EIP_191), or to enable SIGN_MODE_TEXTUAL or SIGN_MODE_EIP_712.
 //
 // We prefer to use depinject to provide client.TxConfig, but we permit this constructor usage. Within the SDK,
 // this constructor is primarily used in tests, but also sees usage in app chains like:
@@ -152,6 +159,15 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 			if err != nil {
 				return nil, err
 			}
+		case signingtypes.SignMode_SIGN_MODE_EIP_712:
+			eip712Opts := configOpts.EIP712
+			if eip712Opts.FileResolver == nil {
+				eip712Opts.FileResolver = signingOpts.FileResolver
+			}
+			if eip712Opts.TypeResolver == nil {
+				eip712Opts.TypeResolver = signingOpts.TypeResolver
+			}
+			handlers[i] = eip712.NewSignModeHandler(eip712Opts)
 		}
 	}
 	for i, m := range configOpts.CustomSignModes {
@@ -174,6 +190,9 @@ func NewTxConfigWithOptions(protoCodec codec.Codec, configOptions ConfigOptions)
 	}
 	if configOptions.ProtoDecoder == nil {
 		txConfig.decoder = DefaultTxDecoder(protoCodec)
+		if configOptions.EnableTxBundles {
+			txConfig.decoder = BundleTxDecoder(protoCodec)
+		}
 	}
 	if configOptions.ProtoEncoder == nil {
 		txConfig.encoder = DefaultTxEncoder()
@@ -138,13 +138,17 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// the account keeper of x/auth also keeps the unordered txs
+	unorderedTxManager, _ := in.AccountKeeper.(ante.UnorderedTxManager)
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:      in.AccountKeeper,
+			BankKeeper:         in.BankKeeper,
+			SignModeHandler:    txConfig.SignModeHandler(),
+			FeegrantKeeper:     in.FeeGrantKeeper,
+			SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxManager: unorderedTxManager,
 		},
 	)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/protobuf/encoding/protowire"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/tx/decode"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
@@ -15,67 +16,101 @@ import (
 )
 
 // DefaultTxDecoder returns a default protobuf TxDecoder using the provided Marshaler.
+// It rejects tx bundles, which are decoded by BundleTxDecoder.
 func DefaultTxDecoder(cdc codec.Codec) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
-		// Make sure txBytes follow ADR-027.
-		err := rejectNonADR027TxRaw(txBytes)
-		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		if decode.IsBundle(txBytes) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx bundles are not enabled")
 		}
 
-		var raw tx.TxRaw
-
-		// reject all unknown proto fields in the root TxRaw
-		err = unknownproto.RejectUnknownFieldsStrict(txBytes, &raw, cdc.InterfaceRegistry())
+		theTx, err := decodeTxRaw(cdc, txBytes)
 		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+			return nil, err
+		}
+		return theTx, nil
+	}
+}
+
+// BundleTxDecoder returns a protobuf TxDecoder using the provided Marshaler,
+// which decodes both txs and tx bundles.
+func BundleTxDecoder(cdc codec.Codec) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if decode.IsBundle(txBytes) {
+			bundle, err := decodeTxBundle(cdc, txBytes)
+			if err != nil {
+				return nil, err
+			}
+			return bundle, nil
 		}
 
-		err = cdc.Unmarshal(txBytes, &raw)
+		theTx, err := decodeTxRaw(cdc, txBytes)
 		if err != nil {
 			return nil, err
 		}
+		return theTx, nil
+	}
+}
 
-		var body tx.TxBody
+// decodeTxRaw decodes the bytes of a tx.TxRaw.
+func decodeTxRaw(cdc codec.Codec, txBytes []byte) (*wrapper, error) {
+	// Make sure txBytes follow ADR-027.
+	err := rejectNonADR027TxRaw(txBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
 
-		// allow non-critical unknown fields in TxBody
-		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, true, cdc.InterfaceRegistry())
-		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
-		}
+	var raw tx.TxRaw
 
-		err = cdc.Unmarshal(raw.BodyBytes, &body)
-		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
-		}
+	// reject all unknown proto fields in the root TxRaw
+	err = unknownproto.RejectUnknownFieldsStrict(txBytes, &raw, cdc.InterfaceRegistry())
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
 
-		var authInfo tx.AuthInfo
+	err = cdc.Unmarshal(txBytes, &raw)
+	if err != nil {
+		return nil, err
+	}
 
-		// reject all unknown proto fields in AuthInfo
-		err = unknownproto.RejectUnknownFieldsStrict(raw.AuthInfoBytes, &authInfo, cdc.InterfaceRegistry())
-		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
-		}
+	var body tx.TxBody
 
-		err = cdc.Unmarshal(raw.AuthInfoBytes, &authInfo)
-		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
-		}
+	// allow non-critical unknown fields in TxBody
+	txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, true, cdc.InterfaceRegistry())
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
 
-		theTx := &tx.Tx{
-			Body:       &body,
-			AuthInfo:   &authInfo,
-			Signatures: raw.Signatures,
-		}
+	err = cdc.Unmarshal(raw.BodyBytes, &body)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
 
-		return &wrapper{
-			tx:                           theTx,
-			bodyBz:                       raw.BodyBytes,
-			authInfoBz:                   raw.AuthInfoBytes,
-			txBodyHasUnknownNonCriticals: txBodyHasUnknownNonCriticals,
-			cdc:                          cdc,
-		}, nil
+	var authInfo tx.AuthInfo
+
+	// reject all unknown proto fields in AuthInfo
+	err = unknownproto.RejectUnknownFieldsStrict(raw.AuthInfoBytes, &authInfo, cdc.InterfaceRegistry())
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
 	}
+
+	err = cdc.Unmarshal(raw.AuthInfoBytes, &authInfo)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	theTx := &tx.Tx{
+		Body:       &body,
+		AuthInfo:   &authInfo,
+		Signatures: raw.Signatures,
+	}
+
+	return &wrapper{
+		tx:                           theTx,
+		bodyBz:                       raw.BodyBytes,
+		authInfoBz:                   raw.AuthInfoBytes,
+		txBodyHasUnknownNonCriticals: txBodyHasUnknownNonCriticals,
+		cdc:                          cdc,
+	}, nil
 }
 
 // DefaultJSONTxDecoder returns a default protobuf JSON TxDecoder using the provided Marshaler.
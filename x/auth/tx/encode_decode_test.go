@@ -11,8 +11,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestDefaultTxDecoderError(t *testing.T) {
@@ -277,3 +280,87 @@ func TestVarintMinLength(t *testing.T) {
 		})
 	}
 }
+
+func TestTxBundle(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	std.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	encoder := DefaultTxEncoder()
+	decoder := BundleTxDecoder(cdc)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	msg1, msg2 := testdata.NewTestMsg(), testdata.NewTestMsg(addr)
+	commitment, err := BundleCommitment([]sdk.Msg{msg1}, []sdk.Msg{msg2})
+	require.NoError(t, err)
+
+	txBytes := func(msg sdk.Msg, memo string, gas uint64, commitment []byte, signMode signing.SignMode) []byte {
+		builder := newBuilder(nil)
+		require.NoError(t, builder.SetMsgs(msg))
+		builder.SetMemo(memo)
+		builder.SetGasLimit(gas)
+		builder.SetBundleCommitment(commitment)
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey: pubKey,
+			Data:   &signing.SingleSignatureData{SignMode: signMode},
+		}))
+		bz, err := encoder(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	bundleBytes := func(txs ...[]byte) []byte {
+		bz, err := (&tx.TxBundle{Txs: txs}).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+	tx1 := txBytes(msg1, "first", 100, commitment, signing.SignMode_SIGN_MODE_DIRECT)
+	tx2 := txBytes(msg2, "second", 200, commitment, signing.SignMode_SIGN_MODE_DIRECT_AUX)
+
+	decoded, err := decoder(bundleBytes(tx1, tx2))
+	require.NoError(t, err)
+	bundle, ok := decoded.(sdk.BundleTx)
+	require.True(t, ok)
+	require.Equal(t, [][]byte{tx1, tx2}, bundle.GetTxsBytes())
+	require.Len(t, bundle.GetTxs(), 2)
+	require.Equal(t, "first", bundle.GetTxs()[0].(sdk.TxWithMemo).GetMemo())
+	require.Equal(t, "second", bundle.GetTxs()[1].(sdk.TxWithMemo).GetMemo())
+	require.Equal(t, commitment, bundle.GetTxs()[1].(sdk.TxWithBundleCommitment).GetBundleCommitment())
+	require.Len(t, bundle.GetMsgs(), 2)
+	require.Equal(t, uint64(300), bundle.(*bundleWrapper).GetGas())
+
+	// the bundle is encoded back to its original bytes
+	bz, err := encoder(bundle)
+	require.NoError(t, err)
+	require.Equal(t, bundleBytes(tx1, tx2), bz)
+
+	// a single bundled tx is decoded as any other tx
+	decoded, err = decoder(tx1)
+	require.NoError(t, err)
+	require.Equal(t, commitment, decoded.(sdk.TxWithBundleCommitment).GetBundleCommitment())
+
+	// the default decoder rejects bundles
+	_, err = DefaultTxDecoder(cdc)(bundleBytes(tx1, tx2))
+	require.ErrorContains(t, err, "tx bundles are not enabled")
+
+	_, err = decoder(bundleBytes(tx2, tx1))
+	require.ErrorContains(t, err, "bundled tx 0: invalid bundle commitment")
+
+	_, err = decoder(bundleBytes(tx1))
+	require.ErrorContains(t, err, "bundled tx 0: invalid bundle commitment")
+
+	_, err = decoder(bundleBytes(tx1, txBytes(msg2, "second", 200, nil, signing.SignMode_SIGN_MODE_DIRECT)))
+	require.ErrorContains(t, err, "bundled tx 1: missing bundle commitment")
+
+	_, err = decoder(bundleBytes(tx1, txBytes(msg2, "second", 200, commitment, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)))
+	require.ErrorContains(t, err, "bundled tx 1: bundled txs must be signed with SIGN_MODE_DIRECT or SIGN_MODE_DIRECT_AUX")
+
+	_, err = decoder(bundleBytes(tx1, bundleBytes(tx2)))
+	require.ErrorContains(t, err, "bundled tx 1: tx bundles cannot be nested")
+
+	_, err = decoder(bundleBytes(tx1, []byte("invalid")))
+	require.ErrorContains(t, err, "bundled tx 1")
+
+	// a bundle with fields of TxRaw is rejected
+	_, err = decoder(append(bundleBytes(tx1), tx2...))
+	require.Error(t, err)
+}
@@ -13,6 +13,10 @@ import (
 // DefaultTxEncoder returns a default protobuf TxEncoder using the provided Marshaler
 func DefaultTxEncoder() sdk.TxEncoder {
 	return func(tx sdk.Tx) ([]byte, error) {
+		if bundle, ok := tx.(*bundleWrapper); ok {
+			return proto.Marshal(&txtypes.TxBundle{Txs: bundle.txsBytes})
+		}
+
 		txWrapper, ok := tx.(*wrapper)
 		if !ok {
 			return nil, fmt.Errorf("expected %T, got %T", &wrapper{}, tx)
@@ -25,19 +25,31 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithStateDiffFn is the signature of the
+// Baseapp#SimulateWithStateDiff function.
+type baseAppSimulateWithStateDiffFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, *txtypes.StateDiff, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx             client.Context
+	simulate              baseAppSimulateFn
+	simulateWithStateDiff baseAppSimulateWithStateDiffFn
+	interfaceRegistry     codectypes.InterfaceRegistry
 }
 
-// NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+// NewTxServer creates a new Tx service server. simulateWithStateDiff may be
+// nil, in which case simulations requesting the state diff are not supported.
+func NewTxServer(
+	clientCtx client.Context,
+	simulate baseAppSimulateFn,
+	simulateWithStateDiff baseAppSimulateWithStateDiffFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) txtypes.ServiceServer {
 	return txServer{
-		clientCtx:         clientCtx,
-		simulate:          simulate,
-		interfaceRegistry: interfaceRegistry,
+		clientCtx:             clientCtx,
+		simulate:              simulate,
+		simulateWithStateDiff: simulateWithStateDiff,
+		interfaceRegistry:     interfaceRegistry,
 	}
 }
 
@@ -96,14 +108,31 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
-	gasInfo, result, err := s.simulate(txBytes)
+	if !req.StateDiff {
+		gasInfo, result, err := s.simulate(txBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
+		}
+
+		return &txtypes.SimulateResponse{
+			GasInfo: &gasInfo,
+			Result:  result,
+		}, nil
+	}
+
+	if s.simulateWithStateDiff == nil {
+		return nil, status.Error(codes.Unimplemented, "simulation with state diff is not supported")
+	}
+
+	gasInfo, result, stateDiff, err := s.simulateWithStateDiff(txBytes)
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "%v With gas wanted: '%d' and gas used: '%d' ", err, gasInfo.GasWanted, gasInfo.GasUsed)
 	}
 
 	return &txtypes.SimulateResponse{
-		GasInfo: &gasInfo,
-		Result:  result,
+		GasInfo:   &gasInfo,
+		Result:    result,
+		StateDiff: stateDiff,
 	}, nil
 }
 
@@ -310,11 +339,12 @@ func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
+	simulateWithStateDiffFn baseAppSimulateWithStateDiffFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, simulateWithStateDiffFn, interfaceRegistry),
 	)
 }
 
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,3 +13,10 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// AccountsModKeeper defines the contract needed from the x/accounts module to
+// migrate base accounts to x/accounts accounts (noalias)
+type AccountsModKeeper interface {
+	MigrateLegacyAccount(ctx context.Context, addr []byte, accountType string, pubKey *codectypes.Any, sequence uint64, initMsg *codectypes.Any) (*codectypes.Any, error)
+	IsAccountsModuleAccount(ctx context.Context, addr []byte) bool
+}
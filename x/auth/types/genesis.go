@@ -72,7 +72,31 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return validateMigratedAccounts(data.MigratedAccounts, genAccs)
+}
+
+// validateMigratedAccounts validates the addresses of the migrated accounts,
+// which cannot be duplicated nor be the addresses of genesis accounts.
+func validateMigratedAccounts(migratedAccounts []string, genAccs GenesisAccounts) error {
+	addrMap := make(map[string]bool, len(migratedAccounts)+len(genAccs))
+	for _, acc := range genAccs {
+		addrMap[acc.GetAddress().String()] = true
+	}
+
+	for _, addrStr := range migratedAccounts {
+		if _, err := sdk.AccAddressFromBech32(addrStr); err != nil {
+			return fmt.Errorf("invalid migrated account address %s: %w", addrStr, err)
+		}
+		if _, ok := addrMap[addrStr]; ok {
+			return fmt.Errorf("duplicate migrated account found in genesis state; address: %s", addrStr)
+		}
+		addrMap[addrStr] = true
+	}
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
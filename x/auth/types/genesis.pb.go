@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// migrated_accounts are the addresses of the base accounts migrated to
+	// x/accounts accounts, which cannot be used by x/auth accounts anymore.
+	MigratedAccounts []string `protobuf:"bytes,3,rep,name=migrated_accounts,json=migratedAccounts,proto3" json:"migrated_accounts,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +84,13 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetMigratedAccounts() []string {
+	if m != nil {
+		return m.MigratedAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +98,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x92, 0x10, 0xab,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0xfe, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x1b, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x57, 0x9c, 0xf7, 0x5c, 0x01,
-	0x00, 0x00,
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x63, 0x2a, 0x55, 0x34, 0x65, 0xa0, 0xa1, 0x43, 0x5b, 0x24, 0x53, 0x98, 0x2a, 0x24,
+	0x6c, 0xda, 0xee, 0x48, 0x8d, 0x84, 0x58, 0x51, 0xba, 0xb1, 0x54, 0x4e, 0x62, 0x4c, 0x04, 0xb1,
+	0xa3, 0xd8, 0x41, 0xe4, 0x16, 0x1c, 0x83, 0x91, 0x81, 0x3b, 0xd0, 0xb1, 0x62, 0x62, 0x42, 0x28,
+	0x19, 0xb8, 0x06, 0xaa, 0xed, 0x76, 0xea, 0x62, 0x3d, 0x3f, 0x7f, 0xff, 0x7b, 0xff, 0x6f, 0xf7,
+	0x34, 0x12, 0x32, 0x15, 0x12, 0x93, 0x42, 0x3d, 0xe0, 0xe7, 0x71, 0x48, 0x15, 0x19, 0x63, 0x46,
+	0x39, 0x95, 0x89, 0x44, 0x59, 0x2e, 0x94, 0xf0, 0x8e, 0x0c, 0x82, 0xd6, 0x08, 0xb2, 0xc8, 0xa0,
+	0xcf, 0x84, 0x60, 0x4f, 0x14, 0x6b, 0x24, 0x2c, 0xee, 0x31, 0xe1, 0xa5, 0xe1, 0x07, 0x5d, 0x26,
+	0x98, 0xd0, 0x25, 0x5e, 0x57, 0xb6, 0x0b, 0x77, 0x2d, 0xd2, 0x23, 0xcd, 0x7b, 0x87, 0xa4, 0x09,
+	0x17, 0x58, 0x9f, 0xb6, 0xd5, 0x37, 0x92, 0x85, 0x99, 0x65, 0x5d, 0xe8, 0xcb, 0xd9, 0x27, 0x70,
+	0x0f, 0x6e, 0x8c, 0xcb, 0xb9, 0x22, 0x8a, 0x7a, 0x57, 0x6e, 0x33, 0x23, 0x39, 0x49, 0x65, 0x0f,
+	0x0c, 0xc1, 0xa8, 0x3d, 0x39, 0x46, 0x3b, 0x5c, 0xa3, 0x5b, 0x8d, 0xf8, 0xad, 0xe5, 0xcf, 0x89,
+	0xf3, 0xf6, 0xf7, 0x7e, 0x0e, 0x02, 0xab, 0xf2, 0x2e, 0xdd, 0x7d, 0x12, 0x45, 0xa2, 0xe0, 0x4a,
+	0xf6, 0xf6, 0x86, 0x8d, 0x51, 0x7b, 0xd2, 0x45, 0x26, 0x22, 0xda, 0x44, 0x44, 0x33, 0x5e, 0x06,
+	0x5b, 0xca, 0xbb, 0x76, 0x3b, 0x69, 0xc2, 0x72, 0xa2, 0x68, 0xbc, 0xd8, 0x4a, 0x1b, 0xc3, 0xc6,
+	0xa8, 0xe5, 0xf7, 0xbe, 0x3e, 0x2e, 0xba, 0x76, 0xff, 0x2c, 0x8e, 0x73, 0x2a, 0xe5, 0x5c, 0xe5,
+	0x09, 0x67, 0xc1, 0xe1, 0x46, 0x32, 0xb3, 0x0a, 0x7f, 0xba, 0xac, 0x20, 0x58, 0x55, 0x10, 0xfc,
+	0x56, 0x10, 0xbc, 0xd6, 0xd0, 0x59, 0xd5, 0xd0, 0xf9, 0xae, 0xa1, 0x73, 0x67, 0xe3, 0xcb, 0xf8,
+	0x11, 0x25, 0x02, 0xbf, 0x98, 0x9f, 0x53, 0x65, 0x46, 0x65, 0xd8, 0xd4, 0x9e, 0xa6, 0xff, 0x03,
+	0x00, 0xb6, 0x3c, 0xee, 0x8f, 0xbe, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +141,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MigratedAccounts) > 0 {
+		for iNdEx := len(m.MigratedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MigratedAccounts[iNdEx])
+			copy(dAtA[i:], m.MigratedAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MigratedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +202,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MigratedAccounts) > 0 {
+		for _, s := range m.MigratedAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +313,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedAccounts = append(m.MigratedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
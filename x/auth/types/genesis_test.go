@@ -53,6 +53,39 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisMigratedAccounts(t *testing.T) {
+	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	genAccs, err := types.PackAccounts(types.GenesisAccounts{acc1})
+	require.NoError(t, err)
+	migratedAddr := sdk.AccAddress(addr2).String()
+
+	testCases := []struct {
+		name             string
+		migratedAccounts []string
+		expErr           bool
+	}{
+		{"valid", []string{migratedAddr}, false},
+		{"invalid address", []string{"foo"}, true},
+		{"duplicate migrated account", []string{migratedAddr, migratedAddr}, true},
+		{"migrated genesis account", []string{acc1.Address}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(types.GenesisState{
+				Params:           types.DefaultParams(),
+				Accounts:         genAccs,
+				MigratedAccounts: tc.migratedAccounts,
+			})
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
 	cdc := encodingConfig.Codec
@@ -28,4 +28,12 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// UnorderedTxsKeyPrefix is the prefix of the set of the hashes of the
+	// unordered txs, by timeout.
+	UnorderedTxsKeyPrefix = collections.NewPrefix(3)
+
+	// MigratedAccountsKeyPrefix is the prefix of the set of the addresses of
+	// the base accounts migrated to x/accounts.
+	MigratedAccountsKeyPrefix = collections.NewPrefix(4)
 )
@@ -63,7 +63,7 @@ func (s msgServer) CreateVestingAccount(ctx context.Context, msg *types.MsgCreat
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
-	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+	if s.AccountKeeper.HasAccount(ctx, to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
@@ -132,7 +132,7 @@ func (s msgServer) CreatePermanentLockedAccount(ctx context.Context, msg *types.
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
-	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+	if s.AccountKeeper.HasAccount(ctx, to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
@@ -194,7 +194,7 @@ func (s msgServer) CreatePeriodicVestingAccount(ctx context.Context, msg *types.
 		totalCoins = totalCoins.Add(period.Amount...)
 	}
 
-	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+	if s.AccountKeeper.HasAccount(ctx, to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
@@ -28,6 +28,7 @@ var (
 	to1Addr    = sdk.AccAddress([]byte("to1__________________"))
 	to2Addr    = sdk.AccAddress([]byte("to2__________________"))
 	to3Addr    = sdk.AccAddress([]byte("to3__________________"))
+	migrAddr   = sdk.AccAddress([]byte("migrated_____________"))
 	fooCoin    = sdk.NewInt64Coin("foo", 100)
 	periodCoin = sdk.NewInt64Coin("foo", 20)
 )
@@ -136,6 +137,22 @@ func (s *VestingTestSuite) TestCreateVestingAccount() {
 			expErr:    true,
 			expErrMsg: "already exists",
 		},
+		"create for migrated account": {
+			preRun: func() {
+				s.Require().NoError(s.accountKeeper.MigratedAccounts.Set(s.ctx, migrAddr))
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(migrAddr).Return(false)
+			},
+			input: vestingtypes.NewMsgCreateVestingAccount(
+				fromAddr,
+				migrAddr,
+				sdk.Coins{fooCoin},
+				time.Now().Unix(),
+				true,
+			),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
 		"create a valid delayed vesting account": {
 			preRun: func() {
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
@@ -235,6 +252,20 @@ func (s *VestingTestSuite) TestCreatePermanentLockedAccount() {
 			expErr:    true,
 			expErrMsg: "already exists",
 		},
+		"create for migrated account": {
+			preRun: func() {
+				s.Require().NoError(s.accountKeeper.MigratedAccounts.Set(s.ctx, migrAddr))
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(migrAddr).Return(false)
+			},
+			input: vestingtypes.NewMsgCreatePermanentLockedAccount(
+				fromAddr,
+				migrAddr,
+				sdk.Coins{fooCoin},
+			),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
 		"create a valid permanent locked account": {
 			preRun: func() {
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
@@ -376,6 +407,25 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 			expErr:    true,
 			expErrMsg: "already exists",
 		},
+		{
+			name: "create for migrated account",
+			preRun: func() {
+				s.Require().NoError(s.accountKeeper.MigratedAccounts.Set(s.ctx, migrAddr))
+			},
+			input: vestingtypes.NewMsgCreatePeriodicVestingAccount(
+				fromAddr,
+				migrAddr,
+				time.Now().Unix(),
+				[]vestingtypes.Period{
+					{
+						Length: 10,
+						Amount: sdk.NewCoins(periodCoin),
+					},
+				},
+			),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
 		{
 			name: "create a valid periodic vesting account",
 			preRun: func() {
@@ -177,4 +177,5 @@ replace (
 	cosmossdk.io/x/mint => ../mint
 	cosmossdk.io/x/protocolpool => ../protocolpool
 	cosmossdk.io/x/slashing => ../slashing
+	cosmossdk.io/x/tx => ../tx
 )
@@ -0,0 +1,197 @@
+package decode
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	v1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/errors"
+)
+
+const (
+	// bundleTxsFieldNumber is the field number of txs in
+	// cosmos.tx.v1beta1.TxBundle. The field numbers of TxRaw are reserved in
+	// TxBundle, so the first tag of the bytes of a transaction tells whether it
+	// is a TxBundle or a TxRaw.
+	bundleTxsFieldNumber protowire.Number = 4
+
+	// bodyMessagesFieldNumber is the field number of messages in
+	// cosmos.tx.v1beta1.TxBody.
+	bodyMessagesFieldNumber protowire.Number = 1
+
+	// bodyBundleCommitmentFieldNumber is the field number of bundle_commitment
+	// in cosmos.tx.v1beta1.TxBody.
+	bodyBundleCommitmentFieldNumber protowire.Number = 6
+)
+
+// IsBundle returns true if txBytes are the bytes of a TxBundle rather than of a
+// TxRaw.
+func IsBundle(txBytes []byte) bool {
+	tagNum, _, m := protowire.ConsumeTag(txBytes)
+	return m > 0 && tagNum == bundleTxsFieldNumber
+}
+
+// BundleCommitment returns the commitment of a bundle whose txs have the given
+// TxBody bytes, in execution order. It is the SHA-256 hash of the encoded
+// messages of the txs, so that it can be computed before the txs are signed,
+// from bodies which only contain their messages.
+func BundleCommitment(txsBodyBytes [][]byte) ([]byte, error) {
+	commitment := sha256.New()
+	commitment.Write(protowire.AppendVarint(nil, uint64(len(txsBodyBytes))))
+	for _, bodyBytes := range txsBodyBytes {
+		msgs, err := consumeBytesFields(bodyBytes, bodyMessagesFieldNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		bz := protowire.AppendVarint(nil, uint64(len(msgs)))
+		for _, msg := range msgs {
+			bz = protowire.AppendBytes(bz, msg)
+		}
+		commitment.Write(bz)
+	}
+
+	return commitment.Sum(nil), nil
+}
+
+// DecodeBundle decodes the raw protobuf encoded bytes of a TxBundle into the
+// DecodedTx of each of its transactions, in execution order. Bundles cannot be
+// nested, and each transaction must commit to the bundle in its
+// bundle_commitment and be signed with SIGN_MODE_DIRECT or SIGN_MODE_DIRECT_AUX.
+func (d *Decoder) DecodeBundle(txBytes []byte) ([]*DecodedTx, error) {
+	txsBytes, err := unmarshalTxBundle(txBytes)
+	if err != nil {
+		return nil, errors.Wrap(ErrTxDecode, err.Error())
+	}
+
+	if len(txsBytes) == 0 {
+		return nil, errors.Wrap(ErrTxDecode, "tx bundle must contain at least one tx")
+	}
+
+	txsBodyBytes := make([][]byte, len(txsBytes))
+	for i, bz := range txsBytes {
+		if IsBundle(bz) {
+			return nil, errors.Wrapf(ErrTxDecode, "bundled tx %d: tx bundles cannot be nested", i)
+		}
+
+		var raw v1beta1.TxRaw
+		if err := proto.Unmarshal(bz, &raw); err != nil {
+			return nil, errors.Wrapf(ErrTxDecode, "bundled tx %d: %s", i, err)
+		}
+		txsBodyBytes[i] = raw.BodyBytes
+	}
+
+	commitment, err := BundleCommitment(txsBodyBytes)
+	if err != nil {
+		return nil, errors.Wrap(ErrTxDecode, err.Error())
+	}
+
+	txs := make([]*DecodedTx, len(txsBytes))
+	for i, bz := range txsBytes {
+		// the commitment is checked on the raw body, before it is decoded
+		txCommitment, err := consumeBytesFields(txsBodyBytes[i], bodyBundleCommitmentFieldNumber)
+		if err != nil {
+			return nil, errors.Wrapf(ErrTxDecode, "bundled tx %d: %s", i, err)
+		}
+		if len(txCommitment) == 0 {
+			return nil, errors.Wrapf(ErrTxDecode, "bundled tx %d: missing bundle commitment", i)
+		}
+		if !bytes.Equal(txCommitment[len(txCommitment)-1], commitment) {
+			return nil, errors.Wrapf(ErrTxDecode, "bundled tx %d: invalid bundle commitment", i)
+		}
+
+		txs[i], err = d.Decode(bz)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bundled tx %d", i)
+		}
+
+		for _, signerInfo := range txs[i].Tx.AuthInfo.SignerInfos {
+			if !isDirectModeInfo(signerInfo.ModeInfo) {
+				return nil, errors.Wrapf(ErrTxDecode, "bundled tx %d: bundled txs must be signed with SIGN_MODE_DIRECT or SIGN_MODE_DIRECT_AUX", i)
+			}
+		}
+	}
+
+	return txs, nil
+}
+
+// isDirectModeInfo returns true if the signer with the given mode info, and all
+// its signers if it is a multisig, sign with SIGN_MODE_DIRECT or
+// SIGN_MODE_DIRECT_AUX, the only sign modes covering bundle_commitment.
+func isDirectModeInfo(modeInfo *v1beta1.ModeInfo) bool {
+	switch {
+	case modeInfo.GetSingle() != nil:
+		mode := modeInfo.GetSingle().Mode
+		return mode == signingv1beta1.SignMode_SIGN_MODE_DIRECT || mode == signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX
+	case modeInfo.GetMulti() != nil:
+		for _, modeInfo := range modeInfo.GetMulti().ModeInfos {
+			if !isDirectModeInfo(modeInfo) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// unmarshalTxBundle returns the txs of a TxBundle, rejecting any other field.
+func unmarshalTxBundle(txBytes []byte) ([][]byte, error) {
+	var txs [][]byte
+	for len(txBytes) > 0 {
+		tagNum, wireType, m := protowire.ConsumeTag(txBytes)
+		if m < 0 {
+			return nil, fmt.Errorf("invalid length; %w", protowire.ParseError(m))
+		}
+
+		if tagNum != bundleTxsFieldNumber || wireType != protowire.BytesType {
+			return nil, fmt.Errorf("unexpected field %d with wire type %d in tx bundle", tagNum, wireType)
+		}
+		txBytes = txBytes[m:]
+
+		tx, m := protowire.ConsumeBytes(txBytes)
+		if m < 0 {
+			return nil, fmt.Errorf("invalid length; %w", protowire.ParseError(m))
+		}
+		txs = append(txs, tx)
+		txBytes = txBytes[m:]
+	}
+
+	return txs, nil
+}
+
+// consumeBytesFields returns the values of the bytes field with the given
+// number in the encoded message bz, skipping all the other fields.
+func consumeBytesFields(bz []byte, fieldNumber protowire.Number) ([][]byte, error) {
+	var values [][]byte
+	for len(bz) > 0 {
+		tagNum, wireType, m := protowire.ConsumeTag(bz)
+		if m < 0 {
+			return nil, fmt.Errorf("invalid length; %w", protowire.ParseError(m))
+		}
+		bz = bz[m:]
+
+		if tagNum == fieldNumber && wireType == protowire.BytesType {
+			value, m := protowire.ConsumeBytes(bz)
+			if m < 0 {
+				return nil, fmt.Errorf("invalid length; %w", protowire.ParseError(m))
+			}
+			values = append(values, value)
+			bz = bz[m:]
+			continue
+		}
+
+		m = protowire.ConsumeFieldValue(tagNum, wireType, bz)
+		if m < 0 {
+			return nil, fmt.Errorf("invalid length; %w", protowire.ParseError(m))
+		}
+		bz = bz[m:]
+	}
+
+	return values, nil
+}
@@ -0,0 +1,129 @@
+package decode_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+)
+
+func bundleBytes(txsBytes ...[]byte) []byte {
+	var bz []byte
+	for _, txBytes := range txsBytes {
+		bz = protowire.AppendTag(bz, 4, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, txBytes)
+	}
+	return bz
+}
+
+func TestDecodeBundle(t *testing.T) {
+	signingCtx, err := signing.NewContext(signing.Options{
+		AddressCodec:          dummyAddressCodec{},
+		ValidatorAddressCodec: dummyAddressCodec{},
+	})
+	require.NoError(t, err)
+	decoder, err := decode.NewDecoder(decode.Options{
+		SigningContext: signingCtx,
+	})
+	require.NoError(t, err)
+
+	bodyBytes := func(amount, memo string) []byte {
+		anyMsg, err := anyutil.New(&bankv1beta1.MsgSend{Amount: []*basev1beta1.Coin{{Denom: "stake", Amount: amount}}})
+		require.NoError(t, err)
+		bz, err := proto.Marshal(&txv1beta1.TxBody{Messages: []*anypb.Any{anyMsg}, Memo: memo})
+		require.NoError(t, err)
+		return bz
+	}
+	body1, body2 := bodyBytes("1", "first"), bodyBytes("2", "second")
+
+	// the commitment only depends on the messages of the txs, in order
+	commitment, err := decode.BundleCommitment([][]byte{body1, body2})
+	require.NoError(t, err)
+	msgsCommitment, err := decode.BundleCommitment([][]byte{bodyBytes("1", ""), bodyBytes("2", "")})
+	require.NoError(t, err)
+	require.Equal(t, commitment, msgsCommitment)
+	reversedCommitment, err := decode.BundleCommitment([][]byte{body2, body1})
+	require.NoError(t, err)
+	require.NotEqual(t, commitment, reversedCommitment)
+
+	txBytes := func(bodyBytes, commitment []byte) []byte {
+		if commitment != nil {
+			bodyBytes = protowire.AppendTag(bodyBytes, 6, protowire.BytesType)
+			bodyBytes = protowire.AppendBytes(bodyBytes, commitment)
+		}
+		authInfoBytes, err := proto.Marshal(&txv1beta1.AuthInfo{Fee: &txv1beta1.Fee{GasLimit: 100}})
+		require.NoError(t, err)
+		bz, err := proto.Marshal(&txv1beta1.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{{1}}})
+		require.NoError(t, err)
+		return bz
+	}
+	tx1, tx2 := txBytes(body1, nil), txBytes(body2, nil)
+
+	require.True(t, decode.IsBundle(bundleBytes(tx1)))
+	require.False(t, decode.IsBundle(tx1))
+	require.False(t, decode.IsBundle(nil))
+
+	// NOTE: decoding a valid bundle requires a TxBody with bundle_commitment,
+	// which the released cosmossdk.io/api this module is built against lacks.
+	testCases := []struct {
+		name  string
+		bz    []byte
+		error string
+	}{
+		{
+			name:  "missing commitment",
+			bz:    bundleBytes(tx1, tx2),
+			error: "bundled tx 0: missing bundle commitment: tx parse error",
+		},
+		{
+			name:  "commitment of another bundle",
+			bz:    bundleBytes(txBytes(body1, reversedCommitment), txBytes(body2, reversedCommitment)),
+			error: "bundled tx 0: invalid bundle commitment: tx parse error",
+		},
+		{
+			name:  "tx of another bundle",
+			bz:    bundleBytes(txBytes(body1, commitment), txBytes(body1, commitment)),
+			error: "bundled tx 0: invalid bundle commitment: tx parse error",
+		},
+		{
+			name:  "empty bundle",
+			bz:    nil,
+			error: "tx bundle must contain at least one tx: tx parse error",
+		},
+		{
+			name:  "nested bundle",
+			bz:    bundleBytes(tx1, bundleBytes(tx2)),
+			error: "bundled tx 1: tx bundles cannot be nested: tx parse error",
+		},
+		{
+			name:  "invalid bundled tx",
+			bz:    bundleBytes([]byte{0x0a}),
+			error: "bundled tx 0",
+		},
+		{
+			name:  "unknown field",
+			bz:    append(bundleBytes(tx1), tx2...),
+			error: "unexpected field 1 with wire type 2 in tx bundle: tx parse error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decoder.DecodeBundle(tc.bz)
+			require.ErrorContains(t, err, tc.error)
+		})
+	}
+
+	// a bundle is not a valid TxRaw
+	_, err = decoder.Decode(bundleBytes(tx1))
+	require.Error(t, err)
+}
@@ -0,0 +1,189 @@
+// Package eip712 implements the SIGN_MODE_EIP_712 signing mode, with which
+// Ethereum wallets sign txs as EIP-712 typed structured data, displaying them
+// to their users in a human-readable form.
+//
+// The typed data of a tx is derived from its protobuf messages, see the Tx type
+// returned by GetTypedData, and the signatures are verified against
+// eth_secp256k1 keys with VerifySignature.
+package eip712
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+)
+
+const (
+	// SignMode is the SIGN_MODE_EIP_712 sign mode.
+	SignMode = signingv1beta1.SignMode(712)
+
+	// DefaultDomainName is the default name of the EIP-712 domain of txs.
+	DefaultDomainName = "Cosmos Tx"
+
+	// DefaultDomainVersion is the default version of the EIP-712 domain of txs.
+	DefaultDomainVersion = "1.0.0"
+
+	// txType is the name of the primary type of the typed data of txs.
+	txType = "Tx"
+)
+
+// SignModeHandler implements the SIGN_MODE_EIP_712 signing mode.
+type SignModeHandler struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	domain       Domain
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	// FileResolver is the protodesc.Resolver to use for resolving proto files when unpacking any messages.
+	FileResolver signing.ProtoFileResolver
+
+	// TypeResolver is the protoregistry.MessageTypeResolver to use for resolving protobuf types when unpacking any messages.
+	TypeResolver protoregistry.MessageTypeResolver
+
+	// DomainName is the name of the EIP-712 domain of txs, DefaultDomainName
+	// if empty.
+	DomainName string
+
+	// DomainVersion is the version of the EIP-712 domain of txs,
+	// DefaultDomainVersion if empty.
+	DomainVersion string
+
+	// EIP155ChainID is the EIP-155 chain id of the EIP-712 domain of txs. If
+	// set, Ethereum wallets must be connected to the Ethereum network of this
+	// chain id to sign txs.
+	EIP155ChainID *big.Int
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	h := &SignModeHandler{
+		domain: Domain{
+			Name:    options.DomainName,
+			Version: options.DomainVersion,
+			ChainID: options.EIP155ChainID,
+		},
+	}
+	if options.FileResolver == nil {
+		h.fileResolver = protoregistry.GlobalFiles
+	} else {
+		h.fileResolver = options.FileResolver
+	}
+	if options.TypeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	} else {
+		h.typeResolver = options.TypeResolver
+	}
+	if h.domain.Name == "" {
+		h.domain.Name = DefaultDomainName
+	}
+	if h.domain.Version == "" {
+		h.domain.Version = DefaultDomainVersion
+	}
+	return h
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return SignMode
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler
+// interface. The sign bytes are the sign bytes of the typed data of the tx,
+// whose keccak256 hash is signed by Ethereum wallets.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedData.SignBytes()
+}
+
+// GetTypedData returns the EIP-712 typed data of a tx, to be signed by Ethereum
+// wallets with eth_signTypedData_v4. Its primary type is:
+//
+//	Tx(string chain_id,uint64 account_number,uint64 sequence,uint64 timeout_height,string memo,cosmos_tx_v1beta1_Fee fee,Any_<msg1> msg1,...)
+//
+// with a member for each message of the tx, typed after the message.
+func (h SignModeHandler) GetTypedData(_ context.Context, signerData signing.SignerData, txData signing.TxData) (*TypedData, error) {
+	body := txData.Body
+	_, err := decode.RejectUnknownFields(
+		txData.BodyBytes, body.ProtoReflect().Descriptor(), false, h.fileResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	if (len(body.ExtensionOptions) > 0) || (len(body.NonCriticalExtensionOptions) > 0) {
+		return nil, fmt.Errorf("SIGN_MODE_EIP_712 does not support protobuf extension options: invalid request")
+	}
+
+	if signerData.Address == "" {
+		return nil, fmt.Errorf("got empty address in SIGN_MODE_EIP_712 handler: invalid request")
+	}
+
+	fee := txData.AuthInfo.Fee
+	if fee == nil {
+		return nil, fmt.Errorf("fee cannot be nil")
+	}
+
+	enc := &encoder{
+		fileResolver: h.fileResolver,
+		typeResolver: h.typeResolver,
+		types:        Types{domainType: h.domain.Types()},
+	}
+
+	feeType, feeValue, err := enc.encodeMessage(fee.ProtoReflect(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []Type{
+		{Name: "chain_id", Type: "string"},
+		{Name: "account_number", Type: "uint64"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "timeout_height", Type: "uint64"},
+		{Name: "memo", Type: "string"},
+		{Name: "fee", Type: feeType},
+	}
+	message := map[string]any{
+		"chain_id":       signerData.ChainID,
+		"account_number": strconv.FormatUint(signerData.AccountNumber, 10),
+		"sequence":       strconv.FormatUint(signerData.Sequence, 10),
+		"timeout_height": strconv.FormatUint(body.TimeoutHeight, 10),
+		"memo":           body.Memo,
+		"fee":            feeValue,
+	}
+
+	for i, msg := range body.Messages {
+		msgType, msgValue, err := enc.encodeMessage(msg.ProtoReflect(), 0)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message %d: %w", i+1, err)
+		}
+
+		name := fmt.Sprintf("msg%d", i+1)
+		members = append(members, Type{Name: name, Type: msgType})
+		message[name] = msgValue
+	}
+
+	if err := enc.registerType(txType, members); err != nil {
+		return nil, err
+	}
+
+	return &TypedData{
+		Types:       enc.types,
+		PrimaryType: txType,
+		Domain:      h.domain,
+		Message:     message,
+	}, nil
+}
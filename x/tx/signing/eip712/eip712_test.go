@@ -0,0 +1,281 @@
+package eip712_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	govv1beta1 "cosmossdk.io/api/cosmos/gov/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing/eip712"
+	"cosmossdk.io/x/tx/signing/testutil"
+)
+
+var (
+	fee = &txv1beta1.Fee{
+		Amount:   []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}},
+		GasLimit: 200000,
+	}
+	msgSend = &bankv1beta1.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      []*basev1beta1.Coin{{Denom: "uatom", Amount: "100"}},
+	}
+	handlerOptions = testutil.HandlerArgumentOptions{
+		ChainID:       "test-chain",
+		Memo:          "sometestmemo",
+		Msg:           msgSend,
+		AccNum:        1,
+		AccSeq:        2,
+		SignerAddress: "cosmos1from",
+		Fee:           fee,
+	}
+)
+
+func TestGetTypedData(t *testing.T) {
+	signerData, txData, err := testutil.MakeHandlerArguments(handlerOptions)
+	require.NoError(t, err)
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{EIP155ChainID: big.NewInt(9000)})
+	require.Equal(t, eip712.SignMode, handler.Mode())
+
+	typedData, err := handler.GetTypedData(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	require.Equal(t, "Tx", typedData.PrimaryType)
+	require.Equal(t, eip712.Domain{Name: eip712.DefaultDomainName, Version: eip712.DefaultDomainVersion, ChainID: big.NewInt(9000)}, typedData.Domain)
+	require.Equal(t, eip712.Types{
+		"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+		},
+		"Tx": {
+			{Name: "chain_id", Type: "string"},
+			{Name: "account_number", Type: "uint64"},
+			{Name: "sequence", Type: "uint64"},
+			{Name: "timeout_height", Type: "uint64"},
+			{Name: "memo", Type: "string"},
+			{Name: "fee", Type: "cosmos_tx_v1beta1_Fee"},
+			{Name: "msg1", Type: "Any_cosmos_bank_v1beta1_MsgSend"},
+		},
+		"cosmos_tx_v1beta1_Fee": {
+			{Name: "amount", Type: "cosmos_base_v1beta1_Coin[]"},
+			{Name: "gas_limit", Type: "uint64"},
+			{Name: "payer", Type: "string"},
+			{Name: "granter", Type: "string"},
+		},
+		"cosmos_base_v1beta1_Coin": {
+			{Name: "denom", Type: "string"},
+			{Name: "amount", Type: "string"},
+		},
+		"Any_cosmos_bank_v1beta1_MsgSend": {
+			{Name: "type_url", Type: "string"},
+			{Name: "value", Type: "cosmos_bank_v1beta1_MsgSend"},
+		},
+		"cosmos_bank_v1beta1_MsgSend": {
+			{Name: "from_address", Type: "string"},
+			{Name: "to_address", Type: "string"},
+			{Name: "amount", Type: "cosmos_base_v1beta1_Coin[]"},
+		},
+	}, typedData.Types)
+	require.Equal(t, map[string]any{
+		"chain_id":       "test-chain",
+		"account_number": "1",
+		"sequence":       "2",
+		"timeout_height": "0",
+		"memo":           "sometestmemo",
+		"fee": map[string]any{
+			"amount":    []any{map[string]any{"denom": "uatom", "amount": "1000"}},
+			"gas_limit": "200000",
+			"payer":     "",
+			"granter":   "",
+		},
+		"msg1": map[string]any{
+			"type_url": "/cosmos.bank.v1beta1.MsgSend",
+			"value": map[string]any{
+				"from_address": "cosmos1from",
+				"to_address":   "cosmos1to",
+				"amount":       []any{map[string]any{"denom": "uatom", "amount": "100"}},
+			},
+		},
+	}, typedData.Message)
+
+	// the sign bytes are the sign bytes of the typed data, as computed by
+	// wallets from its JSON encoding
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	var walletTypedData eip712.TypedData
+	require.NoError(t, json.Unmarshal(bz, &walletTypedData))
+	walletSignBytes, err := walletTypedData.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, signBytes, walletSignBytes)
+}
+
+func TestGetTypedDataMessages(t *testing.T) {
+	anyMsgSend, err := anyutil.New(msgSend)
+	require.NoError(t, err)
+	anyDeposit, err := anyutil.New(&govv1beta1.MsgDeposit{ProposalId: 1, Depositor: "cosmos1from"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		msgs  []*anypb.Any
+		error string
+	}{
+		{
+			name: "messages of different types",
+			msgs: []*anypb.Any{anyMsgSend, anyDeposit},
+		},
+		{
+			name: "nested messages of the same type",
+			msgs: []*anypb.Any{anyMsgSend, newMsgExec(t, anyMsgSend, anyMsgSend)},
+		},
+		{
+			name:  "nested messages of different types",
+			msgs:  []*anypb.Any{newMsgExec(t, anyMsgSend, anyDeposit)},
+			error: "repeated field cosmos.authz.v1beta1.MsgExec.msgs has items of different types",
+		},
+		{
+			name:  "conflicting nested messages",
+			msgs:  []*anypb.Any{newMsgExec(t, anyMsgSend), newMsgExec(t, anyDeposit)},
+			error: "conflicting definitions of type cosmos_authz_v1beta1_MsgExec",
+		},
+	}
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signerData, txData, err := testutil.MakeHandlerArguments(handlerOptions)
+			require.NoError(t, err)
+			txData.Body.Messages = tc.msgs
+			txData.BodyBytes, err = proto.Marshal(txData.Body)
+			require.NoError(t, err)
+
+			typedData, err := handler.GetTypedData(context.Background(), signerData, txData)
+			if tc.error != "" {
+				require.ErrorContains(t, err, tc.error)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = typedData.SignBytes()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func newMsgExec(t *testing.T, msgs ...*anypb.Any) *anypb.Any {
+	t.Helper()
+	anyMsg, err := anyutil.New(&authzv1beta1.MsgExec{Grantee: "cosmos1grantee", Msgs: msgs})
+	require.NoError(t, err)
+	return anyMsg
+}
+
+func TestSignModeHandler(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions
+		error    string
+	}{
+		{
+			name: "happy path",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				return opts
+			},
+		},
+		{
+			name: "empty signer",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.SignerAddress = ""
+				return opts
+			},
+			error: "got empty address in SIGN_MODE_EIP_712 handler: invalid request",
+		},
+		{
+			name: "nil fee",
+			malleate: func(opts testutil.HandlerArgumentOptions) testutil.HandlerArgumentOptions {
+				opts.Fee = nil
+				return opts
+			},
+			error: "fee cannot be nil",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := tc.malleate(handlerOptions)
+			signerData, txData, err := testutil.MakeHandlerArguments(opts)
+			require.NoError(t, err)
+
+			handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+			_, err = handler.GetSignBytes(context.Background(), signerData, txData)
+			if tc.error != "" {
+				require.ErrorContains(t, err, tc.error)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// signEthereum signs sign bytes as Ethereum wallets do, returning R ‖ S ‖ V.
+func signEthereum(privKey *secp256k1.PrivateKey, signBytes []byte) []byte {
+	compactSig := ecdsa.SignCompact(privKey, keccak256(signBytes), false)
+	return append(compactSig[1:], compactSig[0])
+}
+
+func TestVerifySignature(t *testing.T) {
+	signerData, txData, err := testutil.MakeHandlerArguments(handlerOptions)
+	require.NoError(t, err)
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{})
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+	sig := signEthereum(privKey, signBytes)
+	require.True(t, eip712.VerifySignature(pubKey, signBytes, sig))
+
+	// the sign bytes of another sequence
+	signerData.Sequence++
+	otherSignBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.False(t, eip712.VerifySignature(pubKey, otherSignBytes, sig))
+
+	// another key
+	otherPrivKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	require.False(t, eip712.VerifySignature(otherPrivKey.PubKey().SerializeCompressed(), signBytes, sig))
+
+	// a malleated signature with the opposite S
+	var s secp256k1.ModNScalar
+	s.SetByteSlice(sig[32:64])
+	s.Negate()
+	highS := s.Bytes()
+	malleatedSig := append(append(append([]byte{}, sig[:32]...), highS[:]...), sig[64]^1)
+	require.False(t, eip712.VerifySignature(pubKey, signBytes, malleatedSig))
+
+	// an invalid recovery id
+	invalidSig := append(append([]byte{}, sig[:64]...), sig[64]-27)
+	require.False(t, eip712.VerifySignature(pubKey, signBytes, invalidSig))
+
+	// an invalid length
+	require.False(t, eip712.VerifySignature(pubKey, signBytes, sig[:64]))
+}
@@ -0,0 +1,350 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cosmossdk.io/x/tx/signing"
+)
+
+const (
+	// maxDepth is the maximum depth of nested messages, which bounds the zero
+	// values of recursive message types.
+	maxDepth = 32
+
+	// anyTypePrefix prefixes the struct types of google.protobuf.Any values
+	// packing a message.
+	anyTypePrefix = "Any_"
+)
+
+var (
+	anyFullName       = (&anypb.Any{}).ProtoReflect().Descriptor().FullName()
+	timestampFullName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+	durationFullName  = (&durationpb.Duration{}).ProtoReflect().Descriptor().FullName()
+)
+
+// encoder maps protobuf messages to EIP-712 struct types and values.
+//
+// The struct type of a message is named after its full name, with dots replaced
+// by underscores, and has a member for each field in field number order, named
+// after the field. All fields are part of the struct, with their zero value if
+// unset. Scalars are mapped to the EIP-712 atomic types of the same size, and
+// enums to their value names. Repeated fields are mapped to arrays, and map
+// fields to arrays of their entries sorted by key.
+//
+// A google.protobuf.Any packing a message is mapped to a struct with its type
+// URL and the packed message, named after the packed message, so that the
+// message can be displayed to the signer. Struct types are shared by all the
+// messages of a tx, so all the google.protobuf.Any values of a same field must
+// pack messages of the same type.
+type encoder struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	types        Types
+}
+
+// encodeMessage registers the struct type of a message and returns its name
+// and value.
+func (e *encoder) encodeMessage(msg protoreflect.Message, depth int) (string, map[string]any, error) {
+	if depth > maxDepth {
+		return "", nil, fmt.Errorf("max depth of nested messages exceeded at %s", msg.Descriptor().FullName())
+	}
+
+	desc := msg.Descriptor()
+	if desc.FullName() == anyFullName {
+		return e.encodeAny(msg, depth)
+	}
+
+	fields := desc.Fields()
+	sorted := make([]protoreflect.FieldDescriptor, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		sorted[i] = fields.Get(i)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number() < sorted[j].Number() })
+
+	members := make([]Type, 0, len(sorted))
+	value := make(map[string]any, len(sorted))
+	for _, fd := range sorted {
+		typeName, fieldValue, err := e.encodeField(msg, fd, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		members = append(members, Type{Name: string(fd.Name()), Type: typeName})
+		value[string(fd.Name())] = fieldValue
+	}
+
+	typeName := structTypeName(desc.FullName())
+	if err := e.registerType(typeName, members); err != nil {
+		return "", nil, err
+	}
+
+	return typeName, value, nil
+}
+
+// encodeAny maps a google.protobuf.Any to a struct with its type URL and the
+// packed message. An empty google.protobuf.Any is mapped to a struct with its
+// type URL and raw value.
+func (e *encoder) encodeAny(msg protoreflect.Message, depth int) (string, map[string]any, error) {
+	fields := msg.Descriptor().Fields()
+	anyMsg := &anypb.Any{
+		TypeUrl: msg.Get(fields.ByName("type_url")).String(),
+		Value:   msg.Get(fields.ByName("value")).Bytes(),
+	}
+
+	if anyMsg.TypeUrl == "" {
+		typeName := structTypeName(anyFullName)
+		members := []Type{{Name: "type_url", Type: "string"}, {Name: "value", Type: "bytes"}}
+		if err := e.registerType(typeName, members); err != nil {
+			return "", nil, err
+		}
+		return typeName, map[string]any{"type_url": "", "value": encodeBytes(anyMsg.Value)}, nil
+	}
+
+	packed, err := anyutil.Unpack(anyMsg, e.fileResolver, e.typeResolver)
+	if err != nil {
+		return "", nil, err
+	}
+
+	valueType, value, err := e.encodeMessage(packed.ProtoReflect(), depth+1)
+	if err != nil {
+		return "", nil, err
+	}
+
+	typeName := anyTypePrefix + valueType
+	members := []Type{{Name: "type_url", Type: "string"}, {Name: "value", Type: valueType}}
+	if err := e.registerType(typeName, members); err != nil {
+		return "", nil, err
+	}
+
+	return typeName, map[string]any{"type_url": anyMsg.TypeUrl, "value": value}, nil
+}
+
+// encodeField returns the EIP-712 type and value of a field of a message.
+func (e *encoder) encodeField(msg protoreflect.Message, fd protoreflect.FieldDescriptor, depth int) (string, any, error) {
+	switch {
+	case fd.IsMap():
+		return e.encodeMap(msg.Get(fd).Map(), fd, depth)
+
+	case fd.IsList():
+		list := msg.Get(fd).List()
+		if list.Len() == 0 {
+			// the element type of an empty list is the type of a zero value
+			elemType, _, err := e.encodeSingular(fd, list.NewElement(), false, depth)
+			if err != nil {
+				return "", nil, err
+			}
+			return elemType + "[]", []any{}, nil
+		}
+
+		var elemType string
+		items := make([]any, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			itemType, item, err := e.encodeSingular(fd, list.Get(i), true, depth)
+			if err != nil {
+				return "", nil, err
+			}
+			if i > 0 && itemType != elemType {
+				return "", nil, fmt.Errorf("repeated field %s has items of different types %s and %s", fd.FullName(), elemType, itemType)
+			}
+			elemType = itemType
+			items[i] = item
+		}
+		return elemType + "[]", items, nil
+
+	default:
+		return e.encodeSingular(fd, msg.Get(fd), msg.Has(fd), depth)
+	}
+}
+
+// encodeMap maps a map field to an array of its entries, sorted by key.
+func (e *encoder) encodeMap(m protoreflect.Map, fd protoreflect.FieldDescriptor, depth int) (string, any, error) {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool { return lessMapKey(keys[i], keys[j]) })
+
+	entryDesc := fd.Message()
+	keyFd, valueFd := fd.MapKey(), fd.MapValue()
+	keyType, err := scalarTypeName(keyFd)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var valueType string
+	if len(keys) == 0 {
+		// the value type of an empty map is the type of a zero value
+		valueType, _, err = e.encodeSingular(valueFd, m.NewValue(), false, depth)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	entries := make([]any, len(keys))
+	for i, key := range keys {
+		_, keyValue, err := e.encodeSingular(keyFd, key.Value(), true, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		entryValueType, entryValue, err := e.encodeSingular(valueFd, m.Get(key), true, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		if i > 0 && entryValueType != valueType {
+			return "", nil, fmt.Errorf("map field %s has values of different types %s and %s", fd.FullName(), valueType, entryValueType)
+		}
+		valueType = entryValueType
+		entries[i] = map[string]any{"key": keyValue, "value": entryValue}
+	}
+
+	typeName := structTypeName(entryDesc.FullName())
+	members := []Type{{Name: "key", Type: keyType}, {Name: "value", Type: valueType}}
+	if err := e.registerType(typeName, members); err != nil {
+		return "", nil, err
+	}
+
+	return typeName + "[]", entries, nil
+}
+
+// encodeSingular returns the EIP-712 type and value of a singular value of a
+// field.
+func (e *encoder) encodeSingular(fd protoreflect.FieldDescriptor, v protoreflect.Value, isSet bool, depth int) (string, any, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch fd.Message().FullName() {
+		case timestampFullName:
+			if !isSet {
+				return "string", "", nil
+			}
+			seconds, nanos := timestampFields(v.Message())
+			return "string", time.Unix(seconds, nanos).UTC().Format(time.RFC3339Nano), nil
+		case durationFullName:
+			if !isSet {
+				return "string", "", nil
+			}
+			seconds, nanos := timestampFields(v.Message())
+			return "string", (time.Duration(seconds)*time.Second + time.Duration(nanos)).String(), nil
+		default:
+			return e.encodeMessage(v.Message(), depth+1)
+		}
+
+	case protoreflect.EnumKind:
+		enumValue := fd.Enum().Values().ByNumber(v.Enum())
+		if enumValue == nil {
+			return "string", strconv.FormatInt(int64(v.Enum()), 10), nil
+		}
+		return "string", string(enumValue.Name()), nil
+
+	case protoreflect.BoolKind:
+		return "bool", v.Bool(), nil
+
+	case protoreflect.StringKind:
+		return "string", v.String(), nil
+
+	case protoreflect.BytesKind:
+		return "bytes", encodeBytes(v.Bytes()), nil
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32", strconv.FormatInt(v.Int(), 10), nil
+
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64", strconv.FormatInt(v.Int(), 10), nil
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", strconv.FormatUint(v.Uint(), 10), nil
+
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", strconv.FormatUint(v.Uint(), 10), nil
+
+	case protoreflect.FloatKind:
+		return "string", strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+
+	case protoreflect.DoubleKind:
+		return "string", strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+
+	default:
+		return "", nil, fmt.Errorf("unsupported field kind %s of %s", fd.Kind(), fd.FullName())
+	}
+}
+
+// registerType registers a struct type, checking that a struct type of the
+// same name has the same members.
+func (e *encoder) registerType(typeName string, members []Type) error {
+	existing, ok := e.types[typeName]
+	if !ok {
+		e.types[typeName] = members
+		return nil
+	}
+
+	if len(existing) != len(members) {
+		return fmt.Errorf("conflicting definitions of type %s", typeName)
+	}
+	for i := range existing {
+		if existing[i] != members[i] {
+			return fmt.Errorf("conflicting definitions of type %s", typeName)
+		}
+	}
+	return nil
+}
+
+// scalarTypeName returns the EIP-712 type of a non-message field.
+func scalarTypeName(fd protoreflect.FieldDescriptor) (string, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return "", fmt.Errorf("field %s is not a scalar", fd.FullName())
+	case protoreflect.BoolKind:
+		return "bool", nil
+	case protoreflect.BytesKind:
+		return "bytes", nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32", nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64", nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", nil
+	default:
+		return "string", nil
+	}
+}
+
+// structTypeName returns the name of the struct type of a message, which must
+// be an identifier for Ethereum wallets.
+func structTypeName(fullName protoreflect.FullName) string {
+	return strings.ReplaceAll(string(fullName), ".", "_")
+}
+
+func timestampFields(msg protoreflect.Message) (int64, int64) {
+	fields := msg.Descriptor().Fields()
+	return msg.Get(fields.ByName("seconds")).Int(), msg.Get(fields.ByName("nanos")).Int()
+}
+
+func encodeBytes(bz []byte) string {
+	return "0x" + hex.EncodeToString(bz)
+}
+
+func lessMapKey(a, b protoreflect.MapKey) bool {
+	switch a.Interface().(type) {
+	case string:
+		return a.String() < b.String()
+	case bool:
+		return !a.Bool() && b.Bool()
+	case int32, int64:
+		return a.Int() < b.Int()
+	default:
+		return a.Uint() < b.Uint()
+	}
+}
@@ -0,0 +1,394 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// domainType is the name of the type of the EIP-712 domain.
+const domainType = "EIP712Domain"
+
+// TypedData is the typed structured data of EIP-712, in the JSON format of the
+// eth_signTypedData_v4 request of Ethereum wallets.
+type TypedData struct {
+	// Types are the struct types of the typed data, by name. They include the
+	// type of the domain, EIP712Domain.
+	Types Types `json:"types"`
+	// PrimaryType is the name of the type of the message.
+	PrimaryType string `json:"primaryType"`
+	// Domain is the domain of the typed data.
+	Domain Domain `json:"domain"`
+	// Message is the message of the typed data. Integers are encoded as
+	// decimal strings, and bytes as 0x-prefixed hexadecimal strings.
+	Message map[string]any `json:"message"`
+}
+
+// Types are the struct types of typed data, by name.
+type Types map[string][]Type
+
+// Type is a member of a struct type: its name, and its type, either an atomic
+// type, a struct type or an array of them.
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Domain is the domain separator of typed data, which prevents the signature of
+// a message for a domain from being valid for another one. Only its non-empty
+// fields are part of the domain.
+type Domain struct {
+	// Name is the user readable name of the signing domain.
+	Name string `json:"name,omitempty"`
+	// Version is the current major version of the signing domain.
+	Version string `json:"version,omitempty"`
+	// ChainID is the EIP-155 chain id of the Ethereum network the wallet is
+	// connected to.
+	ChainID *big.Int `json:"chainId,omitempty"`
+	// VerifyingContract is the address of the contract verifying the signature.
+	VerifyingContract string `json:"verifyingContract,omitempty"`
+	// Salt is a disambiguating salt of the domain.
+	Salt string `json:"salt,omitempty"`
+}
+
+// Types returns the members of the EIP712Domain type of the domain.
+func (d Domain) Types() []Type {
+	var types []Type
+	if d.Name != "" {
+		types = append(types, Type{Name: "name", Type: "string"})
+	}
+	if d.Version != "" {
+		types = append(types, Type{Name: "version", Type: "string"})
+	}
+	if d.ChainID != nil {
+		types = append(types, Type{Name: "chainId", Type: "uint256"})
+	}
+	if d.VerifyingContract != "" {
+		types = append(types, Type{Name: "verifyingContract", Type: "address"})
+	}
+	if d.Salt != "" {
+		types = append(types, Type{Name: "salt", Type: "bytes32"})
+	}
+	return types
+}
+
+// Map returns the domain as the value of a struct.
+func (d Domain) Map() map[string]any {
+	m := map[string]any{}
+	if d.Name != "" {
+		m["name"] = d.Name
+	}
+	if d.Version != "" {
+		m["version"] = d.Version
+	}
+	if d.ChainID != nil {
+		m["chainId"] = d.ChainID
+	}
+	if d.VerifyingContract != "" {
+		m["verifyingContract"] = d.VerifyingContract
+	}
+	if d.Salt != "" {
+		m["salt"] = d.Salt
+	}
+	return m
+}
+
+// SignBytes returns the bytes signed by Ethereum wallets for the typed data,
+// "\x19\x01" ‖ domainSeparator ‖ hashStruct(message). Wallets sign their
+// keccak256 hash.
+func (td *TypedData) SignBytes() ([]byte, error) {
+	domainSeparator, err := td.HashStruct(domainType, td.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("invalid domain: %w", err)
+	}
+
+	msgHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := make([]byte, 0, 2+len(domainSeparator)+len(msgHash))
+	signBytes = append(signBytes, 0x19, 0x01)
+	signBytes = append(signBytes, domainSeparator...)
+	return append(signBytes, msgHash...), nil
+}
+
+// HashStruct returns the hashStruct of a value of a struct type.
+func (td *TypedData) HashStruct(typeName string, data map[string]any) ([]byte, error) {
+	encoded, err := td.encodeData(typeName, data)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(encoded), nil
+}
+
+// TypeHash returns the typeHash of a struct type.
+func (td *TypedData) TypeHash(typeName string) []byte {
+	return keccak256([]byte(td.EncodeType(typeName)))
+}
+
+// EncodeType returns the encoding of a struct type, followed by the encodings
+// of the struct types it references sorted by name.
+func (td *TypedData) EncodeType(typeName string) string {
+	deps := map[string]bool{}
+	td.dependencies(typeName, deps)
+	delete(deps, typeName)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf strings.Builder
+	for _, name := range append([]string{typeName}, names...) {
+		buf.WriteString(name)
+		buf.WriteString("(")
+		for i, member := range td.Types[name] {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(member.Type)
+			buf.WriteString(" ")
+			buf.WriteString(member.Name)
+		}
+		buf.WriteString(")")
+	}
+	return buf.String()
+}
+
+// dependencies collects the struct types referenced by a type, including
+// itself.
+func (td *TypedData) dependencies(typeName string, found map[string]bool) {
+	if i := strings.Index(typeName, "["); i >= 0 {
+		typeName = typeName[:i]
+	}
+
+	members, ok := td.Types[typeName]
+	if !ok || found[typeName] {
+		return
+	}
+
+	found[typeName] = true
+	for _, member := range members {
+		td.dependencies(member.Type, found)
+	}
+}
+
+// encodeData returns typeHash ‖ encodeData(member) for each member of the
+// struct type.
+func (td *TypedData) encodeData(typeName string, data map[string]any) ([]byte, error) {
+	members, ok := td.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s", typeName)
+	}
+
+	buf := bytes.NewBuffer(td.TypeHash(typeName))
+	for _, member := range members {
+		value, ok := data[member.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value of %s in %s", member.Name, typeName)
+		}
+
+		encoded, err := td.encodeValue(member.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s in %s: %w", member.Name, typeName, err)
+		}
+		buf.Write(encoded)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeValue returns the 32 bytes encoding of a value of the given type.
+func (td *TypedData) encodeValue(typeName string, value any) ([]byte, error) {
+	if strings.HasSuffix(typeName, "]") {
+		i := strings.LastIndex(typeName, "[")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid array type %s", typeName)
+		}
+
+		items, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected array, got %T", value)
+		}
+		if size := typeName[i+1 : len(typeName)-1]; size != "" && size != strconv.Itoa(len(items)) {
+			return nil, fmt.Errorf("expected %s items, got %d", size, len(items))
+		}
+
+		var buf bytes.Buffer
+		for _, item := range items {
+			encoded, err := td.encodeValue(typeName[:i], item)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoded)
+		}
+		return keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typeName]; ok {
+		data, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected struct, got %T", value)
+		}
+		return td.HashStruct(typeName, data)
+	}
+
+	switch {
+	case typeName == "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case typeName == "bytes":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(bz), nil
+
+	case typeName == "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+		encoded := make([]byte, 32)
+		if b {
+			encoded[31] = 1
+		}
+		return encoded, nil
+
+	case typeName == "address":
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != 20 {
+			return nil, fmt.Errorf("expected 20 bytes address, got %d bytes", len(bz))
+		}
+		return append(make([]byte, 12), bz...), nil
+
+	case strings.HasPrefix(typeName, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(typeName, "bytes"))
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("invalid type %s", typeName)
+		}
+		bz, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != size {
+			return nil, fmt.Errorf("expected %d bytes, got %d bytes", size, len(bz))
+		}
+		return append(bz, make([]byte, 32-size)...), nil
+
+	case strings.HasPrefix(typeName, "uint"), strings.HasPrefix(typeName, "int"):
+		signed := strings.HasPrefix(typeName, "int")
+		bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(typeName, "u"), "int"))
+		if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+			return nil, fmt.Errorf("invalid type %s", typeName)
+		}
+		i, err := parseInteger(value)
+		if err != nil {
+			return nil, err
+		}
+		return encodeInteger(i, bits, signed)
+
+	default:
+		return nil, fmt.Errorf("unknown type %s", typeName)
+	}
+}
+
+// encodeInteger returns the 32 bytes two's complement encoding of an integer,
+// after checking that it fits in an integer of the given size.
+func encodeInteger(i *big.Int, bits int, signed bool) ([]byte, error) {
+	lower, upper := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		upper.Rsh(upper, 1)
+		lower.Neg(upper)
+	}
+	if i.Cmp(lower) < 0 || i.Cmp(upper) >= 0 {
+		return nil, fmt.Errorf("integer %s overflows %d bits", i, bits)
+	}
+
+	if i.Sign() < 0 {
+		i = new(big.Int).Add(i, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return i.FillBytes(make([]byte, 32)), nil
+}
+
+// parseInteger parses an integer from a decimal or 0x-prefixed hexadecimal
+// string, a JSON number, or a Go integer.
+func parseInteger(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case string:
+		return parseIntegerString(v)
+	case json.Number:
+		return parseIntegerString(v.String())
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		i, _ := big.NewFloat(v).Int(nil)
+		return i, nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", value)
+	}
+}
+
+func parseIntegerString(s string) (*big.Int, error) {
+	var (
+		i  *big.Int
+		ok bool
+	)
+	if hexStr, found := strings.CutPrefix(s, "0x"); found {
+		i, ok = new(big.Int).SetString(hexStr, 16)
+	} else {
+		i, ok = new(big.Int).SetString(s, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return i, nil
+}
+
+// parseBytes parses bytes from a 0x-prefixed hexadecimal string.
+func parseBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		hexStr, found := strings.CutPrefix(v, "0x")
+		if !found {
+			return nil, fmt.Errorf("expected 0x-prefixed hexadecimal string, got %q", v)
+		}
+		return hex.DecodeString(hexStr)
+	default:
+		return nil, fmt.Errorf("expected bytes, got %T", value)
+	}
+}
+
+func keccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
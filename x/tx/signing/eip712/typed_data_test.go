@@ -0,0 +1,130 @@
+package eip712_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"cosmossdk.io/x/tx/signing/eip712"
+)
+
+// mailTypedData is the example of the EIP-712 specification.
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func keccak256(bz []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+	return hasher.Sum(nil)
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestTypedDataSpecExample(t *testing.T) {
+	var typedData eip712.TypedData
+	require.NoError(t, json.Unmarshal([]byte(mailTypedData), &typedData))
+
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", typedData.EncodeType("Mail"))
+	require.Equal(t, decodeHex(t, "a0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2"), typedData.TypeHash("Mail"))
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	require.NoError(t, err)
+	require.Equal(t, decodeHex(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"), domainSeparator)
+
+	msgHash, err := typedData.HashStruct("Mail", typedData.Message)
+	require.NoError(t, err)
+	require.Equal(t, decodeHex(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e"), msgHash)
+
+	signBytes, err := typedData.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, decodeHex(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), keccak256(signBytes))
+
+	// the signature of the example, by the private key keccak256("cow")
+	privKey := secp256k1.PrivKeyFromBytes(keccak256([]byte("cow")))
+	sig := decodeHex(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d"+
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562"+"1c")
+	require.True(t, eip712.VerifySignature(privKey.PubKey().SerializeCompressed(), signBytes, sig))
+	require.True(t, eip712.VerifySignature(privKey.PubKey().SerializeUncompressed(), signBytes, sig))
+}
+
+func TestTypedDataIntegers(t *testing.T) {
+	typedData := eip712.TypedData{
+		Types: eip712.Types{
+			"Integers": {{Name: "value", Type: "int8"}},
+			"Unsigned": {{Name: "value", Type: "uint8"}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		typeName string
+		value    any
+		expErr   bool
+	}{
+		{"int8 min", "Integers", "-128", false},
+		{"int8 max", "Integers", "127", false},
+		{"int8 underflow", "Integers", "-129", true},
+		{"int8 overflow", "Integers", "128", true},
+		{"uint8 max", "Unsigned", "0xff", false},
+		{"uint8 overflow", "Unsigned", big.NewInt(256), true},
+		{"uint8 negative", "Unsigned", -1, true},
+		{"not an integer", "Unsigned", 1.5, true},
+		{"not a number", "Unsigned", "one", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := typedData.HashStruct(tc.typeName, map[string]any{"value": tc.value})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// negative integers are encoded in two's complement
+	minusOne, err := typedData.HashStruct("Integers", map[string]any{"value": "-1"})
+	require.NoError(t, err)
+	encoded := append(typedData.TypeHash("Integers"), decodeHex(t, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")...)
+	require.Equal(t, keccak256(encoded), minusOne)
+}
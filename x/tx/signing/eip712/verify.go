@@ -0,0 +1,51 @@
+package eip712
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// SignatureLength is the length of the signatures of Ethereum wallets, R ‖ S ‖ V.
+const SignatureLength = 65
+
+// VerifySignature verifies the signature of sign bytes by an eth_secp256k1 key,
+// as produced by Ethereum wallets: the secp256k1 signature of the keccak256
+// hash of the sign bytes, encoded as R ‖ S ‖ V with V the recovery id plus 27.
+//
+// The public key is a compressed or uncompressed secp256k1 public key. To make
+// signatures non-malleable, S must be in the lower half of the curve order.
+func VerifySignature(pubKey, signBytes, sig []byte) bool {
+	if len(sig) != SignatureLength {
+		return false
+	}
+
+	key, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sig[:32]); overflow || r.IsZero() {
+		return false
+	}
+	if overflow := s.SetByteSlice(sig[32:64]); overflow || s.IsZero() || s.IsOverHalfOrder() {
+		return false
+	}
+
+	v := sig[64]
+	if v != 27 && v != 28 {
+		return false
+	}
+
+	// the compact signature format of the recovery is V ‖ R ‖ S, with V the
+	// recovery id plus 27 for uncompressed keys
+	compactSig := make([]byte, 0, SignatureLength)
+	compactSig = append(compactSig, v)
+	compactSig = append(compactSig, sig[:64]...)
+	recovered, _, err := ecdsa.RecoverCompact(compactSig, keccak256(signBytes))
+	if err != nil {
+		return false
+	}
+
+	return recovered.IsEqual(key)
+}
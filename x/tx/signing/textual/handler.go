@@ -30,6 +30,11 @@ type CoinMetadataQueryFn func(ctx context.Context, denom string) (*bankv1beta1.M
 // ValueRendererCreator is a function returning a textual.
 type ValueRendererCreator func(protoreflect.FieldDescriptor) ValueRenderer
 
+// MessageValueRendererCreator is a function returning the textual value
+// renderer of a message. It is given the SignModeHandler, e.g. to render the
+// fields of the message with their own value renderers.
+type MessageValueRendererCreator func(*SignModeHandler) ValueRenderer
+
 // SignModeOptions are options to be passed to Textual's sign mode handler.
 type SignModeOptions struct {
 	// coinMetadataQuerier defines a function to query the coin metadata from
@@ -45,6 +50,16 @@ type SignModeOptions struct {
 	// TypeResolver are the protobuf type resolvers to use for resolving message
 	// types. If it is nil, then a dynamicpb will be used on top of FileResolver.
 	TypeResolver protoregistry.MessageTypeResolver
+
+	// CustomScalarRenderers are the value renderers of the Cosmos scalars of
+	// modules, keyed by the name of the scalar in the cosmos_proto.scalar field
+	// option. The string fields of the other scalars are rendered as strings.
+	CustomScalarRenderers map[string]ValueRendererCreator
+
+	// CustomMessageRenderers are the value renderers of the messages of
+	// modules, keyed by the full name of the message. The other messages are
+	// rendered field by field.
+	CustomMessageRenderers map[protoreflect.FullName]MessageValueRendererCreator
 }
 
 // SignModeHandler holds the configuration for dispatching
@@ -83,6 +98,21 @@ func NewSignModeHandler(o SignModeOptions) (*SignModeHandler, error) {
 	}
 	t.init()
 
+	// The built-in value renderers are part of the spec, so they cannot be
+	// overridden by the custom ones.
+	for scalar, vr := range o.CustomScalarRenderers {
+		if _, found := t.scalars[scalar]; found {
+			return nil, fmt.Errorf("value renderer of scalar %s is already defined", scalar)
+		}
+		t.DefineScalar(scalar, vr)
+	}
+	for name, vr := range o.CustomMessageRenderers {
+		if _, found := t.messages[name]; found {
+			return nil, fmt.Errorf("value renderer of message %s is already defined", name)
+		}
+		t.DefineMessageRenderer(name, vr(t))
+	}
+
 	return t, nil
 }
 
@@ -59,3 +59,52 @@ func fieldDescriptorFromName(name string) protoreflect.FieldDescriptor {
 
 	return fd
 }
+
+type customValueRenderer struct {
+	textual.ValueRenderer
+}
+
+func TestCustomValueRenderers(t *testing.T) {
+	validatorSigner := (&testpb.ValidatorSigner{}).ProtoReflect().Descriptor()
+	foo := (&testpb.Foo{}).ProtoReflect().Descriptor()
+
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: EmptyCoinMetadataQuerier,
+		CustomScalarRenderers: map[string]textual.ValueRendererCreator{
+			"cosmos.ValidatorAddressString": func(protoreflect.FieldDescriptor) textual.ValueRenderer {
+				return customValueRenderer{textual.NewStringValueRenderer()}
+			},
+		},
+		CustomMessageRenderers: map[protoreflect.FullName]textual.MessageValueRendererCreator{
+			foo.FullName(): func(tr *textual.SignModeHandler) textual.ValueRenderer {
+				return customValueRenderer{textual.NewMessageValueRenderer(tr, foo)}
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	rend, err := tr.GetFieldValueRenderer(validatorSigner.Fields().ByName("signer"))
+	require.NoError(t, err)
+	require.IsType(t, customValueRenderer{}, rend)
+
+	rend, err = tr.GetMessageValueRenderer(foo)
+	require.NoError(t, err)
+	require.IsType(t, customValueRenderer{}, rend)
+
+	// the built-in value renderers cannot be overridden
+	_, err = textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: EmptyCoinMetadataQuerier,
+		CustomScalarRenderers: map[string]textual.ValueRendererCreator{
+			"cosmos.Dec": func(protoreflect.FieldDescriptor) textual.ValueRenderer { return textual.NewStringValueRenderer() },
+		},
+	})
+	require.ErrorContains(t, err, "value renderer of scalar cosmos.Dec is already defined")
+
+	_, err = textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: EmptyCoinMetadataQuerier,
+		CustomMessageRenderers: map[protoreflect.FullName]textual.MessageValueRendererCreator{
+			"google.protobuf.Timestamp": func(*textual.SignModeHandler) textual.ValueRenderer { return textual.NewStringValueRenderer() },
+		},
+	})
+	require.ErrorContains(t, err, "value renderer of message google.protobuf.Timestamp is already defined")
+}
@@ -0,0 +1,221 @@
+// Package testutil provides helpers to test the textual value renderers of
+// modules against golden files, in the format of the textual test vectors.
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+// GoldenTestCase is a test case of a golden file: a value, and the screens it
+// is rendered to. The value is a google.protobuf.Any in its protojson
+// encoding, so that a golden file can contain values of different types.
+type GoldenTestCase struct {
+	// Proto is the protojson encoding of the google.protobuf.Any wrapping the
+	// rendered value.
+	Proto json.RawMessage
+	// Screens are the screens the value is rendered to.
+	Screens []textual.Screen
+	// Error is true if the value fails to be rendered.
+	Error bool
+}
+
+// goldenTestCase is the JSON encoding of a GoldenTestCase.
+type goldenTestCase struct {
+	Proto   json.RawMessage `json:"proto"`
+	Screens []goldenScreen  `json:"screens,omitempty"`
+	Error   bool            `json:"error,omitempty"`
+}
+
+// goldenScreen is the JSON encoding of a textual.Screen.
+type goldenScreen struct {
+	Title   string `json:"title,omitempty"`
+	Content string `json:"content"`
+	Indent  int    `json:"indent,omitempty"`
+	Expert  bool   `json:"expert,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (tc GoldenTestCase) MarshalJSON() ([]byte, error) {
+	jsonTc := goldenTestCase{Proto: tc.Proto, Error: tc.Error}
+	for _, screen := range tc.Screens {
+		jsonTc.Screens = append(jsonTc.Screens, goldenScreen(screen))
+	}
+	return json.Marshal(jsonTc)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (tc *GoldenTestCase) UnmarshalJSON(bz []byte) error {
+	var jsonTc goldenTestCase
+	if err := json.Unmarshal(bz, &jsonTc); err != nil {
+		return err
+	}
+
+	*tc = GoldenTestCase{Proto: jsonTc.Proto, Error: jsonTc.Error}
+	for _, screen := range jsonTc.Screens {
+		tc.Screens = append(tc.Screens, textual.Screen(screen))
+	}
+	return nil
+}
+
+// NewGoldenTestCase renders a value with the given SignModeHandler and returns
+// its test case, e.g. to generate a golden file.
+func NewGoldenTestCase(ctx context.Context, handler *textual.SignModeHandler, msg proto.Message) (GoldenTestCase, error) {
+	anyMsg, err := anyutil.New(msg)
+	if err != nil {
+		return GoldenTestCase{}, err
+	}
+
+	bz, err := protojson.Marshal(anyMsg)
+	if err != nil {
+		return GoldenTestCase{}, err
+	}
+
+	screens, err := textual.NewAnyValueRenderer(handler).Format(ctx, protoreflect.ValueOfMessage(anyMsg.ProtoReflect()))
+	if err != nil {
+		return GoldenTestCase{Proto: bz, Error: true}, nil
+	}
+
+	return GoldenTestCase{Proto: bz, Screens: screens}, nil
+}
+
+// ReadGoldenFile reads the test cases of a golden file.
+func ReadGoldenFile(path string) ([]GoldenTestCase, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var testCases []GoldenTestCase
+	if err := json.Unmarshal(bz, &testCases); err != nil {
+		return nil, fmt.Errorf("invalid golden file %s: %w", path, err)
+	}
+
+	return testCases, nil
+}
+
+// WriteGoldenFile writes the test cases of a golden file, laid out as the
+// textual test vectors, with one line per screen.
+func WriteGoldenFile(path string, testCases []GoldenTestCase) error {
+	var buf bytes.Buffer
+	buf.WriteString("[\n")
+	for i, tc := range testCases {
+		buf.WriteString("    {\n        \"proto\": ")
+		if err := json.Indent(&buf, tc.Proto, "        ", "    "); err != nil {
+			return err
+		}
+
+		if len(tc.Screens) > 0 {
+			buf.WriteString(",\n        \"screens\": [\n")
+			for j, screen := range tc.Screens {
+				buf.WriteString("            ")
+				if err := writeScreen(&buf, screen); err != nil {
+					return err
+				}
+				if j < len(tc.Screens)-1 {
+					buf.WriteString(",")
+				}
+				buf.WriteString("\n")
+			}
+			buf.WriteString("        ]")
+		}
+
+		if tc.Error {
+			buf.WriteString(",\n        \"error\": true")
+		}
+
+		buf.WriteString("\n    }")
+		if i < len(testCases)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("]\n")
+
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
+
+// writeScreen writes a screen on a single line, omitting its empty fields.
+func writeScreen(buf *bytes.Buffer, screen textual.Screen) error {
+	var fields []string
+	writeField := func(key string, value any) error {
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, fmt.Sprintf("%q: %s", key, bz))
+		return nil
+	}
+
+	if screen.Title != "" {
+		if err := writeField("title", screen.Title); err != nil {
+			return err
+		}
+	}
+	if err := writeField("content", screen.Content); err != nil {
+		return err
+	}
+	if screen.Indent != 0 {
+		if err := writeField("indent", screen.Indent); err != nil {
+			return err
+		}
+	}
+	if screen.Expert {
+		if err := writeField("expert", screen.Expert); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteString("{" + strings.Join(fields, ", ") + "}")
+	return nil
+}
+
+// RunGoldenFile runs each test case of a golden file as a subtest. The value
+// of the test case is rendered with the given SignModeHandler and compared to
+// the expected screens, which are then parsed back and compared to the value.
+//
+// The type of the value must be registered in the global protobuf registry.
+func RunGoldenFile(t *testing.T, handler *textual.SignModeHandler, path string) {
+	t.Helper()
+
+	testCases, err := ReadGoldenFile(path)
+	require.NoError(t, err)
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			anyMsg := &anypb.Any{}
+			require.NoError(t, protojson.Unmarshal(tc.Proto, anyMsg))
+
+			rend := textual.NewAnyValueRenderer(handler)
+			screens, err := rend.Format(context.Background(), protoreflect.ValueOfMessage(anyMsg.ProtoReflect()))
+			if tc.Error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.Screens, screens)
+
+			val, err := rend.Parse(context.Background(), screens)
+			require.NoError(t, err)
+			diff := cmp.Diff(anyMsg, val.Message().Interface(), protocmp.Transform())
+			require.Empty(t, diff)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package testutil_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/x/tx/internal/testpb"
+	"cosmossdk.io/x/tx/signing/textual"
+	"cosmossdk.io/x/tx/signing/textual/testutil"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+const validatorPrefix = "cosmosvaloper"
+
+// validatorAddressRenderer is a sample value renderer of a custom scalar, which
+// renders validator addresses with a label.
+type validatorAddressRenderer struct{}
+
+func (validatorAddressRenderer) Format(_ context.Context, v protoreflect.Value) ([]textual.Screen, error) {
+	if !strings.HasPrefix(v.String(), validatorPrefix) {
+		return nil, fmt.Errorf("invalid validator address %s", v.String())
+	}
+	return []textual.Screen{{Content: "Validator " + v.String()}}, nil
+}
+
+func (validatorAddressRenderer) Parse(_ context.Context, screens []textual.Screen) (protoreflect.Value, error) {
+	if len(screens) != 1 {
+		return protoreflect.Value{}, fmt.Errorf("expected single screen: %v", screens)
+	}
+	addr, found := strings.CutPrefix(screens[0].Content, "Validator ")
+	if !found {
+		return protoreflect.Value{}, fmt.Errorf("invalid validator screen %s", screens[0].Content)
+	}
+	return protoreflect.ValueOfString(addr), nil
+}
+
+func emptyCoinMetadataQuerier(context.Context, string) (*bankv1beta1.Metadata, error) {
+	return nil, nil
+}
+
+func TestGoldenFile(t *testing.T) {
+	handler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: emptyCoinMetadataQuerier,
+		CustomScalarRenderers: map[string]textual.ValueRendererCreator{
+			"cosmos.ValidatorAddressString": func(protoreflect.FieldDescriptor) textual.ValueRenderer {
+				return validatorAddressRenderer{}
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	path := filepath.Join("testdata", "custom_scalar.json")
+	if *update {
+		var testCases []testutil.GoldenTestCase
+		for _, msg := range []proto.Message{
+			&testpb.ValidatorSigner{},
+			&testpb.ValidatorSigner{Signer: validatorPrefix + "1abc"},
+			&testpb.ValidatorSigner{Signer: "cosmos1abc"},
+		} {
+			tc, err := testutil.NewGoldenTestCase(context.Background(), handler, msg)
+			require.NoError(t, err)
+			testCases = append(testCases, tc)
+		}
+		require.NoError(t, testutil.WriteGoldenFile(path, testCases))
+	}
+
+	testutil.RunGoldenFile(t, handler, path)
+}
@@ -204,5 +204,6 @@ replace (
 	cosmossdk.io/x/protocolpool => ../protocolpool
 	cosmossdk.io/x/slashing => ../slashing
 	cosmossdk.io/x/staking => ../staking
+	cosmossdk.io/x/tx => ../tx
 	github.com/gin-gonic/gin => github.com/gin-gonic/gin v1.9.1
 )